@@ -257,6 +257,11 @@ module.exports = {
                   directory: false,
                   path: "/apps/transfer/params.html",
                 },
+                {
+                  title: "Authorizations",
+                  directory: false,
+                  path: "/apps/transfer/authorizations.html",
+                },
               ],
             },
           ],
@@ -301,6 +306,30 @@ module.exports = {
                 },
               ],
             },
+            {
+              title: "Rate Limiting Middleware",
+              directory: true,
+              path: "/middleware",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/middleware/rate-limiting/overview.html",
+                },
+              ],
+            },
+            {
+              title: "Packet Forward Middleware",
+              directory: true,
+              path: "/middleware",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/middleware/packet-forward/overview.html",
+                },
+              ],
+            },
           ],
         },
         {
@@ -17,6 +17,8 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
+		GetCmdQueryInterchainAccounts(),
+		GetCmdQueryInterchainAccountOwner(),
 		GetCmdParams(),
 	)
 
@@ -36,6 +38,7 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
 		newSendTxCmd(),
+		newTransferOwnershipCmd(),
 	)
 
 	return cmd
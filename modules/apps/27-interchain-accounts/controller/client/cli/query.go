@@ -11,6 +11,11 @@ import (
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 )
 
+const (
+	flagOwner        = "owner"
+	flagConnectionID = "connection-id"
+)
+
 // GetCmdQueryInterchainAccount returns the command handler for the controller submodule parameter querying.
 func GetCmdQueryInterchainAccount() *cobra.Command {
 	cmd := &cobra.Command{
@@ -45,6 +50,93 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryInterchainAccounts returns the command handler for querying all registered interchain accounts.
+func GetCmdQueryInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all registered interchain accounts",
+		Long:    "Query the controller submodule for all registered interchain accounts, optionally filtered by owner and connection, including the state of their active channels",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-accounts --owner cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs --connection-id connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountsRequest{
+				Owner:        owner,
+				ConnectionId: connectionID,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Filter the interchain accounts by owner address")
+	cmd.Flags().String(flagConnectionID, "", "Filter the interchain accounts by connection identifier")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain-accounts")
+
+	return cmd
+}
+
+// GetCmdQueryInterchainAccountOwner returns the command handler for querying the owner of an interchain account.
+func GetCmdQueryInterchainAccountOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner [connection-id] [port-id]",
+		Short:   "Query the owner of the interchain account registered on a particular connection and controller port",
+		Long:    "Query the controller submodule for the current owner of the interchain account registered on a particular connection and controller port",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller owner connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountOwnerRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			}
+
+			res, err := queryClient.InterchainAccountOwner(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for the controller submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
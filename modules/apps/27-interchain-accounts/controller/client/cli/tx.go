@@ -14,11 +14,13 @@ import (
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 )
 
 const (
 	// The controller chain channel version
 	flagVersion                = "version"
+	flagOrdering               = "ordering"
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 )
@@ -30,9 +32,9 @@ func newRegisterInterchainAccountCmd() *cobra.Command {
 		Long: strings.TrimSpace(`Register an account on the counterparty chain via the 
 connection id from the source chain. Connection identifier should be for the source chain 
 and the interchain account will be created on the counterparty chain. Callers are expected to 
-provide the appropriate application version string via {version} flag. Generates a new 
-port identifier using the provided owner string, binds to the port identifier and claims 
-the associated capability.`),
+provide the appropriate application version string via {version} flag. The channel ordering 
+may be set via {ordering} flag. Generates a new port identifier using the provided owner string, 
+binds to the port identifier and claims the associated capability.`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -47,13 +49,25 @@ the associated capability.`),
 				return err
 			}
 
+			ordering, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			order, found := channeltypes.Order_value[ordering]
+			if !found {
+				return fmt.Errorf("invalid channel ordering: %s", ordering)
+			}
+
 			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version)
+			msg.Ordering = channeltypes.Order(order)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
+	cmd.Flags().String(flagOrdering, channeltypes.ORDERED.String(), fmt.Sprintf("Channel ordering, can be one of: %s", strings.Join([]string{channeltypes.ORDERED.String(), channeltypes.UNORDERED.String()}, ", ")))
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -81,16 +95,16 @@ via {packet-timeout-timestamp}`),
 			// attempt to unmarshal ica msg data argument
 			var icaMsgData icatypes.InterchainAccountPacketData
 			msgContentOrFileName := args[1]
-			if err := cdc.UnmarshalInterfaceJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
+			if err := cdc.UnmarshalJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
 
 				// check for file path if JSON input is not provided
 				contents, err := os.ReadFile(msgContentOrFileName)
 				if err != nil {
-					return fmt.Errorf("neither JSON input nor path to .json file for client state were provided: %w", err)
+					return fmt.Errorf("neither JSON input nor path to .json file for packet data were provided: %w", err)
 				}
 
-				if err := cdc.UnmarshalInterfaceJSON(contents, &icaMsgData); err != nil {
-					return fmt.Errorf("error unmarshalling client state file: %w", err)
+				if err := cdc.UnmarshalJSON(contents, &icaMsgData); err != nil {
+					return fmt.Errorf("error unmarshalling packet data file: %w", err)
 				}
 			}
 
@@ -120,3 +134,29 @@ via {packet-timeout-timestamp}`),
 
 	return cmd
 }
+
+func newTransferOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [connection-id] [new-owner]",
+		Short: "Transfer the ownership of an interchain account on the provided connection.",
+		Long: strings.TrimSpace(`Transfers the ownership of the interchain account controlled by the sender on the 
+provided connection to a new owner address. The interchain account keeps its address on the host chain, 
+the sender loses control of the interchain account.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgTransferInterchainAccountOwnership(owner, args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package controller_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -33,13 +34,7 @@ var (
 	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
 
 	// TestVersion defines a reusable interchainaccounts version string for testing purposes
-	TestVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
-		Version:                icatypes.Version,
-		ControllerConnectionId: ibctesting.FirstConnectionID,
-		HostConnectionId:       ibctesting.FirstConnectionID,
-		Encoding:               icatypes.EncodingProtobuf,
-		TxType:                 icatypes.TxTypeSDKMultiMsg,
-	}))
+	TestVersion = icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
 )
 
 type InterchainAccountsTestSuite struct {
@@ -84,7 +79,7 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 
 	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
 
-	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, TestVersion); err != nil {
+	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithOrdering(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, TestVersion, endpoint.ChannelConfig.Order); err != nil {
 		return err
 	}
 
@@ -94,7 +89,7 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 	// update port/channel ids
 	endpoint.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
 	endpoint.ChannelConfig.PortID = portID
-	endpoint.ChannelConfig.Version = TestVersion
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
 
 	return nil
 }
@@ -163,12 +158,17 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultCallbackGasLimit))
 			}, false,
 		},
 		{
-			"ICA OnChanOpenInit fails - UNORDERED channel", func() {
+			"success: UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"ICA OnChanOpenInit fails - invalid channel ordering", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 		{
@@ -255,7 +255,14 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 			)
 
 			if tc.expPass {
-				suite.Require().Equal(icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID), version)
+				expMetadata := icatypes.NewDefaultMetadata(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				err := expMetadata.SetOrdering(channel.Ordering)
+				suite.Require().NoError(err)
+
+				expVersion, err := icatypes.MetadataToVersion(expMetadata)
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(expVersion, version)
 				suite.Require().NoError(err)
 			} else {
 				suite.Require().Error(err)
@@ -329,7 +336,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenAck() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultCallbackGasLimit))
 			}, false,
 		},
 		{
@@ -594,7 +601,7 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultCallbackGasLimit))
 			}, false,
 		},
 		{
@@ -687,7 +694,7 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, types.DefaultCallbackGasLimit))
 			}, false,
 		},
 		{
@@ -762,6 +769,86 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestPacketTimeoutChannelOrdering() {
+	testCases := []struct {
+		name     string
+		ordering channeltypes.Order
+		expState channeltypes.State
+	}{
+		{"ORDERED channel is closed on timeout", channeltypes.ORDERED, channeltypes.CLOSED},
+		{"UNORDERED channel remains open on timeout", channeltypes.UNORDERED, channeltypes.OPEN},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.ordering
+			path.EndpointB.ChannelConfig.Order = tc.ordering
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// the ordering is recorded in the channel metadata
+			metadata, err := icatypes.MetadataFromVersion(path.EndpointA.GetChannel().Version)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.ordering, metadata.ChannelOrdering())
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				sequence,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.ZeroHeight(),
+				timeoutTimestamp,
+			)
+
+			// advance the host chain past the timeout and update the client on the controller chain
+			suite.coordinator.IncrementTimeBy(time.Hour)
+			suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			if tc.ordering == channeltypes.ORDERED {
+				packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			}
+
+			proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+			nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+			suite.Require().True(found)
+
+			msg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+			_, err = suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expState, path.EndpointA.GetChannel().State)
+
+			// the interchain account may continue to send packets if the channel remains open
+			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano()))
+			if tc.expState == channeltypes.OPEN {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, icatypes.ErrActiveChannelNotFound)
+			}
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestSingleHostMultipleControllers() {
 	var (
 		pathAToB *ibctesting.Path
@@ -797,13 +884,7 @@ func (suite *InterchainAccountsTestSuite) TestSingleHostMultipleControllers() {
 
 			// NOTE: Here the version metadata is overridden to include to the next host connection sequence (i.e. chainB's connection to chainC)
 			// SetupICAPath() will set endpoint.ChannelConfig.Version to TestVersion
-			TestVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
-				Version:                icatypes.Version,
-				ControllerConnectionId: pathCToB.EndpointA.ConnectionID,
-				HostConnectionId:       pathCToB.EndpointB.ConnectionID,
-				Encoding:               icatypes.EncodingProtobuf,
-				TxType:                 icatypes.TxTypeSDKMultiMsg,
-			}))
+			TestVersion = icatypes.NewDefaultMetadataString(pathCToB.EndpointA.ConnectionID, pathCToB.EndpointB.ConnectionID)
 
 			err = SetupICAPath(pathCToB, TestOwnerAddress)
 			suite.Require().NoError(err)
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
@@ -17,12 +21,24 @@ import (
 // - An error is returned if the port identifier is already in use. Gaining access to interchain accounts whose channels
 // have closed cannot be done with this function. A regular MsgChannelOpenInit must be used.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error {
-	portID, err := icatypes.NewControllerPortID(owner)
+	return k.RegisterInterchainAccountWithOrdering(ctx, connectionID, owner, version, channeltypes.ORDERED)
+}
+
+// RegisterInterchainAccountWithOrdering registers an interchain account in the same way as RegisterInterchainAccount,
+// using a channel of the provided ordering. Packets timing out on UNORDERED channels do not close the channel.
+// The controller portID is resolved using the ownership mappings, interchain accounts whose ownership has been
+// transferred may only be controlled using the controller msg server and cannot be registered using this function.
+func (k Keeper) RegisterInterchainAccountWithOrdering(ctx sdk.Context, connectionID, owner, version string, ordering channeltypes.Order) error {
+	portID, err := k.GetControllerPortID(ctx, connectionID, owner)
 	if err != nil {
 		return err
 	}
 
-	channelID, err := k.registerInterchainAccount(ctx, connectionID, portID, version)
+	if k.IsOwnershipTransferred(ctx, connectionID, portID) {
+		return sdkerrors.Wrapf(types.ErrAccountOwnershipTransferred, "interchain account on port %s and connection %s must be registered using MsgRegisterInterchainAccount", portID, connectionID)
+	}
+
+	channelID, err := k.registerInterchainAccount(ctx, connectionID, portID, version, ordering)
 	if err != nil {
 		return err
 	}
@@ -34,7 +50,7 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner,
 
 // registerInterchainAccount registers an interchain account, returning the channel id of the MsgChannelOpenInitResponse
 // and an error if one occurred.
-func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string) (string, error) {
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string, ordering channeltypes.Order) (string, error) {
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
@@ -51,7 +67,7 @@ func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID,
 		}
 	}
 
-	msg := channeltypes.NewMsgChannelOpenInit(portID, version, channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, ordering, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
 	handler := k.msgRouter.Handler(msg)
 	res, err := handler(ctx, msg)
 	if err != nil {
@@ -69,3 +85,72 @@ func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID,
 
 	return channelOpenInitResponse.ChannelId, nil
 }
+
+// TransferInterchainAccountOwnership transfers the ownership of the interchain account controlled by the provided owner
+// on the provided connectionID to newOwner. The interchain account keeps its controller portID, and therefore its
+// address on the host chain, while the owner mappings are rebound such that only newOwner may control it.
+// Interchain accounts controlled by an authentication module through the ibc middleware cannot be transferred, and
+// newOwner must not already control an interchain account on the connection.
+func (k Keeper) TransferInterchainAccountOwnership(ctx sdk.Context, connectionID, owner, newOwner string) error {
+	if owner == newOwner {
+		return sdkerrors.Wrap(types.ErrInvalidOwnershipTransfer, "new owner must differ from the current owner")
+	}
+
+	portID, err := k.GetControllerPortID(ctx, connectionID, owner)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); !found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s and connection %s", portID, connectionID)
+	}
+
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if found && k.IsMiddlewareEnabled(ctx, portID, activeChannelID) {
+		return sdkerrors.Wrapf(types.ErrInvalidOwnershipTransfer, "interchain account on port %s is controlled by an authentication module", portID)
+	}
+
+	// the new owner must not already control an interchain account, or a pending registration, on the connection
+	if newOwnerPortID, err := k.GetControllerPortID(ctx, connectionID, newOwner); err == nil && newOwnerPortID != portID {
+		_, hasAccount := k.GetInterchainAccountAddress(ctx, connectionID, newOwnerPortID)
+		if hasAccount || k.IsActiveChannel(ctx, connectionID, newOwnerPortID) {
+			return sdkerrors.Wrapf(types.ErrInvalidOwnershipTransfer, "new owner %s already controls an interchain account on connection %s", newOwner, connectionID)
+		}
+	}
+
+	k.DeleteInterchainAccountOwner(ctx, connectionID, portID)
+	k.SetInterchainAccountOwner(ctx, connectionID, portID, newOwner)
+
+	// the callbacks of packets awaiting acknowledgement or timeout are routed to the new owner
+	k.transferPacketOwners(ctx, connectionID, portID, newOwner)
+
+	EmitTransferAccountOwnershipEvent(ctx, connectionID, portID, owner, newOwner)
+
+	return nil
+}
+
+// transferPacketOwners sets the provided owner as the owner of all packets awaiting acknowledgement or timeout which
+// were sent using SendTx over channels of the provided controller portID on the provided connectionID
+func (k Keeper) transferPacketOwners(ctx sdk.Context, connectionID, portID, owner string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/%s/", icatypes.PacketOwnerKeyPrefix, portID)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		channel, found := k.channelKeeper.GetChannel(ctx, portID, keySplit[len(keySplit)-2])
+		if !found || len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionID {
+			continue
+		}
+
+		keys = append(keys, append([]byte(nil), iterator.Key()...))
+	}
+
+	// the iterator must be closed before writing to the store
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Set(key, []byte(owner))
+	}
+}
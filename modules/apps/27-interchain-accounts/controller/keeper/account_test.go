@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
@@ -118,3 +122,135 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), pathAToC.EndpointA.ConnectionID, owner, string(icatypes.ModuleCdc.MustMarshalJSON(metadata)))
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestTransferInterchainAccountOwnership() {
+	var (
+		owner    string
+		newOwner string
+		path     *ibctesting.Path
+	)
+
+	otherAddress := sdk.AccAddress(crypto.AddressHash([]byte("other"))).String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: transfer back to the owner encoded in the port ID", func() {
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, newOwner)
+				suite.Require().NoError(err)
+
+				owner, newOwner = newOwner, owner
+			}, true,
+		},
+		{
+			"new owner equals owner", func() {
+				newOwner = owner
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				owner = otherAddress
+			}, false,
+		},
+		{
+			"ownership has already been transferred", func() {
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, newOwner)
+				suite.Require().NoError(err)
+
+				newOwner = otherAddress
+			}, false,
+		},
+		{
+			"interchain account is controlled by an authentication module", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			}, false,
+		},
+		{
+			"new owner already controls an interchain account on the connection", func() {
+				portID, err := icatypes.NewControllerPortID(newOwner)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID, TestAccAddress.String())
+			}, false,
+		},
+		{
+			"new owner has a pending registration on the connection", func() {
+				portID, err := icatypes.NewControllerPortID(newOwner)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID, "channel-100")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			owner = TestOwnerAddress
+			newOwner = TestNewOwnerAddress
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, owner)
+			suite.Require().NoError(err)
+
+			// interchain accounts registered through the msg server are not controlled by an authentication module
+			suite.chainA.GetSimApp().ICAControllerKeeper.DeleteMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			// packet awaiting acknowledgement or timeout
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetPacketOwner(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID, 1, owner)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, newOwner)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the callbacks of in-flight packets are routed to the new owner
+				packetOwner, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketOwner(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(newOwner, packetOwner)
+
+				// the interchain account keeps its controller port and is controlled by the new owner only
+				portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, newOwner)
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestPortID, portID)
+				suite.Require().Equal(newOwner, suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountOwner(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID))
+
+				portID, err = suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner)
+				if newOwner == TestOwnerAddress {
+					// no owner mapping is required for the owner encoded in the port ID
+					suite.Require().NoError(err)
+					suite.Require().NotEqual(TestPortID, portID)
+					suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetAllInterchainAccountOwners(suite.chainA.GetContext()))
+				} else {
+					suite.Require().ErrorIs(err, types.ErrAccountOwnershipTransferred)
+
+					// transferred interchain accounts cannot be controlled using the legacy keeper functions
+					err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, TestVersion)
+					suite.Require().ErrorIs(err, types.ErrAccountOwnershipTransferred)
+
+					err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, newOwner, TestVersion)
+					suite.Require().ErrorIs(err, types.ErrAccountOwnershipTransferred)
+
+					packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+					_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, path.EndpointA.ConnectionID, TestPortID, packetData, ^uint64(0))
+					suite.Require().ErrorIs(err, types.ErrAccountOwnershipTransferred)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -29,3 +29,18 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitTransferAccountOwnershipEvent emits an event signalling the transfer of the ownership of the interchain account
+// registered on the provided connection and controller port.
+func EmitTransferAccountOwnershipEvent(ctx sdk.Context, connectionID, portID, owner, newOwner string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeTransferAccountOwnership,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(icatypes.AttributeKeyNewOwner, newOwner),
+		),
+	)
+}
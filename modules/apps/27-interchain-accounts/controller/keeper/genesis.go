@@ -28,19 +28,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGe
 		}
 	}
 
+	// owners must be set before the interchain accounts are indexed by owner
+	for _, owner := range state.InterchainAccountOwners {
+		keeper.SetInterchainAccountOwner(ctx, owner.ConnectionId, owner.PortId, owner.Owner)
+	}
+
 	for _, acc := range state.InterchainAccounts {
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, owner := range state.PacketOwners {
+		keeper.SetPacketOwner(ctx, owner.PortId, owner.ChannelId, owner.Sequence, owner.Owner)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesisState {
-	return genesistypes.NewControllerGenesisState(
+	genesisState := genesistypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
 	)
+	genesisState.InterchainAccountOwners = keeper.GetAllInterchainAccountOwners(ctx)
+	genesisState.PacketOwners = keeper.GetAllPacketOwners(ctx)
+
+	return genesisState
 }
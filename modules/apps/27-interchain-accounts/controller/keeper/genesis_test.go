@@ -27,6 +27,22 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: []string{TestPortID},
+		InterchainAccountOwners: []genesistypes.InterchainAccountOwner{
+			{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+				Owner:        TestNewOwnerAddress,
+			},
+		},
+		PacketOwners: []genesistypes.PacketOwner{
+			{
+				PortId:    TestPortID,
+				ChannelId: ibctesting.FirstChannelID,
+				Sequence:  1,
+				Owner:     TestOwnerAddress,
+			},
+		},
+		Params: types.NewParams(false, types.DefaultCallbackGasLimit),
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, genesisState)
@@ -42,7 +58,18 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
-	expParams := types.NewParams(false)
+	owner := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountOwner(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().Equal(TestNewOwnerAddress, owner)
+
+	portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestNewOwnerAddress)
+	suite.Require().NoError(err)
+	suite.Require().Equal(TestPortID, portID)
+
+	packetOwner, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketOwner(suite.chainA.GetContext(), TestPortID, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(TestOwnerAddress, packetOwner)
+
+	expParams := types.NewParams(false, types.DefaultCallbackGasLimit)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
@@ -56,6 +83,9 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	err := SetupICAPath(path, TestOwnerAddress)
 	suite.Require().NoError(err)
 
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID, TestNewOwnerAddress)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetPacketOwner(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID, 1, TestOwnerAddress)
+
 	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -67,6 +97,16 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	expOwners := []genesistypes.InterchainAccountOwner{
+		{ConnectionId: path.EndpointA.ConnectionID, PortId: TestPortID, Owner: TestNewOwnerAddress},
+	}
+	suite.Require().Equal(expOwners, genesisState.InterchainAccountOwners)
+
+	expPacketOwners := []genesistypes.PacketOwner{
+		{PortId: TestPortID, ChannelId: path.EndpointA.ChannelID, Sequence: 1, Owner: TestOwnerAddress},
+	}
+	suite.Require().Equal(expPacketOwners, genesisState.PacketOwners)
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
@@ -2,13 +2,20 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,12 +26,16 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	portID, err := icatypes.NewControllerPortID(req.Owner)
-	if err != nil {
+	if _, err := icatypes.NewControllerPortID(req.Owner); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	portID, err := k.GetControllerPortID(ctx, req.ConnectionId, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
 	addr, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", portID, req.ConnectionId)
@@ -35,6 +46,134 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	}, nil
 }
 
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(goCtx context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Owner != "" {
+		return k.interchainAccountsByOwner(ctx, req)
+	}
+
+	var interchainAccounts []types.IdentifiedInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the form {portID}/{connectionID}
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return false, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid interchain account key: %s", key)
+		}
+
+		portID, connectionID := keySplit[0], keySplit[1]
+		if req.ConnectionId != "" && req.ConnectionId != connectionID {
+			return false, nil
+		}
+
+		if accumulate {
+			owner := k.GetInterchainAccountOwner(ctx, connectionID, portID)
+			interchainAccounts = append(interchainAccounts, k.newIdentifiedInterchainAccount(ctx, connectionID, portID, owner, string(value)))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// interchainAccountsByOwner returns the interchain accounts of the requested owner, iterating only over the controller
+// ports indexed by the owner
+func (k Keeper) interchainAccountsByOwner(ctx sdk.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	var interchainAccounts []types.IdentifiedInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/%s/", icatypes.ControllerPortKeyPrefix, req.Owner)))
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the form {connectionID}
+		connectionID, portID := string(key), string(value)
+		if req.ConnectionId != "" && req.ConnectionId != connectionID {
+			return false, nil
+		}
+
+		address, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+		if !found {
+			return false, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s and connection %s", portID, connectionID)
+		}
+
+		if accumulate {
+			interchainAccounts = append(interchainAccounts, k.newIdentifiedInterchainAccount(ctx, connectionID, portID, req.Owner, address))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// newIdentifiedInterchainAccount returns the IdentifiedInterchainAccount for the interchain account registered on the
+// provided connectionID and portID, including the identifier and state of its active channel if set
+func (k Keeper) newIdentifiedInterchainAccount(ctx sdk.Context, connectionID, portID, owner, address string) types.IdentifiedInterchainAccount {
+	interchainAccount := types.IdentifiedInterchainAccount{
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		Owner:          owner,
+		AccountAddress: address,
+		ChannelState:   channeltypes.UNINITIALIZED,
+	}
+
+	if channelID, found := k.GetActiveChannelID(ctx, connectionID, portID); found {
+		interchainAccount.ActiveChannelId = channelID
+
+		if channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+			interchainAccount.ChannelState = channel.State
+		}
+	}
+
+	return interchainAccount
+}
+
+// InterchainAccountOwner implements the Query/InterchainAccountOwner gRPC method
+func (k Keeper) InterchainAccountOwner(goCtx context.Context, req *types.QueryInterchainAccountOwnerRequest) (*types.QueryInterchainAccountOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId); !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountOwnerResponse{
+		Owner: k.GetInterchainAccountOwner(ctx, req.ConnectionId, req.PortId),
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
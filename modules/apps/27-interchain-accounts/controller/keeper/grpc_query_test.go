@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 )
 
@@ -75,6 +78,262 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req     *types.QueryInterchainAccountsRequest
+		pathA   *ibctesting.Path
+		pathB   *ibctesting.Path
+		expAccs []types.IdentifiedInterchainAccount
+	)
+
+	newIdentifiedInterchainAccount := func(path *ibctesting.Path, owner string) types.IdentifiedInterchainAccount {
+		address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+		suite.Require().True(found)
+
+		return types.IdentifiedInterchainAccount{
+			ConnectionId:    path.EndpointA.ConnectionID,
+			PortId:          path.EndpointA.ChannelConfig.PortID,
+			Owner:           owner,
+			AccountAddress:  address,
+			ActiveChannelId: path.EndpointA.ChannelID,
+			ChannelState:    channeltypes.OPEN,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: all interchain accounts",
+			func() {
+				expAccs = []types.IdentifiedInterchainAccount{newIdentifiedInterchainAccount(pathA, TestOwnerAddress), newIdentifiedInterchainAccount(pathB, TestNewOwnerAddress)}
+			},
+			true,
+		},
+		{
+			"success: filtered by owner",
+			func() {
+				req.Owner = TestNewOwnerAddress
+				expAccs = []types.IdentifiedInterchainAccount{newIdentifiedInterchainAccount(pathB, TestNewOwnerAddress)}
+			},
+			true,
+		},
+		{
+			"success: filtered by connection",
+			func() {
+				req.ConnectionId = pathA.EndpointA.ConnectionID
+				expAccs = []types.IdentifiedInterchainAccount{newIdentifiedInterchainAccount(pathA, TestOwnerAddress)}
+			},
+			true,
+		},
+		{
+			"success: filtered by owner and connection, no interchain accounts",
+			func() {
+				req.Owner = TestOwnerAddress
+				req.ConnectionId = pathB.EndpointA.ConnectionID
+				expAccs = nil
+			},
+			true,
+		},
+		{
+			"success: filtered by owner of a transferred interchain account",
+			func() {
+				owner := sdk.AccAddress(crypto.AddressHash([]byte("other"))).String()
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteInterchainAccountOwner(suite.chainA.GetContext(), pathA.EndpointA.ConnectionID, pathA.EndpointA.ChannelConfig.PortID)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), pathA.EndpointA.ConnectionID, pathA.EndpointA.ChannelConfig.PortID, owner)
+
+				req.Owner = owner
+				expAccs = []types.IdentifiedInterchainAccount{newIdentifiedInterchainAccount(pathA, owner)}
+			},
+			true,
+		},
+		{
+			"success: filtered by the previous owner of a transferred interchain account",
+			func() {
+				owner := sdk.AccAddress(crypto.AddressHash([]byte("other"))).String()
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteInterchainAccountOwner(suite.chainA.GetContext(), pathA.EndpointA.ConnectionID, pathA.EndpointA.ChannelConfig.PortID)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), pathA.EndpointA.ConnectionID, pathA.EndpointA.ChannelConfig.PortID, owner)
+
+				req.Owner = TestOwnerAddress
+				expAccs = nil
+			},
+			true,
+		},
+		{
+			"success: closed active channel",
+			func() {
+				path := pathA
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.CLOSED
+				path.EndpointA.SetChannel(channel)
+
+				req.ConnectionId = pathA.EndpointA.ConnectionID
+				expAcc := newIdentifiedInterchainAccount(pathA, TestOwnerAddress)
+				expAcc.ChannelState = channeltypes.CLOSED
+				expAccs = []types.IdentifiedInterchainAccount{expAcc}
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expAccs = []types.IdentifiedInterchainAccount{newIdentifiedInterchainAccount(pathA, TestOwnerAddress)}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = "(invalid)"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			pathA = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(pathA)
+
+			err := SetupICAPath(pathA, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// register a second interchain account on a connection to chainC
+			pathB = NewICAPath(suite.chainA, suite.chainC)
+			suite.coordinator.SetupConnections(pathB)
+
+			metadata := icatypes.NewMetadata(icatypes.Version, pathB.EndpointA.ConnectionID, pathB.EndpointB.ConnectionID, "", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			version, err := icatypes.MetadataToVersion(metadata)
+			suite.Require().NoError(err)
+
+			pathB.EndpointA.ChannelConfig.Version = version
+			pathB.EndpointB.ChannelConfig.Version = pathB.EndpointA.ChannelConfig.Version
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), pathB.EndpointA.ConnectionID, TestNewOwnerAddress, pathB.EndpointA.ChannelConfig.Version)
+			suite.Require().NoError(err)
+
+			suite.chainA.NextBlock()
+			pathB.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(1)
+			pathB.EndpointA.ChannelConfig.PortID, err = icatypes.NewControllerPortID(TestNewOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(pathB.EndpointB.ChanOpenTry())
+			suite.Require().NoError(pathB.EndpointA.ChanOpenAck())
+			suite.Require().NoError(pathB.EndpointB.ChanOpenConfirm())
+
+			req = &types.QueryInterchainAccountsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAccs, res.InterchainAccounts)
+
+				if req.Pagination != nil {
+					suite.Require().Equal(uint64(2), res.Pagination.Total)
+					suite.Require().NotNil(res.Pagination.NextKey)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountOwner() {
+	var req *types.QueryInterchainAccountOwnerRequest
+
+	newOwner := TestNewOwnerAddress
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expOwner string
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			ibctesting.TestAccAddress,
+			true,
+		},
+		{
+			"success: transferred interchain account",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), req.ConnectionId, req.PortId, newOwner)
+			},
+			newOwner,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"",
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			"",
+			false,
+		},
+		{
+			"account address not found",
+			func() {
+				req.ConnectionId = "connection-100"
+			},
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, ibctesting.TestAccAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountOwnerRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountOwner(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expOwner, res.Owner)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
@@ -13,7 +13,7 @@ import (
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must be ORDERED or UNORDERED, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
@@ -29,8 +29,8 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.UNORDERED, order)
 	}
 
 	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
@@ -55,6 +55,10 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
+	if err := metadata.SetOrdering(order); err != nil {
+		return "", err
+	}
+
 	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
 		return "", err
 	}
@@ -75,7 +79,7 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
-	return string(icatypes.ModuleCdc.MustMarshalJSON(&metadata)), nil
+	return icatypes.MetadataToVersion(metadata)
 }
 
 // OnChanOpenAck sets the active channel for the interchain account/owner pair
@@ -112,6 +116,25 @@ func (k Keeper) OnChanOpenAck(
 		return err
 	}
 
+	// the host must agree to the encoding and ordering proposed in OnChanOpenInit
+	appVersion, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve application version of channel %s on port %s", channelID, portID)
+	}
+
+	proposedMetadata, err := icatypes.MetadataFromVersion(appVersion)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Encoding != proposedMetadata.Encoding {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "expected encoding format %s, got %s", proposedMetadata.Encoding, metadata.Encoding)
+	}
+
+	if metadata.ChannelOrdering() != proposedMetadata.ChannelOrdering() {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s, got %s", proposedMetadata.ChannelOrdering(), metadata.ChannelOrdering())
+	}
+
 	if strings.TrimSpace(metadata.Address) == "" {
 		return sdkerrors.Wrap(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be empty")
 	}
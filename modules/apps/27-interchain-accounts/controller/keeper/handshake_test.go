@@ -82,10 +82,49 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success: UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
 			},
+			true,
+		},
+		{
+			"success: previous active channel closed, reopened with a different ordering",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.ORDERED,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+
+				path.EndpointA.SetChannel(closedChannel)
+
+				channel.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"invalid order - NONE",
+			func() {
+				channel.Ordering = channeltypes.NONE
+			},
+			false,
+		},
+		{
+			"invalid metadata - ordering does not match channel ordering",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED.String()
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				channel.Version = string(versionBytes)
+			},
 			false,
 		},
 		{
@@ -253,7 +292,15 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(string(versionBytes), version)
+
+				// the channel ordering is set in the returned metadata
+				err := metadata.SetOrdering(channel.Ordering)
+				suite.Require().NoError(err)
+
+				expVersion, err := icatypes.MetadataToVersion(metadata)
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -275,6 +322,22 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success - proto3 JSON encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+
+				// the controller proposes the proto3 JSON encoding in OnChanOpenInit
+				channel := path.EndpointA.GetChannel()
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(channel)
+			}, true,
+		},
 		{
 			"invalid port ID - host chain",
 			func() {
@@ -308,6 +371,30 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			},
 			false,
 		},
+		{
+			"encoding format does not match the proposed encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
+		{
+			"ordering does not match the proposed ordering",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED.String()
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"unsupported transaction type",
 			func() {
@@ -385,6 +472,8 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			suite.Require().NoError(err)
 
 			metadata = icatypes.NewMetadata(icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestAccAddress.String(), icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			metadata.Ordering = channeltypes.ORDERED.String()
+
 			versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
 			suite.Require().NoError(err)
 
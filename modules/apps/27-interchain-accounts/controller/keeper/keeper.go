@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -30,7 +32,8 @@ type Keeper struct {
 
 	scopedKeeper icatypes.ScopedKeeper
 
-	msgRouter icatypes.MessageRouter
+	msgRouter       icatypes.MessageRouter
+	callbacksRouter *types.CallbacksRouter
 }
 
 // NewKeeper creates a new interchain accounts controller Keeper instance
@@ -45,14 +48,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		ics4Wrapper:   ics4Wrapper,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		scopedKeeper:  scopedKeeper,
-		msgRouter:     msgRouter,
+		storeKey:        key,
+		cdc:             cdc,
+		paramSpace:      paramSpace,
+		ics4Wrapper:     ics4Wrapper,
+		channelKeeper:   channelKeeper,
+		portKeeper:      portKeeper,
+		scopedKeeper:    scopedKeeper,
+		msgRouter:       msgRouter,
+		callbacksRouter: types.NewCallbacksRouter(),
 	}
 }
 
@@ -61,6 +65,12 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
 }
 
+// GetCallbacksRouter returns the router used to register the callbacks of interchain account owners. The router is
+// shared by all copies of the keeper, routes may therefore be added after the keeper is passed to the IBC middleware.
+func (k Keeper) GetCallbacksRouter() *types.CallbacksRouter {
+	return k.callbacksRouter
+}
+
 // GetAllPorts returns all ports to which the interchain accounts controller module is bound. Used in ExportGenesis
 func (k Keeper) GetAllPorts(ctx sdk.Context) []string {
 	store := ctx.KVStore(k.storeKey)
@@ -185,6 +195,7 @@ func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.RegisteredInterchainAccount {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.OwnerKeyPrefix))
+	defer iterator.Close()
 
 	var interchainAccounts []genesistypes.RegisteredInterchainAccount
 	for ; iterator.Valid(); iterator.Next() {
@@ -202,10 +213,12 @@ func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.Registe
 	return interchainAccounts
 }
 
-// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID
+// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID.
+// The controller portID is indexed by the owner of the interchain account on the connection.
 func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
+	store.Set(icatypes.KeyControllerPort(k.GetInterchainAccountOwner(ctx, connectionID, portID), connectionID), []byte(portID))
 }
 
 // IsMiddlewareEnabled returns true if the underlying application callbacks are enabled for given port and channel identifier pair, otherwise false
@@ -225,3 +238,141 @@ func (k Keeper) DeleteMiddlewareEnabled(ctx sdk.Context, portID, channelID strin
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(icatypes.KeyIsMiddlewareEnabled(portID, channelID))
 }
+
+// GetPacketOwner retrieves the owner of the packet with the provided port identifier, channel identifier and sequence
+func (k Keeper) GetPacketOwner(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := icatypes.KeyPacketOwner(portID, channelID, sequence)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// SetPacketOwner stores the owner of the packet with the provided port identifier, channel identifier and sequence
+func (k Keeper) SetPacketOwner(ctx sdk.Context, portID, channelID string, sequence uint64, owner string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyPacketOwner(portID, channelID, sequence), []byte(owner))
+}
+
+// DeletePacketOwner removes the owner of the packet with the provided port identifier, channel identifier and sequence
+func (k Keeper) DeletePacketOwner(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyPacketOwner(portID, channelID, sequence))
+}
+
+// GetAllPacketOwners returns a list of all packets sent using SendTx which are awaiting acknowledgement or timeout,
+// along with the owners whose callbacks are invoked once the packets complete
+func (k Keeper) GetAllPacketOwners(ctx sdk.Context) []genesistypes.PacketOwner {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.PacketOwnerKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var owners []genesistypes.PacketOwner
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		owner := genesistypes.PacketOwner{
+			PortId:    keySplit[1],
+			ChannelId: keySplit[2],
+			Sequence:  sequence,
+			Owner:     string(iterator.Value()),
+		}
+
+		owners = append(owners, owner)
+	}
+
+	return owners
+}
+
+// GetInterchainAccountOwner returns the owner of the interchain account registered on the provided connectionID and
+// controller portID. This is the owner the interchain account has been transferred to, if any, otherwise the owner
+// encoded in the controller portID.
+func (k Keeper) GetInterchainAccountOwner(ctx sdk.Context, connectionID, portID string) string {
+	store := ctx.KVStore(k.storeKey)
+	key := icatypes.KeyAccountOwner(portID, connectionID)
+
+	if !store.Has(key) {
+		return strings.TrimPrefix(portID, icatypes.PortPrefix)
+	}
+
+	return string(store.Get(key))
+}
+
+// GetAllInterchainAccountOwners returns a list of all interchain accounts which have been transferred to a new owner,
+// along with their associated connection and controller port identifiers
+func (k Keeper) GetAllInterchainAccountOwners(ctx sdk.Context) []genesistypes.InterchainAccountOwner {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.AccountOwnerKeyPrefix))
+	defer iterator.Close()
+
+	var owners []genesistypes.InterchainAccountOwner
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		owner := genesistypes.InterchainAccountOwner{
+			ConnectionId: keySplit[2],
+			PortId:       keySplit[1],
+			Owner:        string(iterator.Value()),
+		}
+
+		owners = append(owners, owner)
+	}
+
+	return owners
+}
+
+// SetInterchainAccountOwner stores the owner the interchain account registered on the provided connectionID and
+// controller portID has been transferred to. The controller portID is indexed by the owner on the connection.
+// No owner is stored if the owner is the owner encoded in the controller portID.
+func (k Keeper) SetInterchainAccountOwner(ctx sdk.Context, connectionID, portID, owner string) {
+	store := ctx.KVStore(k.storeKey)
+	if owner != strings.TrimPrefix(portID, icatypes.PortPrefix) {
+		store.Set(icatypes.KeyAccountOwner(portID, connectionID), []byte(owner))
+	}
+
+	store.Set(icatypes.KeyControllerPort(owner, connectionID), []byte(portID))
+}
+
+// DeleteInterchainAccountOwner removes the owner of the interchain account registered on the provided connectionID and
+// controller portID, along with the controller portID indexed by the owner
+func (k Keeper) DeleteInterchainAccountOwner(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyControllerPort(k.GetInterchainAccountOwner(ctx, connectionID, portID), connectionID))
+	store.Delete(icatypes.KeyAccountOwner(portID, connectionID))
+}
+
+// IsOwnershipTransferred returns true if the interchain account registered on the provided connectionID and controller
+// portID has been transferred to an owner other than the owner encoded in the portID
+func (k Keeper) IsOwnershipTransferred(ctx sdk.Context, connectionID, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(icatypes.KeyAccountOwner(portID, connectionID))
+}
+
+// GetControllerPortID returns the controller portID of the interchain account controlled by the provided owner on the
+// provided connectionID. This is the portID indexed by the owner, if any, otherwise the portID generated from the owner.
+// An error is returned if the interchain account of the generated portID has been transferred to another owner.
+func (k Keeper) GetControllerPortID(ctx sdk.Context, connectionID, owner string) (string, error) {
+	store := ctx.KVStore(k.storeKey)
+	if portID := store.Get(icatypes.KeyControllerPort(owner, connectionID)); portID != nil {
+		return string(portID), nil
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if currentOwner := k.GetInterchainAccountOwner(ctx, connectionID, portID); currentOwner != owner {
+		return "", sdkerrors.Wrapf(types.ErrAccountOwnershipTransferred, "interchain account on port %s and connection %s is owned by %s", portID, connectionID, currentOwner)
+	}
+
+	return portID, nil
+}
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	controllertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	"github.com/cosmos/ibc-go/v5/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
@@ -39,3 +40,31 @@ func (m Migrator) AssertChannelCapabilityMigrations(ctx sdk.Context) error {
 
 	return nil
 }
+
+// IndexInterchainAccountOwners indexes the controller portIDs of all registered interchain accounts by their owner.
+// Chains which do not run the controller submodule are left untouched.
+func (m Migrator) IndexInterchainAccountOwners(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	for _, acc := range m.keeper.GetAllInterchainAccounts(ctx) {
+		m.keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
+	}
+
+	return nil
+}
+
+// MigrateParams sets the CallbackGasLimit controller parameter to its default value if it is not yet set.
+// Chains which do not run the controller submodule are left untouched.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, controllertypes.KeyCallbackGasLimit) {
+		m.keeper.paramSpace.Set(ctx, controllertypes.KeyCallbackGasLimit, controllertypes.DefaultParams().CallbackGasLimit)
+	}
+
+	return nil
+}
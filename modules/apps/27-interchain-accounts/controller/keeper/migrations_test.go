@@ -1,7 +1,13 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 )
 
@@ -61,3 +67,60 @@ func (suite *KeeperTestSuite) TestAssertChannelCapabilityMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestIndexInterchainAccountOwners() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// remove the owner index to mimic an interchain account registered before the migration
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Delete(icatypes.KeyControllerPort(TestOwnerAddress, path.EndpointA.ConnectionID))
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryInterchainAccountsRequest{Owner: TestOwnerAddress})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.InterchainAccounts)
+
+	migrator := keeper.NewMigrator(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	err = migrator.IndexInterchainAccountOwners(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryInterchainAccountsRequest{Owner: TestOwnerAddress})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InterchainAccounts, 1)
+	suite.Require().Equal(TestPortID, res.InterchainAccounts[0].PortId)
+
+	err = keeper.NewMigrator(nil).IndexInterchainAccountOwners(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.GetSimApp()
+
+	// remove the callback gas limit from the param store to mimic a chain running a previous version
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+	paramStore.Delete(types.KeyCallbackGasLimit)
+
+	migrator := keeper.NewMigrator(&app.ICAControllerKeeper)
+	err := migrator.MigrateParams(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), app.ICAControllerKeeper.GetParams(ctx))
+
+	// an existing callback gas limit is left untouched
+	params := types.NewParams(true, 200_000)
+	app.ICAControllerKeeper.SetParams(ctx, params)
+
+	err = migrator.MigrateParams(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, app.ICAControllerKeeper.GetParams(ctx))
+
+	// chains which do not run the controller submodule are left untouched
+	err = keeper.NewMigrator(nil).MigrateParams(ctx)
+	suite.Require().NoError(err)
+}
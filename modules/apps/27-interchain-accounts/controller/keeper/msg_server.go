@@ -6,7 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
-	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -25,12 +25,18 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.GetControllerPortID(ctx, msg.ConnectionId, msg.Owner)
 	if err != nil {
 		return nil, err
 	}
 
-	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version)
+	// default to ORDERED channels for backwards compatibility
+	ordering := msg.Ordering
+	if ordering == channeltypes.NONE {
+		ordering = channeltypes.ORDERED
+	}
+
+	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version, ordering)
 	if err != nil {
 		return nil, err
 	}
@@ -44,16 +50,27 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.GetControllerPortID(ctx, msg.ConnectionId, msg.Owner)
 	if err != nil {
 		return nil, err
 	}
 
-	// explicitly passing nil as the argument is discarded as the channel capability is retrieved in SendTx.
-	seq, err := s.Keeper.SendTx(ctx, nil, msg.ConnectionId, portID, msg.PacketData, msg.TimeoutTimestamp)
+	// the owner has been authenticated, interchain accounts transferred to the owner may be controlled
+	seq, err := s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, msg.TimeoutTimestamp)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSendTxResponse{Sequence: seq}, nil
 }
+
+// TransferInterchainAccountOwnership defines a rpc handler for MsgTransferInterchainAccountOwnership
+func (s msgServer) TransferInterchainAccountOwnership(goCtx context.Context, msg *types.MsgTransferInterchainAccountOwnership) (*types.MsgTransferInterchainAccountOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.Keeper.TransferInterchainAccountOwnership(ctx, msg.ConnectionId, msg.Owner, msg.NewOwner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferInterchainAccountOwnershipResponse{}, nil
+}
@@ -168,7 +168,7 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 			}
 
-			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{icaMsg})
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{icaMsg}, icatypes.EncodingProtobuf)
 			suite.Require().NoError(err)
 
 			packetData := icatypes.InterchainAccountPacketData{
@@ -198,3 +198,78 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTransferInterchainAccountOwnership_MsgServer() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	ctx := suite.chainA.GetContext()
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	_, err := msgServer.RegisterInterchainAccount(ctx, types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion))
+	suite.Require().NoError(err)
+
+	suite.chainA.NextBlock()
+	path.EndpointA.ChannelID = ibctesting.FirstChannelID
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+	suite.Require().True(found)
+
+	newOwner := TestNewOwnerAddress
+
+	// only the current owner may transfer the interchain account
+	ctx = suite.chainA.GetContext()
+	_, err = msgServer.TransferInterchainAccountOwnership(ctx, types.NewMsgTransferInterchainAccountOwnership(newOwner, path.EndpointA.ConnectionID, TestOwnerAddress))
+	suite.Require().ErrorIs(err, icatypes.ErrInterchainAccountNotFound)
+
+	res, err := msgServer.TransferInterchainAccountOwnership(ctx, types.NewMsgTransferInterchainAccountOwnership(TestOwnerAddress, path.EndpointA.ConnectionID, newOwner))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(icatypes.EventTypeTransferAccountOwnership, events[0].Type)
+
+	// the interchain account address is resolved for the new owner only
+	queryRes, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(sdk.WrapSDKContext(ctx), &types.QueryInterchainAccountRequest{Owner: newOwner, ConnectionId: path.EndpointA.ConnectionID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(interchainAccountAddr, queryRes.Address)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(sdk.WrapSDKContext(ctx), &types.QueryInterchainAccountRequest{Owner: TestOwnerAddress, ConnectionId: path.EndpointA.ConnectionID})
+	suite.Require().Error(err)
+
+	icaMsg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{icaMsg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Minute).UnixNano())
+
+	// the previous owner can no longer send transactions
+	_, err = msgServer.SendTx(ctx, types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, clienttypes.ZeroHeight(), timeoutTimestamp, packetData))
+	suite.Require().ErrorIs(err, types.ErrAccountOwnershipTransferred)
+
+	sendTxRes, err := msgServer.SendTx(ctx, types.NewMsgSendTx(newOwner, path.EndpointA.ConnectionID, clienttypes.ZeroHeight(), timeoutTimestamp, packetData))
+	suite.Require().NoError(err)
+
+	// the packet is sent over the channel of the original controller port and owned by the new owner
+	packetOwner, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketOwner(ctx, TestPortID, path.EndpointA.ChannelID, sendTxRes.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(newOwner, packetOwner)
+}
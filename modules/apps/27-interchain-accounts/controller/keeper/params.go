@@ -14,9 +14,16 @@ func (k Keeper) IsControllerEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetCallbackGasLimit retrieves the maximum amount of gas an owner callback may consume from the paramstore
+func (k Keeper) GetCallbackGasLimit(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyCallbackGasLimit, &res)
+	return res
+}
+
 // GetParams returns the total set of the controller submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsControllerEnabled(ctx))
+	return types.NewParams(k.IsControllerEnabled(ctx), k.GetCallbackGasLimit(ctx))
 }
 
 // SetParams sets the total set of the controller submodule parameters.
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
@@ -14,9 +15,21 @@ import (
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
 // The packet sequence for the outgoing packet is returned as a result.
 // If the base application has the capability to send on the provided portID. An appropriate
-// absolute timeoutTimestamp must be provided. If the packet is timed out, the channel will be closed.
+// absolute timeoutTimestamp must be provided. If the packet is timed out on an ORDERED channel, the channel will be closed.
 // In the case of channel closure, a new channel may be reopened to reconnect to the host chain.
+// Interchain accounts whose ownership has been transferred may only be controlled using the controller msg server,
+// and an error is returned for their controller portID.
 func (k Keeper) SendTx(ctx sdk.Context, _ *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
+	if k.IsOwnershipTransferred(ctx, connectionID, portID) {
+		return 0, sdkerrors.Wrapf(types.ErrAccountOwnershipTransferred, "interchain account on port %s and connection %s must be controlled using MsgSendTx", portID, connectionID)
+	}
+
+	return k.sendTx(ctx, connectionID, portID, icaPacketData, timeoutTimestamp)
+}
+
+// sendTx sends a packet containing the provided packet data over the active channel of the provided connectionID and
+// controller portID, storing the owner of the interchain account as the owner of the packet.
+func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if !found {
 		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
@@ -39,7 +52,15 @@ func (k Keeper) SendTx(ctx sdk.Context, _ *capabilitytypes.Capability, connectio
 		return 0, icatypes.ErrInvalidTimeoutTimestamp
 	}
 
-	return k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData, timeoutTimestamp)
+	sequence, err := k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	// the owner of the packet is stored until the packet is acknowledged or timed out, to route its callbacks
+	k.SetPacketOwner(ctx, portID, activeChannelID, sequence, k.GetInterchainAccountOwner(ctx, connectionID, portID))
+
+	return sequence, nil
 }
 
 func (k Keeper) createOutgoingPacket(
@@ -80,8 +101,104 @@ func (k Keeper) createOutgoingPacket(
 	return packet.Sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// OnAcknowledgementPacket invokes the OnAcknowledgement callback registered for the owner of the provided packet, if any.
+// The packet owner stored in SendTx is removed.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	owner, found := k.GetPacketOwner(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePacketOwner(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if cbs, found := k.callbacksRouter.GetRoute(owner); found {
+		k.executeCallback(ctx, owner, func(cacheCtx sdk.Context) error {
+			return cbs.OnAcknowledgement(cacheCtx, packet, acknowledgement)
+		})
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket invokes the OnTimeout callback registered for the owner of the provided packet, if any. The packet
+// owner stored in SendTx is removed. The underlying channel end is closed due to the semantics of ORDERED channels.
+// UNORDERED channels remain open and may continue to be used by the interchain account
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	owner, found := k.GetPacketOwner(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePacketOwner(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if cbs, found := k.callbacksRouter.GetRoute(owner); found {
+		k.executeCallback(ctx, owner, func(cacheCtx sdk.Context) error {
+			return cbs.OnTimeout(cacheCtx, packet)
+		})
+	}
+
 	return nil
 }
+
+// executeCallback runs the provided owner callback on a branched context, which is only written if the callback
+// succeeds. The callback is limited to the CallbackGasLimit param and the gas it consumes is charged to the
+// transaction. Errors returned and panics raised by the callback, including running out of its own gas limit, are
+// logged, so that a faulty callback cannot prevent the completion of the packet lifecycle. Running out of the gas of
+// the transaction itself is not recovered.
+func (k Keeper) executeCallback(ctx sdk.Context, owner string, callback func(cacheCtx sdk.Context) error) {
+	gasLimit := k.GetCallbackGasLimit(ctx)
+	cacheCtx, writeCache := ctx.CacheContext()
+	limitedCtx := cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		r := recover()
+
+		// NOTE: an out of gas panic raised by the gas meter of the transaction is propagated
+		ctx.GasMeter().ConsumeGas(limitedCtx.GasMeter().GasConsumedToLimit(), "interchain accounts controller callback")
+
+		if r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				k.Logger(ctx).Error("interchain accounts controller callback exceeded gas limit", "owner", owner, "gas-limit", gasLimit)
+				return
+			}
+
+			k.Logger(ctx).Error("interchain accounts controller callback panicked", "owner", owner, "panic", r)
+		}
+	}()
+
+	if err := callback(limitedCtx); err != nil {
+		k.Logger(ctx).Error("interchain accounts controller callback failed", "owner", owner, "error", err)
+		return
+	}
+
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(limitedCtx.EventManager().Events())
+	writeCache()
+}
+
+// DecodeQueryAcknowledgement decodes the acknowledgement of a query packet sent over the provided channel into the
+// responses of the queries executed on the host chain. The responses are decoded using the encoding of the channel
+// and an error is returned if the host chain failed to execute the queries.
+func (k Keeper) DecodeQueryAcknowledgement(ctx sdk.Context, portID, channelID string, acknowledgement []byte) (icatypes.CosmosQueryResponse, error) {
+	appVersion, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return icatypes.CosmosQueryResponse{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve application version of channel %s on port %s", channelID, portID)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(appVersion)
+	if err != nil {
+		return icatypes.CosmosQueryResponse{}, err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return icatypes.CosmosQueryResponse{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	result, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	if !ok {
+		return icatypes.CosmosQueryResponse{}, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "queries failed on the host chain: %s", ack.GetError())
+	}
+
+	return icatypes.DeserializeCosmosQueryResponse(k.cdc, result.Result, metadata.Encoding)
+}
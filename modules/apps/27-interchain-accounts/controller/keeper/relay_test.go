@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
@@ -34,7 +38,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -63,7 +67,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					},
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgsBankSend)
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgsBankSend, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -128,7 +132,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -216,3 +220,325 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDecodeQueryAcknowledgement() {
+	var (
+		path            *ibctesting.Path
+		acknowledgement []byte
+	)
+
+	queryResponse := icatypes.CosmosQueryResponse{
+		Responses: []icatypes.QueryResponse{
+			{Data: []byte("response")},
+		},
+		Height: 10,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = "channel-100"
+			},
+			false,
+		},
+		{
+			"cannot unmarshal acknowledgement",
+			func() {
+				acknowledgement = []byte("invalid-acknowledgement")
+			},
+			false,
+		},
+		{
+			"error acknowledgement",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement(icatypes.ErrInvalidRoute).Acknowledgement()
+			},
+			false,
+		},
+		{
+			"cannot decode query response",
+			func() {
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("invalid-query-response")).Acknowledgement()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			bz := suite.chainA.GetSimApp().AppCodec().MustMarshal(&queryResponse)
+			acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+
+			tc.malleate() // malleate mutates test data
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.DecodeQueryAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, acknowledgement)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(queryResponse, res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(icatypes.CosmosQueryResponse{}, res)
+			}
+		})
+	}
+}
+
+// mockCallbacks implements the ControllerCallbacks interface using overridable functions
+type mockCallbacks struct {
+	onAcknowledgement func(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error
+	onTimeout         func(ctx sdk.Context, packet channeltypes.Packet) error
+}
+
+func (cbs *mockCallbacks) OnAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	return cbs.onAcknowledgement(ctx, packet, acknowledgement)
+}
+
+func (cbs *mockCallbacks) OnTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	return cbs.onTimeout(ctx, packet)
+}
+
+func (suite *KeeperTestSuite) TestPacketCallbacks() {
+	var (
+		path       *ibctesting.Path
+		callbacks  *mockCallbacks
+		invoked    bool
+		expWritten bool
+	)
+
+	// the callback writes to the store and emits an event, which are discarded if the callback fails
+	callbackKey := []byte("callback")
+	callbackEvent := sdk.NewEvent("callback")
+	succeed := func(ctx sdk.Context) error {
+		invoked = true
+		ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)).Set(callbackKey, []byte{0x01})
+		ctx.EventManager().EmitEvent(callbackEvent)
+		return nil
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {
+				callbacks.onAcknowledgement = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte) error { return succeed(ctx) }
+				callbacks.onTimeout = func(ctx sdk.Context, _ channeltypes.Packet) error { return succeed(ctx) }
+				expWritten = true
+			},
+		},
+		{
+			"callback fails",
+			func() {
+				callbacks.onAcknowledgement = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte) error {
+					_ = succeed(ctx)
+					return fmt.Errorf("callback failed")
+				}
+				callbacks.onTimeout = func(ctx sdk.Context, _ channeltypes.Packet) error {
+					_ = succeed(ctx)
+					return fmt.Errorf("callback failed")
+				}
+			},
+		},
+		{
+			"callback panics",
+			func() {
+				callbacks.onAcknowledgement = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte) error {
+					_ = succeed(ctx)
+					panic("callback panicked")
+				}
+				callbacks.onTimeout = func(ctx sdk.Context, _ channeltypes.Packet) error {
+					_ = succeed(ctx)
+					panic("callback panicked")
+				}
+			},
+		},
+		{
+			"callback exceeds the callback gas limit",
+			func() {
+				callbacks.onAcknowledgement = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte) error {
+					_ = succeed(ctx)
+					ctx.GasMeter().ConsumeGas(types.DefaultCallbackGasLimit, "callback")
+					return nil
+				}
+				callbacks.onTimeout = func(ctx sdk.Context, _ channeltypes.Packet) error {
+					_ = succeed(ctx)
+					ctx.GasMeter().ConsumeGas(types.DefaultCallbackGasLimit, "callback")
+					return nil
+				}
+			},
+		},
+		{
+			"callback exceeds the callback gas limit param",
+			func() {
+				params := types.DefaultParams()
+				params.CallbackGasLimit = 10_000
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				callbacks.onAcknowledgement = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte) error {
+					_ = succeed(ctx)
+					ctx.GasMeter().ConsumeGas(params.CallbackGasLimit, "callback")
+					return nil
+				}
+				callbacks.onTimeout = func(ctx sdk.Context, _ channeltypes.Packet) error {
+					_ = succeed(ctx)
+					ctx.GasMeter().ConsumeGas(params.CallbackGasLimit, "callback")
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, timeout := range []bool{false, true} {
+			tc := tc
+			timeout := timeout
+
+			suite.Run(fmt.Sprintf("%s (timeout: %t)", tc.name, timeout), func() {
+				suite.SetupTest() // reset
+				invoked, expWritten = false, false
+
+				path = NewICAPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				callbacks = &mockCallbacks{}
+				suite.chainA.GetSimApp().ICAControllerKeeper.GetCallbacksRouter().AddRoute(TestOwnerAddress, callbacks)
+
+				tc.malleate() // malleate mutates test data
+
+				packetData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				}
+
+				ctx := suite.chainA.GetContext()
+				timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Minute).UnixNano())
+				sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(ctx, nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+				suite.Require().NoError(err)
+
+				owner, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketOwner(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(TestOwnerAddress, owner)
+
+				packet := channeltypes.NewPacket(
+					packetData.GetBytes(),
+					sequence,
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.ZeroHeight(),
+					timeoutTimestamp,
+				)
+
+				if timeout {
+					err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx, packet)
+				} else {
+					ack := channeltypes.NewResultAcknowledgement([]byte("result"))
+					err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement())
+				}
+
+				// callback failures do not prevent the completion of the packet lifecycle
+				suite.Require().NoError(err)
+				suite.Require().True(invoked)
+
+				_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetPacketOwner(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().False(found)
+
+				written := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)).Has(callbackKey)
+				suite.Require().Equal(expWritten, written)
+				suite.Require().Equal(expWritten, containsEvent(ctx.EventManager().Events(), callbackEvent))
+
+				// the callback is only invoked once
+				invoked = false
+				if timeout {
+					err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx, packet)
+				} else {
+					err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(ctx, packet, []byte("ack"))
+				}
+
+				suite.Require().NoError(err)
+				suite.Require().False(invoked)
+			})
+		}
+	}
+}
+
+// TestPacketCallbacksGasConsumption asserts the gas consumed by a callback is charged to the transaction and that
+// running out of the gas of the transaction is not recovered
+func (suite *KeeperTestSuite) TestPacketCallbacksGasConsumption() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	callbackGas := uint64(50000)
+	callbacks := &mockCallbacks{
+		onTimeout: func(ctx sdk.Context, _ channeltypes.Packet) error {
+			ctx.GasMeter().ConsumeGas(callbackGas, "callback")
+			return nil
+		},
+	}
+	suite.chainA.GetSimApp().ICAControllerKeeper.GetCallbacksRouter().AddRoute(TestOwnerAddress, callbacks)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	ctx := suite.chainA.GetContext()
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Minute).UnixNano())
+
+	sendPacket := func() channeltypes.Packet {
+		sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(ctx, nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+		suite.Require().NoError(err)
+
+		return channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	}
+
+	// the gas consumed by the callback is charged to the transaction
+	packet := sendPacket()
+	gasMeter := sdk.NewInfiniteGasMeter()
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx.WithGasMeter(gasMeter), packet)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(gasMeter.GasConsumed(), callbackGas)
+
+	// running out of the gas of the transaction panics
+	packet = sendPacket()
+	suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "interchain accounts controller callback"}, func() {
+		_ = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx.WithGasMeter(sdk.NewGasMeter(callbackGas)), packet)
+	})
+}
+
+func containsEvent(events sdk.Events, event sdk.Event) bool {
+	for _, e := range events {
+		if e.Type == event.Type {
+			return true
+		}
+	}
+
+	return false
+}
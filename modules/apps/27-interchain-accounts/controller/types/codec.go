@@ -11,5 +11,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
+		&MsgTransferInterchainAccountOwnership{},
 	)
 }
@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty" yaml:"controller_enabled"`
+	// callback_gas_limit defines the maximum amount of gas which may be consumed by an owner callback invoked on the
+	// acknowledgement or timeout of a packet sent using SendTx.
+	CallbackGasLimit uint64 `protobuf:"varint,2,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty" yaml:"callback_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +73,13 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
 }
@@ -79,23 +89,26 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0xce, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe2,
-	0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x19, 0x65, 0x26, 0x25, 0xeb, 0x21, 0x1b, 0xa2, 0x87,
-	0xc5, 0x10, 0x3d, 0x24, 0x6d, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xed, 0xfa,
-	0x20, 0x16, 0xc4, 0x24, 0xa5, 0x30, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x1f,
-	0x2e, 0x21, 0x84, 0x86, 0xf8, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x0e, 0x27, 0xd9, 0x4f, 0xf7, 0xe4, 0x25, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0xd5,
-	0x28, 0x05, 0x09, 0x22, 0x04, 0x5d, 0x21, 0x62, 0x4e, 0x59, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x15, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66,
-	0xaa, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x0a, 0xa0, 0x62, 0x7d, 0x23, 0x73, 0x5d,
-	0x84, 0xb7, 0x74, 0xb1, 0x85, 0x4d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x2b, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x34, 0x46, 0x1b, 0x5b, 0x01, 0x00, 0x00,
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
+	0x14, 0x46, 0x33, 0x3f, 0x3f, 0x41, 0xb6, 0xd2, 0xc5, 0x22, 0x0a, 0x8e, 0x61, 0xab, 0x34, 0xd9,
+	0x21, 0x11, 0x11, 0x2c, 0x23, 0x62, 0x61, 0x8a, 0x90, 0xd2, 0x66, 0xb9, 0x73, 0x33, 0x6c, 0x46,
+	0x67, 0xe6, 0x2e, 0x3b, 0x93, 0x40, 0xde, 0xc2, 0x77, 0xf0, 0x65, 0x2c, 0x53, 0x5a, 0x89, 0x24,
+	0x6f, 0xe0, 0x13, 0x48, 0x12, 0x64, 0x57, 0x92, 0x6e, 0xe6, 0x70, 0xbf, 0x53, 0x9c, 0xe8, 0x4e,
+	0x4b, 0x14, 0x50, 0x14, 0x46, 0x23, 0x04, 0x4d, 0xce, 0x0b, 0xed, 0x82, 0x2a, 0x71, 0x0a, 0xda,
+	0x65, 0x80, 0x48, 0x33, 0x17, 0xbc, 0x40, 0x72, 0xa1, 0x24, 0x63, 0x54, 0x29, 0xe6, 0xbd, 0xda,
+	0x2f, 0x2d, 0x4a, 0x0a, 0x14, 0xf7, 0xb5, 0xc4, 0xb4, 0x2e, 0x49, 0x0f, 0x48, 0xd2, 0xda, 0x6c,
+	0xde, 0x3b, 0x3f, 0xcd, 0x29, 0xa7, 0xed, 0x5c, 0x6c, 0x5e, 0x3b, 0x53, 0xf2, 0xc6, 0xa2, 0xe6,
+	0x08, 0x4a, 0xb0, 0x3e, 0x1e, 0x46, 0x71, 0xb5, 0xc8, 0x94, 0x03, 0x69, 0xd4, 0xa4, 0xc5, 0xda,
+	0xac, 0x73, 0x34, 0xb8, 0xf8, 0xfe, 0xbc, 0x3c, 0x5b, 0x80, 0x35, 0xb7, 0xc9, 0xfe, 0x4d, 0x32,
+	0x3e, 0xa9, 0xe0, 0xfd, 0x8e, 0xc5, 0x8f, 0x51, 0x8c, 0x60, 0x8c, 0x04, 0x7c, 0xc9, 0x72, 0xf0,
+	0x99, 0xd1, 0x56, 0x87, 0xd6, 0xbf, 0x36, 0xeb, 0xfc, 0xff, 0x63, 0xdb, 0xbb, 0x49, 0xc6, 0xc7,
+	0xbf, 0xf0, 0x01, 0xfc, 0x70, 0x83, 0x06, 0xcf, 0xef, 0x2b, 0xce, 0x96, 0x2b, 0xce, 0xbe, 0x56,
+	0x9c, 0xbd, 0xae, 0x79, 0x63, 0xb9, 0xe6, 0x8d, 0x8f, 0x35, 0x6f, 0x3c, 0x8d, 0x72, 0x1d, 0xa6,
+	0x33, 0x99, 0x22, 0x59, 0x81, 0xe4, 0x2d, 0x79, 0xa1, 0x25, 0x76, 0x73, 0x12, 0xf3, 0x6b, 0x61,
+	0x69, 0x32, 0x33, 0xca, 0x6f, 0x72, 0x7b, 0xd1, 0xbf, 0xe9, 0x56, 0x91, 0xba, 0x87, 0x4a, 0x87,
+	0x45, 0xa1, 0xbc, 0x6c, 0x6e, 0xc3, 0x5c, 0xfd, 0x0c, 0x00, 0x45, 0x11, 0x4a, 0xcf, 0xa9, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -118,6 +131,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -151,6 +169,9 @@ func (m *Params) Size() (n int) {
 	if m.ControllerEnabled {
 		n += 2
 	}
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovController(uint64(m.CallbackGasLimit))
+	}
 	return n
 }
 
@@ -209,6 +230,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
@@ -7,4 +7,6 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 1, "controller submodule is disabled")
+	ErrAccountOwnershipTransferred = sdkerrors.Register(SubModuleName, 2, "interchain account ownership has been transferred")
+	ErrInvalidOwnershipTransfer    = sdkerrors.Register(SubModuleName, 3, "invalid interchain account ownership transfer")
 )
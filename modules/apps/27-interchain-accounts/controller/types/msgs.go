@@ -12,7 +12,11 @@ import (
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgRegisterInterchainAccount{}
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSendTx{}
+	_ sdk.Msg = &MsgTransferInterchainAccountOwnership{}
+)
 
 // NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
 func NewMsgRegisterInterchainAccount(connectionID, owner, version string) *MsgRegisterInterchainAccount {
@@ -37,6 +41,12 @@ func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse owner address: %s", msg.Owner)
 	}
 
+	switch msg.Ordering {
+	case channelerrors.NONE, channelerrors.ORDERED, channelerrors.UNORDERED:
+	default:
+		return sdkerrors.Wrapf(channelerrors.ErrInvalidChannelOrdering, "unsupported channel ordering %s", msg.Ordering)
+	}
+
 	return nil
 }
 
@@ -95,3 +105,43 @@ func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{accAddr}
 }
+
+// NewMsgTransferInterchainAccountOwnership creates a new instance of MsgTransferInterchainAccountOwnership
+func NewMsgTransferInterchainAccountOwnership(owner, connectionID, newOwner string) *MsgTransferInterchainAccountOwnership {
+	return &MsgTransferInterchainAccountOwnership{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		NewOwner:     newOwner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferInterchainAccountOwnership) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse owner address: %s", msg.Owner)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse new owner address: %s", msg.NewOwner)
+	}
+
+	if msg.Owner == msg.NewOwner {
+		return sdkerrors.Wrap(ErrInvalidOwnershipTransfer, "new owner must differ from the current owner")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransferInterchainAccountOwnership) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
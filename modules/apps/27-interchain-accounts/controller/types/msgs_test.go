@@ -6,11 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	feetypes "github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 	"github.com/cosmos/ibc-go/v5/testing/simapp"
 )
@@ -48,6 +50,20 @@ func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"success: with UNORDERED channel ordering",
+			func() {
+				msg.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"invalid channel ordering",
+			func() {
+				msg.Ordering = channeltypes.Order(100)
+			},
+			false,
+		},
 		{
 			"connection id is invalid",
 			func() {
@@ -156,7 +172,7 @@ func TestMsgSendTxValidateBasic(t *testing.T) {
 			Amount:      ibctesting.TestCoins,
 		}
 
-		data, err := icatypes.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []sdk.Msg{msgBankSend})
+		data, err := icatypes.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []sdk.Msg{msgBankSend}, icatypes.EncodingProtobuf)
 		require.NoError(t, err)
 
 		packetData := icatypes.InterchainAccountPacketData{
@@ -193,7 +209,7 @@ func TestMsgSendTxGetSigners(t *testing.T) {
 		Amount:      ibctesting.TestCoins,
 	}
 
-	data, err := icatypes.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []sdk.Msg{msgBankSend})
+	data, err := icatypes.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []sdk.Msg{msgBankSend}, icatypes.EncodingProtobuf)
 	require.NoError(t, err)
 
 	packetData := icatypes.InterchainAccountPacketData{
@@ -210,3 +226,68 @@ func TestMsgSendTxGetSigners(t *testing.T) {
 	)
 	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
 }
+
+func TestMsgTransferInterchainAccountOwnershipValidateBasic(t *testing.T) {
+	var msg *types.MsgTransferInterchainAccountOwnership
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"new owner address is invalid",
+			func() {
+				msg.NewOwner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"new owner equals owner",
+			func() {
+				msg.NewOwner = msg.Owner
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, sdk.AccAddress(crypto.AddressHash([]byte("new-owner"))).String())
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgTransferInterchainAccountOwnershipGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, sdk.AccAddress(crypto.AddressHash([]byte("new-owner"))).String())
+	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
+}
@@ -9,10 +9,16 @@ import (
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultCallbackGasLimit is the default value for the callback gas limit param
+	DefaultCallbackGasLimit = uint64(1_000_000)
 )
 
-// KeyControllerEnabled is the store key for ControllerEnabled Params
-var KeyControllerEnabled = []byte("ControllerEnabled")
+var (
+	// KeyControllerEnabled is the store key for ControllerEnabled Params
+	KeyControllerEnabled = []byte("ControllerEnabled")
+	// KeyCallbackGasLimit is the store key for the CallbackGasLimit Params
+	KeyCallbackGasLimit = []byte("CallbackGasLimit")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
@@ -20,15 +26,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the controller submodule
-func NewParams(enableController bool) Params {
+func NewParams(enableController bool, callbackGasLimit uint64) Params {
 	return Params{
 		ControllerEnabled: enableController,
+		CallbackGasLimit:  callbackGasLimit,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the controller submodule
 func DefaultParams() Params {
-	return NewParams(DefaultControllerEnabled)
+	return NewParams(DefaultControllerEnabled, DefaultCallbackGasLimit)
 }
 
 // Validate validates all controller submodule parameters
@@ -37,6 +44,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCallbackGasLimit(p.CallbackGasLimit); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -44,6 +55,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyControllerEnabled, p.ControllerEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyCallbackGasLimit, p.CallbackGasLimit, validateCallbackGasLimit),
 	}
 }
 
@@ -55,3 +67,16 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateCallbackGasLimit(i interface{}) error {
+	gasLimit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if gasLimit == 0 {
+		return fmt.Errorf("callback gas limit must be positive")
+	}
+
+	return nil
+}
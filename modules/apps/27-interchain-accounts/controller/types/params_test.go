@@ -10,5 +10,7 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false).Validate())
+	require.NoError(t, types.NewParams(false, types.DefaultCallbackGasLimit).Validate())
+	require.NoError(t, types.NewParams(true, 1).Validate())
+	require.Error(t, types.NewParams(true, 0).Validate())
 }
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// ControllerCallbacks defines the callbacks invoked on the owner of an interchain account once a packet
+// sent using SendTx has been acknowledged or has timed out. Errors returned by the callbacks are logged and
+// any state changes made by a failing callback are discarded.
+type ControllerCallbacks interface {
+	OnAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error
+	OnTimeout(ctx sdk.Context, packet channeltypes.Packet) error
+}
+
+// CallbacksRouter is a map from interchain account owner to the ControllerCallbacks of the owner
+type CallbacksRouter struct {
+	routes map[string]ControllerCallbacks
+	sealed bool
+}
+
+// NewCallbacksRouter returns an empty CallbacksRouter
+func NewCallbacksRouter() *CallbacksRouter {
+	return &CallbacksRouter{
+		routes: make(map[string]ControllerCallbacks),
+	}
+}
+
+// Seal prevents the CallbacksRouter from any subsequent route handlers to be registered.
+// Seal will panic if called more than once.
+func (rtr *CallbacksRouter) Seal() {
+	if rtr.sealed {
+		panic("callbacks router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the CallbacksRouter is sealed or not.
+func (rtr CallbacksRouter) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute adds the ControllerCallbacks for a given interchain account owner. It returns the CallbacksRouter
+// so AddRoute calls can be linked. It will panic if the CallbacksRouter is sealed.
+func (rtr *CallbacksRouter) AddRoute(owner string, cbs ControllerCallbacks) *CallbacksRouter {
+	if rtr.sealed {
+		panic(fmt.Sprintf("callbacks router sealed; cannot register %s route callbacks", owner))
+	}
+	if strings.TrimSpace(owner) == "" {
+		panic("callbacks route owner cannot be blank")
+	}
+	if rtr.HasRoute(owner) {
+		panic(fmt.Sprintf("callbacks route %s has already been registered", owner))
+	}
+
+	rtr.routes[owner] = cbs
+	return rtr
+}
+
+// HasRoute returns true if the CallbacksRouter has callbacks registered for the owner or false otherwise.
+func (rtr *CallbacksRouter) HasRoute(owner string) bool {
+	_, ok := rtr.routes[owner]
+	return ok
+}
+
+// GetRoute returns the ControllerCallbacks for a given owner.
+func (rtr *CallbacksRouter) GetRoute(owner string) (ControllerCallbacks, bool) {
+	if !rtr.HasRoute(owner) {
+		return nil, false
+	}
+	return rtr.routes[owner], true
+}
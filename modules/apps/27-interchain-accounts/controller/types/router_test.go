@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+type noopCallbacks struct{}
+
+func (noopCallbacks) OnAcknowledgement(sdk.Context, channeltypes.Packet, []byte) error { return nil }
+func (noopCallbacks) OnTimeout(sdk.Context, channeltypes.Packet) error                 { return nil }
+
+func TestCallbacksRouter(t *testing.T) {
+	owner := ibctesting.TestAccAddress
+
+	rtr := types.NewCallbacksRouter()
+	require.False(t, rtr.HasRoute(owner))
+
+	rtr.AddRoute(owner, noopCallbacks{})
+	require.True(t, rtr.HasRoute(owner))
+
+	cbs, found := rtr.GetRoute(owner)
+	require.True(t, found)
+	require.Equal(t, noopCallbacks{}, cbs)
+
+	_, found = rtr.GetRoute("other-owner")
+	require.False(t, found)
+
+	require.Panics(t, func() { rtr.AddRoute(owner, noopCallbacks{}) }, "duplicate route")
+	require.Panics(t, func() { rtr.AddRoute(" ", noopCallbacks{}) }, "blank owner")
+
+	rtr.Seal()
+	require.True(t, rtr.Sealed())
+	require.Panics(t, func() { rtr.AddRoute("other-owner", noopCallbacks{}) }, "sealed router")
+	require.Panics(t, func() { rtr.Seal() }, "router already sealed")
+}
@@ -6,8 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	types1 "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
-	types "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	types2 "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	types1 "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	types "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -35,6 +36,8 @@ type MsgRegisterInterchainAccount struct {
 	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
 	Version      string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// ordering of the interchain accounts channel, ORDERED if unspecified
+	Ordering types.Order `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
 }
 
 func (m *MsgRegisterInterchainAccount) Reset()         { *m = MsgRegisterInterchainAccount{} }
@@ -121,11 +124,11 @@ type MsgSendTx struct {
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
 	// Timeout height relative to the current block height.
 	// The timeout is disabled when set to 0.
-	TimeoutHeight types.Height `protobuf:"bytes,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	TimeoutHeight types1.Height `protobuf:"bytes,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
 	// Timeout timestamp in absolute nanoseconds since unix epoch.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64                             `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
-	PacketData       types1.InterchainAccountPacketData `protobuf:"bytes,5,opt,name=packet_data,json=packetData,proto3" json:"packet_data" yaml:"packet_data"`
+	PacketData       types2.InterchainAccountPacketData `protobuf:"bytes,5,opt,name=packet_data,json=packetData,proto3" json:"packet_data" yaml:"packet_data"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -206,11 +209,94 @@ func (m *MsgSendTxResponse) GetSequence() uint64 {
 	return 0
 }
 
+// MsgTransferInterchainAccountOwnership defines the payload for Msg/TransferInterchainAccountOwnership
+type MsgTransferInterchainAccountOwnership struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	NewOwner     string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgTransferInterchainAccountOwnership) Reset()         { *m = MsgTransferInterchainAccountOwnership{} }
+func (m *MsgTransferInterchainAccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferInterchainAccountOwnership) ProtoMessage()    {}
+func (*MsgTransferInterchainAccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{4}
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferInterchainAccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnership.Merge(m, src)
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferInterchainAccountOwnership proto.InternalMessageInfo
+
+// MsgTransferInterchainAccountOwnershipResponse defines the response for Msg/TransferInterchainAccountOwnership
+type MsgTransferInterchainAccountOwnershipResponse struct {
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) Reset() {
+	*m = MsgTransferInterchainAccountOwnershipResponse{}
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTransferInterchainAccountOwnershipResponse) ProtoMessage() {}
+func (*MsgTransferInterchainAccountOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{5}
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
 	proto.RegisterType((*MsgSendTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTx")
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
+	proto.RegisterType((*MsgTransferInterchainAccountOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferInterchainAccountOwnership")
+	proto.RegisterType((*MsgTransferInterchainAccountOwnershipResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferInterchainAccountOwnershipResponse")
 }
 
 func init() {
@@ -218,45 +304,52 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3d, 0x6f, 0xd4, 0x40,
-	0x10, 0xb5, 0xf3, 0x45, 0xb2, 0x21, 0x88, 0x58, 0x89, 0x64, 0xac, 0x60, 0x47, 0x16, 0x45, 0x9a,
-	0xec, 0xea, 0x8e, 0x20, 0xa4, 0xa0, 0x14, 0x58, 0x41, 0xe2, 0x8a, 0x48, 0x91, 0x49, 0x81, 0x10,
-	0xd2, 0xb1, 0xb7, 0x5e, 0xf9, 0x16, 0xec, 0x5d, 0xe3, 0xdd, 0x33, 0x49, 0x49, 0x47, 0x85, 0xe8,
-	0x28, 0x68, 0xf2, 0x6f, 0x48, 0x99, 0x92, 0xea, 0x14, 0x25, 0x0d, 0xf5, 0xfd, 0x02, 0xe4, 0x8f,
-	0xf3, 0xdd, 0x89, 0x10, 0x85, 0xaf, 0xca, 0x3b, 0xbb, 0xf3, 0x66, 0xde, 0xe8, 0x3d, 0x0f, 0x78,
-	0xc4, 0x3a, 0x04, 0xe1, 0x24, 0x89, 0x18, 0xc1, 0x8a, 0x09, 0x2e, 0x11, 0xe3, 0x8a, 0xa6, 0xa4,
-	0x8b, 0x19, 0x6f, 0x63, 0x42, 0x44, 0x8f, 0x2b, 0x89, 0x88, 0xe0, 0x2a, 0x15, 0x51, 0x44, 0x53,
-	0x94, 0x35, 0x90, 0x3a, 0x84, 0x49, 0x2a, 0x94, 0x30, 0x9a, 0xac, 0x43, 0xe0, 0x38, 0x18, 0x5e,
-	0x02, 0x86, 0x23, 0x30, 0xcc, 0x1a, 0xd6, 0x4a, 0x28, 0x42, 0x51, 0xc0, 0x51, 0x7e, 0x2a, 0x2b,
-	0x59, 0x4e, 0x4e, 0x83, 0x88, 0x94, 0x22, 0x12, 0x31, 0xca, 0x55, 0xde, 0xa6, 0x3c, 0x55, 0x09,
-	0x5b, 0xd7, 0xe2, 0x99, 0x35, 0x50, 0x82, 0xc9, 0x1b, 0x5a, 0xa1, 0xdc, 0xcf, 0x3a, 0x58, 0xdb,
-	0x93, 0xa1, 0x4f, 0x43, 0x26, 0x15, 0x4d, 0x5b, 0x35, 0xe4, 0x71, 0x89, 0x30, 0x56, 0xc0, 0xac,
-	0x78, 0xc7, 0x69, 0x6a, 0xea, 0xeb, 0xfa, 0xc6, 0x82, 0x5f, 0x06, 0xc6, 0x0e, 0x58, 0x22, 0x82,
-	0x73, 0x4a, 0xf2, 0x4e, 0x6d, 0x16, 0x98, 0x53, 0xf9, 0xab, 0x67, 0x0e, 0xfa, 0xce, 0xca, 0x11,
-	0x8e, 0xa3, 0x6d, 0x77, 0xe2, 0xd9, 0xf5, 0x6f, 0x8e, 0xe2, 0x56, 0x60, 0x98, 0xe0, 0x46, 0x46,
-	0x53, 0xc9, 0x04, 0x37, 0xa7, 0x8b, 0xb2, 0xc3, 0x70, 0x7b, 0xfe, 0xc3, 0xb1, 0xa3, 0x7d, 0x3f,
-	0x76, 0x34, 0xf7, 0x25, 0xb8, 0x77, 0x15, 0x31, 0x9f, 0xca, 0x44, 0x70, 0x49, 0x8d, 0x2d, 0x00,
-	0x48, 0x17, 0x73, 0x4e, 0xa3, 0x9c, 0x47, 0xc1, 0xd2, 0x5b, 0x1d, 0xf4, 0x9d, 0xe5, 0x8a, 0x47,
-	0xfd, 0xe6, 0xfa, 0x0b, 0x55, 0xd0, 0x0a, 0xdc, 0x2f, 0xd3, 0x60, 0x61, 0x4f, 0x86, 0xcf, 0x28,
-	0x0f, 0x0e, 0x0e, 0xff, 0xcf, 0x90, 0xaf, 0xc0, 0x2d, 0xc5, 0x62, 0x2a, 0x7a, 0xaa, 0xdd, 0xa5,
-	0x2c, 0xec, 0xaa, 0x62, 0xd6, 0xc5, 0xa6, 0x05, 0x73, 0x53, 0xe4, 0x52, 0xc2, 0x4a, 0xc0, 0xac,
-	0x01, 0x9f, 0x16, 0x19, 0xde, 0xdd, 0x93, 0xbe, 0xa3, 0x0d, 0xfa, 0xce, 0x6a, 0x59, 0x7f, 0x12,
-	0xef, 0xfa, 0x4b, 0xd5, 0x45, 0x99, 0x6d, 0xb4, 0xc0, 0xf2, 0x30, 0x23, 0xff, 0x4a, 0x85, 0xe3,
-	0xc4, 0x9c, 0x59, 0xd7, 0x37, 0x66, 0xbc, 0xb5, 0x41, 0xdf, 0x31, 0x27, 0x8b, 0xd4, 0x29, 0xae,
-	0x7f, 0xbb, 0xba, 0x3b, 0x18, 0x5e, 0x19, 0xef, 0x75, 0xb0, 0x58, 0x1a, 0xa3, 0x1d, 0x60, 0x85,
-	0xcd, 0xd9, 0x82, 0xea, 0x2e, 0xbc, 0x96, 0x7f, 0xb3, 0x06, 0xfc, 0x49, 0x9f, 0xfd, 0xa2, 0xd8,
-	0x2e, 0x56, 0xd8, 0xb3, 0xaa, 0xa1, 0x8c, 0x92, 0xcf, 0x58, 0x1b, 0xd7, 0x07, 0x49, 0x9d, 0x37,
-	0xa6, 0x3d, 0x02, 0xcb, 0xb5, 0x38, 0xb5, 0xd0, 0x16, 0x98, 0x97, 0xf4, 0x6d, 0x8f, 0x72, 0x42,
-	0x0b, 0x9d, 0x66, 0xfc, 0x3a, 0x6e, 0x9e, 0x4d, 0x81, 0xe9, 0x3d, 0x19, 0x1a, 0x5f, 0x75, 0x70,
-	0xe7, 0xd7, 0x5e, 0xde, 0x87, 0xbf, 0xff, 0x3b, 0xc2, 0xab, 0x4c, 0x68, 0x3d, 0xff, 0xd7, 0x15,
-	0xeb, 0x69, 0x3f, 0xea, 0x60, 0xae, 0x72, 0xe7, 0xce, 0x1f, 0x36, 0x29, 0xe1, 0xd6, 0x93, 0xbf,
-	0x82, 0x0f, 0x09, 0x79, 0xaf, 0x4f, 0xce, 0x6d, 0xfd, 0xf4, 0xdc, 0xd6, 0xcf, 0xce, 0x6d, 0xfd,
-	0xd3, 0x85, 0xad, 0x9d, 0x5e, 0xd8, 0xda, 0xb7, 0x0b, 0x5b, 0x7b, 0xb1, 0x1f, 0x32, 0xd5, 0xed,
-	0x75, 0x20, 0x11, 0x31, 0x22, 0x42, 0xc6, 0x42, 0x22, 0xd6, 0x21, 0x9b, 0xa1, 0x40, 0xd9, 0x03,
-	0x14, 0x8b, 0xa0, 0x17, 0x51, 0x99, 0x6f, 0x26, 0x89, 0x9a, 0x0f, 0x37, 0x47, 0xad, 0x37, 0x2f,
-	0x5b, 0x9e, 0xea, 0x28, 0xa1, 0xb2, 0x33, 0x57, 0x2c, 0xa7, 0xfb, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xc9, 0xf9, 0x08, 0x9e, 0x7c, 0x05, 0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0x13, 0x39,
+	0x18, 0xce, 0x6c, 0xd3, 0x6e, 0xe2, 0x6e, 0xab, 0x76, 0x94, 0x4a, 0xb3, 0xa3, 0x6e, 0xa6, 0x3b,
+	0x02, 0xa9, 0x97, 0xd8, 0x4a, 0x28, 0x20, 0x15, 0xf5, 0x40, 0x54, 0x24, 0x72, 0x88, 0x5a, 0x0d,
+	0x3d, 0x00, 0x42, 0x0a, 0x8e, 0x63, 0x26, 0x86, 0xc4, 0x1e, 0xc6, 0xce, 0xb4, 0x3d, 0x72, 0xe3,
+	0x84, 0xb8, 0x73, 0xe9, 0xdf, 0xe0, 0x07, 0x20, 0x7a, 0xa3, 0x47, 0x4e, 0x11, 0x4a, 0x2f, 0x9c,
+	0xf3, 0x0b, 0xd0, 0x7c, 0x64, 0xd2, 0x88, 0x52, 0x42, 0xa1, 0xa7, 0xf1, 0x6b, 0x3f, 0xcf, 0xf3,
+	0x7e, 0xf9, 0x1d, 0x83, 0x3b, 0xac, 0x49, 0x10, 0xf6, 0xbc, 0x0e, 0x23, 0x58, 0x31, 0xc1, 0x25,
+	0x62, 0x5c, 0x51, 0x9f, 0xb4, 0x31, 0xe3, 0x0d, 0x4c, 0x88, 0xe8, 0x71, 0x25, 0x11, 0x11, 0x5c,
+	0xf9, 0xa2, 0xd3, 0xa1, 0x3e, 0x0a, 0xca, 0x48, 0x1d, 0x40, 0xcf, 0x17, 0x4a, 0xe8, 0x15, 0xd6,
+	0x24, 0xf0, 0x2c, 0x19, 0x9e, 0x43, 0x86, 0x63, 0x32, 0x0c, 0xca, 0x66, 0xc1, 0x15, 0xae, 0x88,
+	0xe8, 0x28, 0x5c, 0xc5, 0x4a, 0xa6, 0x15, 0x86, 0x41, 0x84, 0x4f, 0x11, 0xe9, 0x30, 0xca, 0x55,
+	0xe8, 0x26, 0x5e, 0x25, 0x80, 0xff, 0xc7, 0x80, 0x36, 0xe6, 0x9c, 0x76, 0x22, 0x44, 0xbc, 0x4c,
+	0x20, 0x1b, 0x53, 0xa5, 0x12, 0x94, 0x91, 0x87, 0xc9, 0x0b, 0x9a, 0x08, 0xdb, 0x9f, 0x34, 0xb0,
+	0x5a, 0x97, 0xae, 0x43, 0x5d, 0x26, 0x15, 0xf5, 0x6b, 0x29, 0xe5, 0x6e, 0xcc, 0xd0, 0x0b, 0x60,
+	0x56, 0xec, 0x73, 0xea, 0x1b, 0xda, 0x9a, 0xb6, 0x9e, 0x77, 0x62, 0x43, 0xdf, 0x02, 0x0b, 0x44,
+	0x70, 0x4e, 0x49, 0xe8, 0xa9, 0xc1, 0x5a, 0xc6, 0x5f, 0xe1, 0x69, 0xd5, 0x18, 0xf6, 0xad, 0xc2,
+	0x21, 0xee, 0x76, 0x36, 0xed, 0x89, 0x63, 0xdb, 0xf9, 0x67, 0x6c, 0xd7, 0x5a, 0xba, 0x01, 0xfe,
+	0x0e, 0xa8, 0x2f, 0x99, 0xe0, 0xc6, 0x4c, 0x24, 0x3b, 0x32, 0xf5, 0x5b, 0x20, 0x27, 0xfc, 0x16,
+	0xf5, 0x19, 0x77, 0x8d, 0xec, 0x9a, 0xb6, 0xbe, 0x58, 0x31, 0x61, 0x58, 0xe6, 0x30, 0x77, 0x38,
+	0x4a, 0x38, 0x28, 0xc3, 0x9d, 0x10, 0xe4, 0xa4, 0xd8, 0xcd, 0xdc, 0xeb, 0x23, 0x2b, 0xf3, 0xf5,
+	0xc8, 0xca, 0xd8, 0x4f, 0xc0, 0xb5, 0x8b, 0x12, 0x72, 0xa8, 0xf4, 0x04, 0x97, 0x54, 0xdf, 0x00,
+	0x20, 0xd1, 0x0b, 0xe3, 0x8f, 0xb2, 0xab, 0xae, 0x0c, 0xfb, 0xd6, 0x72, 0x12, 0x7f, 0x7a, 0x66,
+	0x3b, 0xf9, 0xc4, 0xa8, 0xb5, 0xec, 0x77, 0x33, 0x20, 0x5f, 0x97, 0xee, 0x03, 0xca, 0x5b, 0x7b,
+	0x07, 0x57, 0x53, 0x9c, 0xa7, 0x60, 0x51, 0xb1, 0x2e, 0x15, 0x3d, 0xd5, 0x68, 0x53, 0xe6, 0xb6,
+	0x55, 0x54, 0xa3, 0xf9, 0x89, 0x42, 0xc4, 0x77, 0x23, 0x28, 0xc3, 0xfb, 0x11, 0xa2, 0xfa, 0xdf,
+	0x71, 0xdf, 0xca, 0x0c, 0xfb, 0xd6, 0x4a, 0xac, 0x3f, 0xc9, 0xb7, 0x9d, 0x85, 0x64, 0x23, 0x46,
+	0xeb, 0x35, 0xb0, 0x3c, 0x42, 0x84, 0x5f, 0xa9, 0x70, 0xd7, 0x8b, 0xaa, 0x9d, 0xad, 0xae, 0x0e,
+	0xfb, 0x96, 0x31, 0x29, 0x92, 0x42, 0x6c, 0x67, 0x29, 0xd9, 0xdb, 0x1b, 0x6d, 0xe9, 0xaf, 0x34,
+	0x30, 0x1f, 0x5f, 0xa8, 0x46, 0x0b, 0x2b, 0x6c, 0xcc, 0x46, 0xa1, 0x6e, 0xc3, 0xa9, 0x46, 0x23,
+	0x28, 0xc3, 0xef, 0xfa, 0xb3, 0x1b, 0x89, 0x6d, 0x63, 0x85, 0xab, 0x66, 0x92, 0x94, 0x1e, 0xc7,
+	0x73, 0xc6, 0x8d, 0xed, 0x00, 0x2f, 0xc5, 0x9d, 0xe9, 0x3d, 0x02, 0xcb, 0x69, 0x73, 0xd2, 0x46,
+	0x9b, 0x20, 0x27, 0xe9, 0xcb, 0x1e, 0xe5, 0x84, 0x46, 0x7d, 0xca, 0x3a, 0xa9, 0x6d, 0xbf, 0xd7,
+	0xc0, 0xf5, 0xba, 0x74, 0xf7, 0x7c, 0xcc, 0xe5, 0xb3, 0x73, 0x6e, 0xcb, 0x4e, 0xd8, 0x50, 0xd9,
+	0x66, 0xde, 0xd5, 0xb4, 0xba, 0x0c, 0xf2, 0x9c, 0xee, 0x37, 0x62, 0xe1, 0x68, 0x12, 0xaa, 0x85,
+	0x61, 0xdf, 0x5a, 0x8a, 0xa9, 0xe9, 0x91, 0xed, 0xe4, 0x38, 0xdd, 0x8f, 0x62, 0x99, 0x48, 0xb6,
+	0x34, 0x55, 0xe8, 0xa3, 0x42, 0x54, 0x3e, 0x64, 0xc1, 0x4c, 0x5d, 0xba, 0xfa, 0x47, 0x0d, 0xfc,
+	0xfb, 0xe3, 0x81, 0xdf, 0x85, 0xbf, 0xfe, 0x5b, 0x83, 0x17, 0x4d, 0x9c, 0xf9, 0xf0, 0x4f, 0x2b,
+	0xa6, 0xad, 0x7d, 0xa3, 0x81, 0xb9, 0x64, 0x14, 0xb7, 0x2e, 0xe9, 0x24, 0xa6, 0x9b, 0xf7, 0x7e,
+	0x8b, 0x9e, 0x06, 0x34, 0xd0, 0x80, 0x3d, 0xc5, 0x65, 0x7a, 0x74, 0x49, 0x6f, 0x3f, 0x97, 0x36,
+	0xf1, 0x95, 0x49, 0x8f, 0x92, 0xac, 0x3e, 0x3f, 0x1e, 0x14, 0xb5, 0x93, 0x41, 0x51, 0xfb, 0x32,
+	0x28, 0x6a, 0x6f, 0x4f, 0x8b, 0x99, 0x93, 0xd3, 0x62, 0xe6, 0xf3, 0x69, 0x31, 0xf3, 0x78, 0xd7,
+	0x65, 0xaa, 0xdd, 0x6b, 0x42, 0x22, 0xba, 0x88, 0x08, 0xd9, 0x15, 0x12, 0xb1, 0x26, 0x29, 0xb9,
+	0x02, 0x05, 0x37, 0x51, 0x57, 0xb4, 0x7a, 0x1d, 0x2a, 0xc3, 0x37, 0x4a, 0xa2, 0xca, 0xed, 0xd2,
+	0x38, 0xac, 0xd2, 0x79, 0x2f, 0xad, 0x3a, 0xf4, 0xa8, 0x6c, 0xce, 0x45, 0xcf, 0xd4, 0x8d, 0x6f,
+	0x03, 0x00, 0x1b, 0xe1, 0xe0, 0xad, 0xa9, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,6 +368,8 @@ type MsgClient interface {
 	RegisterInterchainAccount(ctx context.Context, in *MsgRegisterInterchainAccount, opts ...grpc.CallOption) (*MsgRegisterInterchainAccountResponse, error)
 	// SendTx defines a rpc handler for MsgSendTx.
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
+	// TransferInterchainAccountOwnership defines a rpc handler for MsgTransferInterchainAccountOwnership.
+	TransferInterchainAccountOwnership(ctx context.Context, in *MsgTransferInterchainAccountOwnership, opts ...grpc.CallOption) (*MsgTransferInterchainAccountOwnershipResponse, error)
 }
 
 type msgClient struct {
@@ -303,12 +398,23 @@ func (c *msgClient) SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) TransferInterchainAccountOwnership(ctx context.Context, in *MsgTransferInterchainAccountOwnership, opts ...grpc.CallOption) (*MsgTransferInterchainAccountOwnershipResponse, error) {
+	out := new(MsgTransferInterchainAccountOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferInterchainAccountOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
 	RegisterInterchainAccount(context.Context, *MsgRegisterInterchainAccount) (*MsgRegisterInterchainAccountResponse, error)
 	// SendTx defines a rpc handler for MsgSendTx.
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
+	// TransferInterchainAccountOwnership defines a rpc handler for MsgTransferInterchainAccountOwnership.
+	TransferInterchainAccountOwnership(context.Context, *MsgTransferInterchainAccountOwnership) (*MsgTransferInterchainAccountOwnershipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -321,6 +427,9 @@ func (*UnimplementedMsgServer) RegisterInterchainAccount(ctx context.Context, re
 func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*MsgSendTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTx not implemented")
 }
+func (*UnimplementedMsgServer) TransferInterchainAccountOwnership(ctx context.Context, req *MsgTransferInterchainAccountOwnership) (*MsgTransferInterchainAccountOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferInterchainAccountOwnership not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -362,6 +471,24 @@ func _Msg_SendTx_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferInterchainAccountOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferInterchainAccountOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferInterchainAccountOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferInterchainAccountOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferInterchainAccountOwnership(ctx, req.(*MsgTransferInterchainAccountOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -374,6 +501,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendTx",
 			Handler:    _Msg_SendTx_Handler,
 		},
+		{
+			MethodName: "TransferInterchainAccountOwnership",
+			Handler:    _Msg_TransferInterchainAccountOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
@@ -399,6 +530,11 @@ func (m *MsgRegisterInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.Ordering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -543,6 +679,73 @@ func (m *MsgSendTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferInterchainAccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferInterchainAccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferInterchainAccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -572,6 +775,9 @@ func (m *MsgRegisterInterchainAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
 	return n
 }
 
@@ -624,6 +830,36 @@ func (m *MsgSendTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferInterchainAccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -755,6 +991,25 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= types.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1126,6 +1381,202 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferInterchainAccountOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	controllertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
@@ -81,6 +85,34 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	for _, owner := range gs.InterchainAccountOwners {
+		if err := host.ConnectionIdentifierValidator(owner.ConnectionId); err != nil {
+			return err
+		}
+
+		if err := host.PortIdentifierValidator(owner.PortId); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(owner.Owner) == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "owner of interchain account on port %s cannot be empty", owner.PortId)
+		}
+	}
+
+	for _, owner := range gs.PacketOwners {
+		if err := host.PortIdentifierValidator(owner.PortId); err != nil {
+			return err
+		}
+
+		if err := host.ChannelIdentifierValidator(owner.ChannelId); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(owner.Owner) == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "owner of packet with sequence %d on port %s cannot be empty", owner.Sequence, owner.PortId)
+		}
+	}
+
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
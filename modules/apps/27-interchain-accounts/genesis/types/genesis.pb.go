@@ -80,10 +80,12 @@ func (m *GenesisState) GetHostGenesisState() HostGenesisState {
 
 // ControllerGenesisState defines the interchain accounts controller genesis state
 type ControllerGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
-	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels          []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
+	InterchainAccounts      []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	Ports                   []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Params                  types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	InterchainAccountOwners []InterchainAccountOwner      `protobuf:"bytes,5,rep,name=interchain_account_owners,json=interchainAccountOwners,proto3" json:"interchain_account_owners" yaml:"interchain_account_owners"`
+	PacketOwners            []PacketOwner                 `protobuf:"bytes,6,rep,name=packet_owners,json=packetOwners,proto3" json:"packet_owners" yaml:"packet_owners"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,6 +149,20 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetInterchainAccountOwners() []InterchainAccountOwner {
+	if m != nil {
+		return m.InterchainAccountOwners
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetPacketOwners() []PacketOwner {
+	if m != nil {
+		return m.PacketOwners
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
@@ -347,12 +363,146 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 	return ""
 }
 
+// InterchainAccountOwner contains a connection ID, controller port ID and the owner the associated interchain account
+// has been transferred to
+type InterchainAccountOwner struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *InterchainAccountOwner) Reset()         { *m = InterchainAccountOwner{} }
+func (m *InterchainAccountOwner) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountOwner) ProtoMessage()    {}
+func (*InterchainAccountOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4aa48c8e29a1947, []int{5}
+}
+func (m *InterchainAccountOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountOwner.Merge(m, src)
+}
+func (m *InterchainAccountOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountOwner proto.InternalMessageInfo
+
+func (m *InterchainAccountOwner) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *InterchainAccountOwner) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// PacketOwner contains a controller port ID, channel ID and sequence of a packet sent using SendTx which is awaiting
+// acknowledgement or timeout, and the owner whose callbacks are invoked once the packet completes
+type PacketOwner struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Owner     string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *PacketOwner) Reset()         { *m = PacketOwner{} }
+func (m *PacketOwner) String() string { return proto.CompactTextString(m) }
+func (*PacketOwner) ProtoMessage()    {}
+func (*PacketOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4aa48c8e29a1947, []int{6}
+}
+func (m *PacketOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketOwner.Merge(m, src)
+}
+func (m *PacketOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketOwner proto.InternalMessageInfo
+
+func (m *PacketOwner) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketOwner) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketOwner) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
 	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
 	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
 	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
 	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*InterchainAccountOwner)(nil), "ibc.applications.interchain_accounts.genesis.v1.InterchainAccountOwner")
+	proto.RegisterType((*PacketOwner)(nil), "ibc.applications.interchain_accounts.genesis.v1.PacketOwner")
 }
 
 func init() {
@@ -360,51 +510,59 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0xdd, 0x6a, 0xdb, 0x3c,
-	0x18, 0xc7, 0xe3, 0xa4, 0x6f, 0xdf, 0x37, 0xea, 0xc7, 0xdb, 0xa9, 0x1f, 0x78, 0xd9, 0xb0, 0x33,
-	0x9f, 0x2c, 0x30, 0x6a, 0xd3, 0xae, 0xa3, 0x50, 0xe8, 0x20, 0x0e, 0xa3, 0x0b, 0xac, 0x30, 0xbc,
-	0x1d, 0x8c, 0x9d, 0x18, 0x45, 0x16, 0x8e, 0xc0, 0xb1, 0x82, 0xa5, 0x66, 0xf4, 0x0a, 0x7a, 0x3a,
-	0x76, 0x07, 0x3b, 0x1b, 0xbb, 0x80, 0x5d, 0x43, 0x8f, 0x46, 0x0f, 0x77, 0x14, 0x46, 0x7b, 0x07,
-	0xb9, 0x82, 0x21, 0x59, 0x4d, 0xd2, 0x34, 0x1d, 0xc9, 0xc9, 0x8e, 0x76, 0x64, 0x7d, 0x3c, 0xff,
-	0xff, 0xf3, 0x93, 0x1e, 0x59, 0x02, 0x87, 0xb4, 0x85, 0x3d, 0xd4, 0xed, 0x26, 0x14, 0x23, 0x41,
-	0x59, 0xca, 0x3d, 0x9a, 0x0a, 0x92, 0xe1, 0x36, 0xa2, 0x69, 0x88, 0x30, 0x66, 0x27, 0xa9, 0xe0,
-	0x5e, 0x4c, 0x52, 0xc2, 0x29, 0xf7, 0x7a, 0x3b, 0xd7, 0x4d, 0xb7, 0x9b, 0x31, 0xc1, 0xa0, 0x47,
-	0x5b, 0xd8, 0x1d, 0x97, 0xbb, 0x53, 0xe4, 0xee, 0xb5, 0xa6, 0xb7, 0x53, 0xd9, 0x88, 0x59, 0xcc,
-	0x94, 0xd6, 0x93, 0xad, 0xdc, 0xa6, 0xd2, 0x98, 0x89, 0x02, 0xb3, 0x54, 0x64, 0x2c, 0x49, 0x48,
-	0x26, 0x41, 0x46, 0x3d, 0x6d, 0xb2, 0x3f, 0x93, 0x49, 0x9b, 0x71, 0x21, 0xe5, 0xf2, 0x9b, 0x0b,
-	0x9d, 0x8b, 0x22, 0x58, 0x3e, 0xca, 0x11, 0xdf, 0x08, 0x24, 0x08, 0xfc, 0x6a, 0x00, 0x73, 0x64,
-	0x1f, 0x6a, 0xfc, 0x90, 0xcb, 0x49, 0xd3, 0xa8, 0x1a, 0xb5, 0xa5, 0xdd, 0x23, 0x77, 0xce, 0x95,
-	0xbb, 0x8d, 0xa1, 0xe1, 0x78, 0x2e, 0xff, 0xf1, 0x79, 0xdf, 0x2e, 0x0c, 0xfa, 0xb6, 0x7d, 0x8a,
-	0x3a, 0xc9, 0x81, 0x73, 0x57, 0x5a, 0x27, 0xd8, 0xc2, 0x53, 0x0d, 0xe0, 0x27, 0x03, 0x40, 0xb9,
-	0x98, 0x09, 0xcc, 0xa2, 0xc2, 0xac, 0xcf, 0x8d, 0xf9, 0x92, 0x71, 0x71, 0x03, 0xf0, 0x91, 0x06,
-	0xbc, 0x9f, 0x03, 0xde, 0x4e, 0xe5, 0x04, 0x6b, 0xed, 0x09, 0x91, 0xf3, 0xad, 0x04, 0xb6, 0xa6,
-	0x2f, 0x18, 0x9e, 0x19, 0xe0, 0x7f, 0x84, 0x05, 0xed, 0x91, 0x10, 0xb7, 0x51, 0x9a, 0x92, 0x84,
-	0x9b, 0x46, 0xb5, 0x54, 0x5b, 0xda, 0x7d, 0x3e, 0x37, 0x6c, 0x5d, 0xf9, 0x34, 0x72, 0x1b, 0xdf,
-	0xd2, 0xa4, 0x5b, 0x39, 0xe9, 0x44, 0x12, 0x27, 0x58, 0x45, 0xe3, 0xe1, 0x1c, 0x7e, 0x36, 0xc0,
-	0xfa, 0x94, 0x04, 0x66, 0x51, 0xd1, 0xbc, 0x9a, 0x9b, 0x26, 0x20, 0x31, 0xe5, 0x82, 0x64, 0x24,
-	0x6a, 0x0e, 0x03, 0xeb, 0x79, 0x9c, 0xef, 0x68, 0xb6, 0x4a, 0xce, 0x36, 0xc5, 0xc9, 0x09, 0x20,
-	0x9d, 0x94, 0x71, 0xb8, 0x01, 0xfe, 0xe9, 0xb2, 0x4c, 0x70, 0xb3, 0x54, 0x2d, 0xd5, 0xca, 0x41,
-	0xde, 0x81, 0xef, 0xc0, 0x62, 0x17, 0x65, 0xa8, 0xc3, 0xcd, 0x05, 0x55, 0xe6, 0x83, 0xd9, 0x58,
-	0xc7, 0x7e, 0x99, 0xde, 0x8e, 0xfb, 0x5a, 0x39, 0xf8, 0x0b, 0x92, 0x2c, 0xd0, 0x7e, 0xce, 0x97,
-	0x12, 0x58, 0x9b, 0x3c, 0x02, 0x7f, 0x4b, 0x36, 0x57, 0xc9, 0x20, 0x58, 0x90, 0x55, 0x32, 0x4b,
-	0x55, 0xa3, 0x56, 0x0e, 0x54, 0x1b, 0x06, 0x13, 0x05, 0xdb, 0x9b, 0x8d, 0x54, 0x5d, 0x52, 0x77,
-	0x95, 0xea, 0xac, 0x08, 0x56, 0x6e, 0xec, 0x26, 0x3c, 0x04, 0x2b, 0x98, 0xa5, 0x29, 0xc1, 0xd2,
-	0x31, 0xa4, 0x91, 0xba, 0xab, 0xca, 0xbe, 0x39, 0xe8, 0xdb, 0x1b, 0xc3, 0xeb, 0x65, 0x34, 0xed,
-	0x04, 0xcb, 0xa3, 0x7e, 0x33, 0x82, 0x4f, 0xc0, 0xbf, 0x12, 0x56, 0x0a, 0x8b, 0x4a, 0x08, 0x07,
-	0x7d, 0x7b, 0x35, 0x17, 0xea, 0x09, 0x27, 0x58, 0x94, 0xad, 0x66, 0x04, 0xf7, 0x00, 0xd0, 0x65,
-	0x92, 0xf1, 0x6a, 0xad, 0xfe, 0xe6, 0xa0, 0x6f, 0xdf, 0xd3, 0x89, 0x86, 0x73, 0x4e, 0x50, 0xd6,
-	0x9d, 0x66, 0x04, 0xdf, 0x82, 0x4d, 0xca, 0xc3, 0x0e, 0x8d, 0xa2, 0x84, 0x7c, 0x40, 0x19, 0x09,
-	0x49, 0x8a, 0x5a, 0x09, 0x89, 0xd4, 0xb6, 0xfc, 0xe7, 0x57, 0x07, 0x7d, 0xfb, 0xa1, 0xde, 0xee,
-	0x69, 0x61, 0x4e, 0xb0, 0x4e, 0xf9, 0xf1, 0x70, 0xf8, 0x85, 0x1e, 0xfd, 0x6e, 0x80, 0x07, 0xbf,
-	0xa9, 0xe4, 0x1f, 0xdd, 0x97, 0x86, 0xfc, 0x55, 0x54, 0xda, 0x10, 0x45, 0x51, 0x46, 0x38, 0xd7,
-	0x9b, 0x53, 0x19, 0x3f, 0xe6, 0x37, 0x02, 0xd4, 0x31, 0x57, 0x23, 0xf5, 0x7c, 0xc0, 0x8f, 0xcf,
-	0x2f, 0x2d, 0xe3, 0xe2, 0xd2, 0x32, 0x7e, 0x5e, 0x5a, 0xc6, 0xc7, 0x2b, 0xab, 0x70, 0x71, 0x65,
-	0x15, 0x7e, 0x5c, 0x59, 0x85, 0xf7, 0xc7, 0x31, 0x15, 0xed, 0x93, 0x96, 0x8b, 0x59, 0xc7, 0xc3,
-	0x8c, 0x77, 0x18, 0x97, 0x4f, 0xf0, 0x76, 0xcc, 0xbc, 0xde, 0x33, 0xaf, 0xc3, 0xa2, 0x93, 0x84,
-	0x70, 0xf9, 0x08, 0x72, 0x6f, 0x77, 0x7f, 0x7b, 0x74, 0xa4, 0xb6, 0x6f, 0x3d, 0xe5, 0xe2, 0xb4,
-	0x4b, 0x78, 0x6b, 0x51, 0xbd, 0x80, 0x4f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x74, 0x7a,
-	0x12, 0x07, 0x08, 0x00, 0x00,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x8f, 0x1b, 0x35,
+	0x14, 0xc7, 0xd7, 0x49, 0x36, 0x34, 0xde, 0xdd, 0x52, 0xdc, 0x34, 0x4c, 0x43, 0x95, 0x04, 0x5f,
+	0x88, 0x84, 0x76, 0x46, 0xbb, 0x14, 0x55, 0xaa, 0x28, 0x52, 0x26, 0x42, 0x25, 0x12, 0x15, 0x95,
+	0xe1, 0x80, 0xb8, 0x8c, 0x26, 0x1e, 0x2b, 0xb1, 0x98, 0x8c, 0x87, 0xf1, 0x24, 0x55, 0x4f, 0x1c,
+	0x7b, 0x45, 0x1c, 0xb9, 0xc1, 0x09, 0x21, 0xf1, 0x3d, 0x7a, 0x42, 0x7b, 0xe4, 0x14, 0xa1, 0xdd,
+	0x03, 0xf7, 0x7c, 0x02, 0x64, 0x8f, 0x9b, 0x4c, 0x92, 0x59, 0x94, 0x70, 0xe8, 0xa9, 0xa7, 0x99,
+	0x67, 0xbf, 0xf7, 0x7f, 0x3f, 0x3f, 0x3f, 0x5b, 0x86, 0x8f, 0xf8, 0x90, 0x3a, 0x7e, 0x1c, 0x87,
+	0x9c, 0xfa, 0x29, 0x17, 0x91, 0x74, 0x78, 0x94, 0xb2, 0x84, 0x8e, 0x7d, 0x1e, 0x79, 0x3e, 0xa5,
+	0x62, 0x1a, 0xa5, 0xd2, 0x19, 0xb1, 0x88, 0x49, 0x2e, 0x9d, 0xd9, 0xd9, 0xab, 0x5f, 0x3b, 0x4e,
+	0x44, 0x2a, 0x90, 0xc3, 0x87, 0xd4, 0xce, 0x87, 0xdb, 0x05, 0xe1, 0xf6, 0xab, 0x98, 0xd9, 0x59,
+	0xb3, 0x3e, 0x12, 0x23, 0xa1, 0x63, 0x1d, 0xf5, 0x97, 0xc9, 0x34, 0xfb, 0x3b, 0x51, 0x50, 0x11,
+	0xa5, 0x89, 0x08, 0x43, 0x96, 0x28, 0x90, 0x95, 0x65, 0x44, 0x1e, 0xec, 0x24, 0x32, 0x16, 0x32,
+	0x55, 0xe1, 0xea, 0x9b, 0x05, 0xe2, 0x8b, 0x12, 0x3c, 0x7e, 0x9c, 0x21, 0x7e, 0x95, 0xfa, 0x29,
+	0x43, 0xbf, 0x03, 0x68, 0xad, 0xe4, 0x3d, 0x83, 0xef, 0x49, 0x35, 0x69, 0x81, 0x0e, 0xe8, 0x1e,
+	0x9d, 0x3f, 0xb6, 0xf7, 0x5c, 0xb9, 0xdd, 0x5f, 0x0a, 0xe6, 0x73, 0xb9, 0x1f, 0xbc, 0x9c, 0xb7,
+	0x0f, 0x16, 0xf3, 0x76, 0xfb, 0xb9, 0x3f, 0x09, 0x1f, 0xe2, 0xeb, 0xd2, 0x62, 0xd2, 0xa0, 0x85,
+	0x02, 0xe8, 0x27, 0x00, 0x91, 0x5a, 0xcc, 0x06, 0x66, 0x49, 0x63, 0xf6, 0xf6, 0xc6, 0xfc, 0x5c,
+	0xc8, 0x74, 0x0d, 0xf0, 0x7d, 0x03, 0x78, 0x37, 0x03, 0xdc, 0x4e, 0x85, 0xc9, 0xad, 0xf1, 0x46,
+	0x10, 0xfe, 0xe7, 0x10, 0x36, 0x8a, 0x17, 0x8c, 0x5e, 0x00, 0xf8, 0xb6, 0x4f, 0x53, 0x3e, 0x63,
+	0x1e, 0x1d, 0xfb, 0x51, 0xc4, 0x42, 0x69, 0x81, 0x4e, 0xb9, 0x7b, 0x74, 0xfe, 0xe9, 0xde, 0xb0,
+	0x3d, 0xad, 0xd3, 0xcf, 0x64, 0xdc, 0x96, 0x21, 0x6d, 0x64, 0xa4, 0x1b, 0x49, 0x30, 0xb9, 0xe9,
+	0xe7, 0xdd, 0x25, 0xfa, 0x05, 0xc0, 0xdb, 0x05, 0x09, 0xac, 0x92, 0xa6, 0xf9, 0x62, 0x6f, 0x1a,
+	0xc2, 0x46, 0x5c, 0xa6, 0x2c, 0x61, 0xc1, 0x60, 0xe9, 0xd8, 0xcb, 0xfc, 0x5c, 0x6c, 0xd8, 0x9a,
+	0x19, 0x5b, 0x81, 0x12, 0x26, 0x88, 0x6f, 0x86, 0x49, 0x54, 0x87, 0x87, 0xb1, 0x48, 0x52, 0x69,
+	0x95, 0x3b, 0xe5, 0x6e, 0x8d, 0x64, 0x06, 0xfa, 0x06, 0x56, 0x63, 0x3f, 0xf1, 0x27, 0xd2, 0xaa,
+	0xe8, 0x6d, 0x7e, 0xb8, 0x1b, 0x6b, 0xee, 0xc8, 0xcc, 0xce, 0xec, 0xa7, 0x5a, 0xc1, 0xad, 0x28,
+	0x32, 0x62, 0xf4, 0xd0, 0x1f, 0x00, 0xde, 0xdd, 0x0e, 0xf5, 0xc4, 0xb3, 0x88, 0x25, 0xd2, 0x3a,
+	0xec, 0x94, 0xff, 0x57, 0xef, 0x6f, 0xd5, 0xe3, 0x4b, 0xa5, 0xe7, 0x76, 0x4d, 0x51, 0x3a, 0xd7,
+	0x15, 0xc5, 0xe4, 0xc5, 0xe4, 0x5d, 0x5e, 0xa8, 0x20, 0xd1, 0x0f, 0xf0, 0x24, 0xf6, 0xe9, 0x77,
+	0x6c, 0x89, 0x58, 0xd5, 0x88, 0x9f, 0xec, 0x8d, 0xf8, 0x54, 0xab, 0x64, 0x5c, 0xf7, 0x0c, 0x57,
+	0x3d, 0xe3, 0x5a, 0x4b, 0x80, 0xc9, 0x71, 0xbc, 0x72, 0x95, 0xf8, 0xb7, 0x32, 0xbc, 0xb5, 0x79,
+	0x66, 0xde, 0xf4, 0xf8, 0x5e, 0x3d, 0x8e, 0x60, 0x45, 0xb5, 0xb5, 0x55, 0xee, 0x80, 0x6e, 0x8d,
+	0xe8, 0x7f, 0x44, 0x36, 0x3a, 0xfc, 0xfe, 0x6e, 0xa4, 0xfa, 0x56, 0xbf, 0xa6, 0xb7, 0xf1, 0x8b,
+	0x12, 0x3c, 0x59, 0xab, 0x26, 0x7a, 0x04, 0x4f, 0xa8, 0x88, 0x22, 0x46, 0x95, 0xa2, 0xc7, 0x03,
+	0x7d, 0xb9, 0xd7, 0x5c, 0x6b, 0xb5, 0xf7, 0x6b, 0xd3, 0x98, 0x1c, 0xaf, 0xec, 0x41, 0x80, 0x3e,
+	0x84, 0x6f, 0x29, 0x58, 0x15, 0x58, 0xd2, 0x81, 0x68, 0x31, 0x6f, 0xdf, 0x34, 0x4d, 0x93, 0x4d,
+	0x60, 0x52, 0x55, 0x7f, 0x83, 0x00, 0xdd, 0x87, 0xd0, 0x6c, 0x93, 0xf2, 0xd7, 0x6b, 0x75, 0xef,
+	0x2c, 0xe6, 0xed, 0x77, 0x4c, 0xa2, 0xe5, 0x1c, 0x26, 0x35, 0x63, 0x0c, 0x02, 0xf4, 0x35, 0xbc,
+	0xc3, 0xa5, 0x37, 0xe1, 0x41, 0x10, 0xb2, 0x67, 0x7e, 0xc2, 0x3c, 0x16, 0xf9, 0xc3, 0x90, 0x05,
+	0xba, 0x2c, 0x37, 0xdc, 0xce, 0x62, 0xde, 0xbe, 0x67, 0xca, 0x5d, 0xe4, 0x86, 0xc9, 0x6d, 0x2e,
+	0x9f, 0x2c, 0x87, 0x3f, 0x33, 0xa3, 0x7f, 0x02, 0xf8, 0xde, 0x7f, 0xec, 0xe4, 0x6b, 0xad, 0x4b,
+	0x5f, 0x1d, 0x95, 0xec, 0xb4, 0xfb, 0x41, 0x90, 0x30, 0x29, 0x4d, 0x71, 0x9a, 0xf9, 0x36, 0x5f,
+	0x73, 0xd0, 0x6d, 0xae, 0x47, 0x7a, 0x66, 0xe0, 0x67, 0x00, 0x1b, 0xc5, 0x97, 0xcc, 0x6b, 0x5d,
+	0x4b, 0x1d, 0x1e, 0xea, 0x5b, 0xc2, 0xb4, 0x72, 0x66, 0xe0, 0x5f, 0x01, 0x3c, 0xca, 0x5d, 0x2f,
+	0x79, 0x49, 0xb0, 0x67, 0xdb, 0x94, 0x76, 0x6c, 0x9b, 0x26, 0xbc, 0x21, 0xd9, 0xf7, 0x53, 0x16,
+	0x51, 0xa6, 0x59, 0x2a, 0x64, 0x69, 0xaf, 0x20, 0x2b, 0x39, 0x48, 0x77, 0xf4, 0xf2, 0xb2, 0x05,
+	0x2e, 0x2e, 0x5b, 0xe0, 0xef, 0xcb, 0x16, 0xf8, 0xf1, 0xaa, 0x75, 0x70, 0x71, 0xd5, 0x3a, 0xf8,
+	0xeb, 0xaa, 0x75, 0xf0, 0xed, 0x93, 0x11, 0x4f, 0xc7, 0xd3, 0xa1, 0x4d, 0xc5, 0xc4, 0xa1, 0x42,
+	0x4e, 0x84, 0x54, 0xaf, 0xbe, 0xd3, 0x91, 0x70, 0x66, 0x1f, 0x3b, 0x13, 0x11, 0x4c, 0x43, 0x26,
+	0xd5, 0xbb, 0x4b, 0x3a, 0xe7, 0x0f, 0x4e, 0x57, 0x87, 0xf2, 0x74, 0xeb, 0xf5, 0x98, 0x3e, 0x8f,
+	0x99, 0x1c, 0x56, 0xf5, 0xa3, 0xeb, 0xa3, 0x7f, 0x07, 0x00, 0xb5, 0xb7, 0xce, 0x77, 0x7a, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -470,6 +628,34 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketOwners) > 0 {
+		for iNdEx := len(m.PacketOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.InterchainAccountOwners) > 0 {
+		for iNdEx := len(m.InterchainAccountOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccountOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -686,6 +872,99 @@ func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *InterchainAccountOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -736,6 +1015,18 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InterchainAccountOwners) > 0 {
+		for _, e := range m.InterchainAccountOwners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketOwners) > 0 {
+		for _, e := range m.PacketOwners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -811,6 +1102,51 @@ func (m *RegisteredInterchainAccount) Size() (n int) {
 	return n
 }
 
+func (m *InterchainAccountOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1095,27 +1431,95 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountOwners", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccountOwners = append(m.InterchainAccountOwners, InterchainAccountOwner{})
+			if err := m.InterchainAccountOwners[len(m.InterchainAccountOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketOwners = append(m.PacketOwners, PacketOwner{})
+			if err := m.PacketOwners[len(m.PacketOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1611,6 +2015,317 @@ func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InterchainAccountOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
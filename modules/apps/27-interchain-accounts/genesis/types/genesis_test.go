@@ -179,6 +179,33 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 			},
 			false,
 		},
+		{
+			"success: packet owners",
+			func() {
+				genesisState.PacketOwners = []genesistypes.PacketOwner{
+					{PortId: TestPortID, ChannelId: ibctesting.FirstChannelID, Sequence: 1, Owner: TestOwnerAddress},
+				}
+			},
+			true,
+		},
+		{
+			"failed to validate packet owners - invalid channel identifier",
+			func() {
+				genesisState.PacketOwners = []genesistypes.PacketOwner{
+					{PortId: TestPortID, ChannelId: "invalid|channel", Sequence: 1, Owner: TestOwnerAddress},
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate packet owners - empty owner",
+			func() {
+				genesisState.PacketOwners = []genesistypes.PacketOwner{
+					{PortId: TestPortID, ChannelId: ibctesting.FirstChannelID, Sequence: 1, Owner: " "},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
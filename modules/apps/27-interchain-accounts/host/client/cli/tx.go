@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	flagMemo     = "memo"
+	flagEncoding = "encoding"
+)
+
+func newGeneratePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-packet-data [message-json]",
+		Short: "Generate interchain account packet data from the provided messages.",
+		Long: strings.TrimSpace(`Generates interchain account packet data from a single JSON encoded SDK message, or a JSON
+array of SDK messages, each including its type URL under the "@type" key. The messages are serialized into a CosmosTx
+using the encoding provided via {encoding} flag, which must match the encoding of the interchain account channel.
+An optional memo may be provided via {memo} flag. The packet data is printed to stdout and may be submitted using
+the controller send-tx command.`),
+		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data '{
+    "@type":"/cosmos.bank.v1beta1.MsgSend",
+    "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+    "to_address":"cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+    "amount": [{"denom": "stake", "amount": "1000"}]
+}' --memo memo`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", packetDataBytes))
+		},
+	}
+
+	cmd.Flags().String(flagMemo, "", "An optional memo to be included in the interchain account packet data")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("Encoding of the messages, can be one of: %s", strings.Join([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}, ", ")))
+
+	return cmd
+}
+
+// generatePacketData takes a single JSON encoded SDK message, or a JSON array of SDK messages, and returns the JSON
+// encoded interchain account packet data containing the messages serialized using the provided encoding.
+func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo, encoding string) ([]byte, error) {
+	msgs, err := unmarshalMsgs(cdc, msgBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(&packetData)
+}
+
+// unmarshalMsgs unmarshals the provided JSON encoded SDK message, or JSON array of SDK messages.
+func unmarshalMsgs(cdc *codec.ProtoCodec, msgBytes []byte) ([]sdk.Msg, error) {
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(msgBytes, &rawMsgs); err != nil {
+		// the input is not a JSON array, it is therefore expected to contain a single message
+		rawMsgs = []json.RawMessage{msgBytes}
+	}
+
+	if len(rawMsgs) == 0 {
+		return nil, fmt.Errorf("at least one message must be provided")
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
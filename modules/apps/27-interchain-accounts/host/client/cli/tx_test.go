@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+)
+
+const msgSendJSON = `{
+	"@type": "/cosmos.bank.v1beta1.MsgSend",
+	"from_address": "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+	"to_address": "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+	"amount": [{"denom": "stake", "amount": "1000"}]
+}`
+
+func TestGeneratePacketData(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	expMsg := &banktypes.MsgSend{
+		FromAddress: "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+		ToAddress:   "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}
+
+	testCases := []struct {
+		name     string
+		message  string
+		memo     string
+		encoding string
+		expMsgs  []sdk.Msg
+		expPass  bool
+	}{
+		{"single message", msgSendJSON, "memo", icatypes.EncodingProtobuf, []sdk.Msg{expMsg}, true},
+		{"multiple messages", "[" + msgSendJSON + "," + msgSendJSON + "]", "", icatypes.EncodingProtobuf, []sdk.Msg{expMsg, expMsg}, true},
+		{"proto3json encoding", msgSendJSON, "", icatypes.EncodingProto3JSON, []sdk.Msg{expMsg}, true},
+		{"empty message array", "[]", "", icatypes.EncodingProtobuf, nil, false},
+		{"message without type URL", `{"from_address": "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz"}`, "", icatypes.EncodingProtobuf, nil, false},
+		{"unregistered message type", `{"@type": "/cosmos.invalid.v1beta1.MsgInvalid"}`, "", icatypes.EncodingProtobuf, nil, false},
+		{"invalid JSON", "invalid", "", icatypes.EncodingProtobuf, nil, false},
+		{"unsupported encoding", msgSendJSON, "", "invalid-encoding", nil, false},
+	}
+
+	for _, tc := range testCases {
+		bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo, tc.encoding)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+
+		var packetData icatypes.InterchainAccountPacketData
+		require.NoError(t, cdc.UnmarshalJSON(bz, &packetData), tc.name)
+		require.Equal(t, icatypes.EXECUTE_TX, packetData.Type, tc.name)
+		require.Equal(t, tc.memo, packetData.Memo, tc.name)
+
+		msgs, err := icatypes.DeserializeCosmosTx(cdc, packetData.Data, tc.encoding)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMsgs, msgs, tc.name)
+	}
+}
+
+func TestGeneratePacketDataCmdOutput(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+
+	var stdout, stderr bytes.Buffer
+	clientCtx := client.Context{}.WithInterfaceRegistry(interfaceRegistry).WithOutput(&stdout)
+
+	cmd := newGeneratePacketDataCmd()
+	cmd.SetErr(&stderr)
+	cmd.SetArgs([]string{msgSendJSON})
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	// the packet data is written to the client output rather than stderr
+	require.Empty(t, stderr.String())
+
+	var packetData icatypes.InterchainAccountPacketData
+	require.NoError(t, codec.NewProtoCodec(interfaceRegistry).UnmarshalJSON(stdout.Bytes(), &packetData))
+	require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+}
@@ -1,6 +1,9 @@
 package host
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -112,7 +115,7 @@ func (im IBCModule) OnRecvPacket(
 	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
+		ack = newErrorAcknowledgement(err)
 	}
 
 	// Emit an event indicating a successful or failed acknowledgement.
@@ -140,3 +143,18 @@ func (im IBCModule) OnTimeoutPacket(
 ) error {
 	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
 }
+
+// newErrorAcknowledgement returns an error acknowledgement for the provided error. Errors caused by exceeding the execute
+// gas limit report the gas used in the acknowledgement, as their error message is deterministic. All other errors are
+// redacted by channeltypes.NewErrorAcknowledgement.
+func newErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	if errors.Is(err, types.ErrExecuteGasLimitExceeded) {
+		return channeltypes.Acknowledgement{
+			Response: &channeltypes.Acknowledgement_Error{
+				Error: fmt.Sprintf("ABCI code: %d: %s", types.ErrExecuteGasLimitExceeded.ABCICode(), err.Error()),
+			},
+		}
+	}
+
+	return channeltypes.NewErrorAcknowledgement(err)
+}
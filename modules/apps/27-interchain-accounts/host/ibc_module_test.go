@@ -1,7 +1,9 @@
 package host_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -35,13 +37,7 @@ var (
 	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
 
 	// TestVersion defines a reusable interchainaccounts version string for testing purposes
-	TestVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
-		Version:                icatypes.Version,
-		ControllerConnectionId: ibctesting.FirstConnectionID,
-		HostConnectionId:       ibctesting.FirstConnectionID,
-		Encoding:               icatypes.EncodingProtobuf,
-		TxType:                 icatypes.TxTypeSDKMultiMsg,
-	}))
+	TestVersion = icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
 )
 
 type InterchainAccountsTestSuite struct {
@@ -166,7 +162,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"ICA callback fails - invalid channel order", func() {
-				channel.Ordering = channeltypes.UNORDERED
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 	}
@@ -256,6 +252,58 @@ func (suite *InterchainAccountsTestSuite) TestChanOpenAck() {
 	suite.Require().Error(err)
 }
 
+// Test opening a channel using the metadata of counterparties which do not support the ordering field
+func (suite *InterchainAccountsTestSuite) TestChanOpenHandshakeLegacyMetadata() {
+	suite.SetupTest() // reset
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	legacyVersion := fmt.Sprintf(`{"version":"%s","controller_connection_id":"%s","host_connection_id":"%s","address":"","encoding":"%s","tx_type":"%s"}`,
+		icatypes.Version, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+
+	portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, legacyVersion)
+	suite.Require().NoError(err)
+
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Version = legacyVersion
+
+	// the metadata of ORDERED channels is unchanged
+	suite.Require().Equal(legacyVersion, path.EndpointA.GetChannel().Version)
+
+	err = path.EndpointB.ChanOpenTry()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanOpenAck()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanOpenConfirm()
+	suite.Require().NoError(err)
+
+	// the host metadata is decodable by counterparties which reject unknown fields
+	var legacyMetadata struct {
+		Version                string `json:"version"`
+		ControllerConnectionID string `json:"controller_connection_id"`
+		HostConnectionID       string `json:"host_connection_id"`
+		Address                string `json:"address"`
+		Encoding               string `json:"encoding"`
+		TxType                 string `json:"tx_type"`
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(path.EndpointB.GetChannel().Version))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&legacyMetadata)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(legacyMetadata.Address)
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 	testCases := []struct {
 		name     string
@@ -437,7 +485,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
 				Amount:      amount,
 			}
-			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg})
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 			suite.Require().NoError(err)
 
 			icaPacketData := icatypes.InterchainAccountPacketData{
@@ -485,6 +533,53 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketExecuteGasLimitExceeded() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params.MaxExecuteGas = 1_000
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+	suite.Require().False(ack.Success())
+
+	// the error acknowledgement reports the gas used by the execution
+	channelAck, ok := ack.(channeltypes.Acknowledgement)
+	suite.Require().True(ok)
+	suite.Require().Regexp(`^ABCI code: 3: gas used \(\d+\) exceeds gas limit \(1000\): execute gas limit exceeded$`, channelAck.GetError())
+
+	// the acknowledgement is deterministic
+	suite.Require().Equal(ack, cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil))
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	testCases := []struct {
 		name     string
@@ -627,7 +722,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Amount:      tokenAmt,
 	}
 
-	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 	suite.Require().NoError(err)
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
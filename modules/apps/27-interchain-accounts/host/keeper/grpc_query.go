@@ -2,10 +2,17 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +26,88 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: addr,
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	var interchainAccounts []types.IdentifiedInterchainAccount
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		// the key is formatted as {portID}/{connectionID}, see icatypes.KeyOwnerAccount
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return status.Errorf(codes.Internal, "invalid interchain account key: %s", key)
+		}
+
+		portID, connectionID := keySplit[0], keySplit[1]
+		activeChannelID, _ := q.GetActiveChannelID(ctx, connectionID, portID)
+
+		interchainAccounts = append(interchainAccounts, types.IdentifiedInterchainAccount{
+			ConnectionId:    connectionID,
+			PortId:          portID,
+			AccountAddress:  string(value),
+			ActiveChannelId: activeChannelID,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// AllowMessages implements the Query/AllowMessages gRPC method
+func (q Keeper) AllowMessages(c context.Context, req *types.QueryAllowMessagesRequest) (*types.QueryAllowMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowMsgs, err := q.GetChannelAllowMessages(ctx, icatypes.PortID, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryAllowMessagesResponse{
+		AllowMessages: allowMsgs,
+	}, nil
+}
@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +14,193 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var req *types.QueryInterchainAccountRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.PortId = "icacontroller-unknown"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				ConnectionId: path.EndpointB.ConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddress, res.Address)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// an interchain account without an active channel
+	otherPortID, err := icatypes.NewControllerPortID("owner")
+	suite.Require().NoError(err)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, otherPortID, TestAccAddress.String())
+
+	expAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.IdentifiedInterchainAccount{
+		{
+			ConnectionId:    path.EndpointB.ConnectionID,
+			PortId:          path.EndpointA.ChannelConfig.PortID,
+			AccountAddress:  expAddress,
+			ActiveChannelId: path.EndpointB.ChannelID,
+		},
+		{
+			ConnectionId:   path.EndpointB.ConnectionID,
+			PortId:         otherPortID,
+			AccountAddress: TestAccAddress.String(),
+		},
+	}, res.InterchainAccounts)
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InterchainAccounts, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryAllowMessages() {
+	var req *types.QueryAllowMessagesRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = "channel-10"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.NewParams(true, []string{"/cosmos.gov.v1beta1.MsgVote"})
+			params.ScopedAllowMessages = []types.ScopedAllowMessages{
+				{ConnectionId: path.EndpointB.ConnectionID, AllowMessages: []string{"/cosmos.bank.*"}},
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			req = &types.QueryAllowMessagesRequest{
+				ChannelId: path.EndpointB.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.AllowMessages(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]string{"/cosmos.bank.*"}, res.AllowMessages)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -27,8 +27,8 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.UNORDERED, order)
 	}
 
 	if portID != icatypes.PortID {
@@ -44,6 +44,15 @@ func (k Keeper) OnChanOpenTry(
 		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
 	}
 
+	// the metadata of counterparties which do not support the ordering field denotes an ORDERED channel
+	if metadata.ChannelOrdering() != order {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s, got %s", order, metadata.ChannelOrdering())
+	}
+
+	if err := metadata.SetOrdering(order); err != nil {
+		return "", err
+	}
+
 	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
 		return "", err
 	}
@@ -76,12 +85,7 @@ func (k Keeper) OnChanOpenTry(
 	k.RegisterInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId, accAddress)
 
 	metadata.Address = accAddress.String()
-	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
-	if err != nil {
-		return "", err
-	}
-
-	return string(versionBytes), nil
+	return icatypes.MetadataToVersion(metadata)
 }
 
 // OnChanOpenConfirm completes the handshake process by setting the active channel in state on the host chain
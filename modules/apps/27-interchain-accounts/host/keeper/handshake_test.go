@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"fmt"
+	"strings"
+
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
@@ -40,6 +43,18 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			}, true,
 		},
+		{
+			"success - proto3 JSON encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+				path.EndpointB.SetChannel(*channel)
+			}, true,
+		},
 		{
 			"invalid metadata - previous metadata is different",
 			func() {
@@ -60,9 +75,53 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			}, false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success: UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
+
+				metadata.Ordering = channeltypes.UNORDERED.String()
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+			},
+			true,
+		},
+		{
+			"success: counterparty metadata without ordering field",
+			func() {
+				path.EndpointA.ChannelConfig.Version = fmt.Sprintf(`{"version":"%s","controller_connection_id":"%s","host_connection_id":"%s","address":"","encoding":"%s","tx_type":"%s"}`,
+					icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			},
+			true,
+		},
+		{
+			"invalid metadata - missing ordering denotes an ORDERED channel",
+			func() {
+				channel.Ordering = channeltypes.UNORDERED
+
+				path.EndpointA.ChannelConfig.Version = fmt.Sprintf(`{"version":"%s","controller_connection_id":"%s","host_connection_id":"%s","address":"","encoding":"%s","tx_type":"%s"}`,
+					icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			},
+			false,
+		},
+		{
+			"invalid order - NONE",
+			func() {
+				channel.Ordering = channeltypes.NONE
+			},
+			false,
+		},
+		{
+			"invalid metadata - ordering does not match channel ordering",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED.String()
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
 			},
 			false,
 		},
@@ -218,6 +277,13 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				versionMetadata, err := icatypes.MetadataFromVersion(version)
+				suite.Require().NoError(err)
+				suite.Require().Equal(channel.Ordering, versionMetadata.ChannelOrdering())
+
+				// the ordering is omitted from the metadata of ORDERED channels
+				suite.Require().Equal(channel.Ordering == channeltypes.UNORDERED, strings.Contains(version, "ordering"))
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
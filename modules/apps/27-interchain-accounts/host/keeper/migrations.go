@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams sets the ScopedAllowMessages, AllowQueries, MaxQueryGas and MaxExecuteGas host parameters to their
+// default values if they are not yet set.
+// Chains which do not run the host submodule are left untouched.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, types.KeyScopedAllowMessages) {
+		m.keeper.paramSpace.Set(ctx, types.KeyScopedAllowMessages, types.DefaultParams().ScopedAllowMessages)
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, types.KeyAllowQueries) {
+		m.keeper.paramSpace.Set(ctx, types.KeyAllowQueries, types.DefaultParams().AllowQueries)
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, types.KeyMaxQueryGas) {
+		m.keeper.paramSpace.Set(ctx, types.KeyMaxQueryGas, types.DefaultParams().MaxQueryGas)
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, types.KeyMaxExecuteGas) {
+		m.keeper.paramSpace.Set(ctx, types.KeyMaxExecuteGas, types.DefaultParams().MaxExecuteGas)
+	}
+
+	return nil
+}
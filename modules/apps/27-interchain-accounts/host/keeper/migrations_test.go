@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.GetSimApp()
+
+	// remove the scoped allow lists from the param store to mimic a chain running a previous version
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+	paramStore.Delete(types.KeyScopedAllowMessages)
+	paramStore.Delete(types.KeyAllowQueries)
+	paramStore.Delete(types.KeyMaxQueryGas)
+	paramStore.Delete(types.KeyMaxExecuteGas)
+	suite.Require().Panics(func() { app.ICAHostKeeper.GetScopedAllowMessages(ctx) })
+	suite.Require().Panics(func() { app.ICAHostKeeper.GetAllowQueries(ctx) })
+	suite.Require().Panics(func() { app.ICAHostKeeper.GetMaxQueryGas(ctx) })
+	suite.Require().Panics(func() { app.ICAHostKeeper.GetMaxExecuteGas(ctx) })
+
+	migrator := keeper.NewMigrator(&app.ICAHostKeeper)
+	err := migrator.MigrateParams(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), app.ICAHostKeeper.GetParams(ctx))
+
+	// existing scoped allow lists, query and execute gas parameters are left untouched
+	params := types.DefaultParams()
+	params.ScopedAllowMessages = []types.ScopedAllowMessages{
+		{ConnectionId: ibctesting.FirstConnectionID, AllowMessages: []string{"/cosmos.bank.*"}},
+	}
+	params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance"}
+	params.MaxQueryGas = 50_000
+	params.MaxExecuteGas = 200_000
+	app.ICAHostKeeper.SetParams(ctx, params)
+
+	err = migrator.MigrateParams(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, app.ICAHostKeeper.GetParams(ctx))
+
+	// chains which do not run the host submodule are skipped
+	err = keeper.NewMigrator(nil).MigrateParams(ctx)
+	suite.Require().NoError(err)
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 )
 
 // IsHostEnabled retrieves the host enabled boolean from the paramstore.
@@ -21,9 +23,55 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetScopedAllowMessages retrieves the msg types allowed per connection from the paramstore
+func (k Keeper) GetScopedAllowMessages(ctx sdk.Context) []types.ScopedAllowMessages {
+	var res []types.ScopedAllowMessages
+	k.paramSpace.Get(ctx, types.KeyScopedAllowMessages, &res)
+	return res
+}
+
+// GetAllowQueries retrieves the gRPC query paths interchain accounts are allowed to query from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetMaxQueryGas retrieves the maximum amount of gas the queries of a single packet may consume from the paramstore
+func (k Keeper) GetMaxQueryGas(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxQueryGas, &res)
+	return res
+}
+
+// GetMaxExecuteGas retrieves the maximum amount of gas the transaction of a single packet may consume from the paramstore.
+// A zero value disables the limit.
+func (k Keeper) GetMaxExecuteGas(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxExecuteGas, &res)
+	return res
+}
+
+// GetChannelAllowMessages returns the msg types interchain accounts are allowed to execute over the provided host channel.
+// The allow list scoped to the connection of the channel is returned if it exists, otherwise the global allow list is returned.
+func (k Keeper) GetChannelAllowMessages(ctx sdk.Context, portID, channelID string) ([]string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return k.GetParams(ctx).AllowMessagesForConnection(channel.ConnectionHops[0]), nil
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	params := types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	params.ScopedAllowMessages = k.GetScopedAllowMessages(ctx)
+	params.AllowQueries = k.GetAllowQueries(ctx)
+	params.MaxQueryGas = k.GetMaxQueryGas(ctx)
+	params.MaxExecuteGas = k.GetMaxExecuteGas(ctx)
+
+	return params
 }
 
 // SetParams sets the total set of the host submodule parameters.
@@ -1,6 +1,10 @@
 package keeper_test
 
-import "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+import (
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
 
 func (suite *KeeperTestSuite) TestParams() {
 	expParams := types.DefaultParams()
@@ -10,7 +14,91 @@ func (suite *KeeperTestSuite) TestParams() {
 
 	expParams.HostEnabled = false
 	expParams.AllowMessages = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	expParams.ScopedAllowMessages = []types.ScopedAllowMessages{
+		{ConnectionId: ibctesting.FirstConnectionID, AllowMessages: []string{"/cosmos.bank.*"}},
+	}
 	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
+
+func (suite *KeeperTestSuite) TestGetChannelAllowMessages() {
+	var (
+		path      *ibctesting.Path
+		params    types.Params
+		channelID string
+	)
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expAllowMsgs []string
+		expPass      bool
+	}{
+		{
+			"success: global allow list",
+			func() {},
+			[]string{"/cosmos.gov.v1beta1.MsgVote"},
+			true,
+		},
+		{
+			"success: allow list scoped to connection",
+			func() {
+				params.ScopedAllowMessages = []types.ScopedAllowMessages{
+					{ConnectionId: "connection-10", AllowMessages: []string{"/cosmos.staking.*"}},
+					{ConnectionId: path.EndpointB.ConnectionID, AllowMessages: []string{"/cosmos.bank.*"}},
+				}
+			},
+			[]string{"/cosmos.bank.*"},
+			true,
+		},
+		{
+			"success: no matching scoped allow list falls back to global allow list",
+			func() {
+				params.ScopedAllowMessages = []types.ScopedAllowMessages{
+					{ConnectionId: "connection-10", AllowMessages: []string{"/cosmos.bank.*"}},
+				}
+			},
+			[]string{"/cosmos.gov.v1beta1.MsgVote"},
+			true,
+		},
+		{
+			"channel not found",
+			func() {
+				channelID = "channel-10"
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			channelID = path.EndpointB.ChannelID
+			params = types.NewParams(true, []string{"/cosmos.gov.v1beta1.MsgVote"})
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			allowMsgs, err := suite.chainB.GetSimApp().ICAHostKeeper.GetChannelAllowMessages(suite.chainB.GetContext(), icatypes.PortID, channelID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAllowMsgs, allowMsgs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
@@ -23,17 +24,39 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+		metadata, err := k.getAppMetadata(ctx, packet.DestinationPort, packet.DestinationChannel)
 		if err != nil {
 			return nil, err
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, metadata.Encoding, data.GasLimit)
 		if err != nil {
 			return nil, err
 		}
 
 		return txResponse, nil
+	case icatypes.QUERY:
+		metadata, err := k.getAppMetadata(ctx, packet.DestinationPort, packet.DestinationChannel)
+		if err != nil {
+			return nil, err
+		}
+
+		requests, err := icatypes.DeserializeCosmosQuery(k.cdc, data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse, err := k.executeQuery(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, requests, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		return queryResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
@@ -43,13 +66,22 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// The transaction response is marshaled using the provided encoding of the channel.
+// The gas consumed by the messages is limited by the lower of the MaxExecuteGas parameter and the gas limit requested
+// by the packet, ignoring either if it is zero. Exceeding the limit reverts the transaction and returns an
+// ErrExecuteGasLimitExceeded error reporting the gas used.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, encoding string, packetGasLimit uint64) ([]byte, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	allowMsgs, err := k.GetChannelAllowMessages(ctx, destPort, destChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort, allowMsgs); err != nil {
 		return nil, err
 	}
 
@@ -60,40 +92,194 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
+
+	if gasLimit := executeGasLimit(k.GetMaxExecuteGas(ctx), packetGasLimit); gasLimit != 0 {
+		err = k.executeMsgsWithGasLimit(cacheCtx, msgs, txMsgData, gasLimit)
+	} else {
+		err = k.executeMsgs(cacheCtx, msgs, txMsgData)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+
+	var txResponse []byte
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		txResponse, err = proto.Marshal(txMsgData)
+	case icatypes.EncodingProto3JSON:
+		txResponse, err = k.cdc.MarshalJSON(txMsgData)
+	default:
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+	}
+
+	return txResponse, nil
+}
+
+// executeMsgsWithGasLimit executes the provided msgs using a gas meter limited to the provided gas limit. The gas
+// consumed, up to the gas limit, is charged to the gas meter of the provided context. Running out of gas is recovered
+// from and returned as an ErrExecuteGasLimitExceeded error, so that it results in an error acknowledgement.
+func (k Keeper) executeMsgsWithGasLimit(ctx sdk.Context, msgs []sdk.Msg, txMsgData *sdk.TxMsgData, gasLimit uint64) (err error) {
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		r := recover()
+
+		ctx.GasMeter().ConsumeGas(limitedCtx.GasMeter().GasConsumedToLimit(), "interchain account tx execution")
+
+		if r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(types.ErrExecuteGasLimitExceeded, "gas used (%d) exceeds gas limit (%d)", limitedCtx.GasMeter().GasConsumed(), gasLimit)
+		}
+	}()
+
+	return k.executeMsgs(limitedCtx, msgs, txMsgData)
+}
+
+// executeMsgs validates and executes the provided msgs in order, aggregating the msg responses into txMsgData.
+func (k Keeper) executeMsgs(ctx sdk.Context, msgs []sdk.Msg, txMsgData *sdk.TxMsgData) error {
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
+			return err
 		}
 
-		any, err := k.executeMsg(cacheCtx, msg)
+		any, err := k.executeMsg(ctx, msg)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		txMsgData.MsgResponses[i] = any
 	}
 
-	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-	writeCache()
+	return nil
+}
+
+// executeGasLimit returns the gas limit for the execution of a transaction, which is the lower of the provided
+// host and packet gas limits. A zero limit is ignored and zero is returned if neither limit is set.
+func executeGasLimit(hostGasLimit, packetGasLimit uint64) uint64 {
+	switch {
+	case hostGasLimit == 0:
+		return packetGasLimit
+	case packetGasLimit == 0 || hostGasLimit < packetGasLimit:
+		return hostGasLimit
+	default:
+		return packetGasLimit
+	}
+}
+
+// executeQuery attempts to execute the provided gRPC queries at the current block height. Each query path must be
+// allowed by the AllowQueries parameter of the host. The queries are executed on a branched context which is never
+// written, and the gas they consume is limited by the MaxQueryGas parameter before being charged to the packet.
+// The query responses are marshaled using the provided encoding of the channel.
+func (k Keeper) executeQuery(ctx sdk.Context, sourcePort, destPort, destChannel string, requests []icatypes.QueryRequest, encoding string) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], sourcePort); !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", sourcePort)
+	}
+
+	if len(requests) == 0 {
+		return nil, sdkerrors.Wrap(icatypes.ErrInvalidOutgoingData, "queries cannot be empty")
+	}
+
+	allowQueries := k.GetAllowQueries(ctx)
+	for _, request := range requests {
+		if !types.ContainsQueryPath(allowQueries, request.Path) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", request.Path)
+		}
+	}
+
+	queryCtx, _ := ctx.CacheContext()
+	queryCtx = queryCtx.WithGasMeter(sdk.NewGasMeter(k.GetMaxQueryGas(ctx)))
+
+	queryResponse, err := k.executeQueryRequests(queryCtx, requests)
+
+	// charge the gas consumed by the queries, up to the query gas limit, to the packet
+	ctx.GasMeter().ConsumeGas(queryCtx.GasMeter().GasConsumedToLimit(), "interchain account queries")
 
-	txResponse, err := proto.Marshal(txMsgData)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+		return nil, err
 	}
 
-	return txResponse, nil
+	var response []byte
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		response, err = proto.Marshal(queryResponse)
+	case icatypes.EncodingProto3JSON:
+		response, err = k.cdc.MarshalJSON(queryResponse)
+	default:
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal query response")
+	}
+
+	return response, nil
+}
+
+// executeQueryRequests routes each query request to its gRPC query handler and aggregates the responses.
+// Running out of gas is recovered from and returned as an error, so that it results in an error acknowledgement.
+func (k Keeper) executeQueryRequests(ctx sdk.Context, requests []icatypes.QueryRequest) (queryResponse *icatypes.CosmosQueryResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			queryResponse, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "query gas limit exceeded in location: %s", oog.Descriptor)
+		}
+	}()
+
+	queryResponse = &icatypes.CosmosQueryResponse{
+		Responses: make([]icatypes.QueryResponse, len(requests)),
+		Height:    ctx.BlockHeight(),
+	}
+
+	for i, request := range requests {
+		handler := k.queryRouter.Route(request.Path)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "no route found for query path %s", request.Path)
+		}
+
+		res, err := handler(ctx, abci.RequestQuery{
+			Path:   request.Path,
+			Data:   request.Data,
+			Height: ctx.BlockHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse.Responses[i] = icatypes.QueryResponse{Data: res.Value}
+	}
+
+	return queryResponse, nil
 }
 
-// authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
-func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
+// authenticateTx ensures the provided msgs are allowed by the provided allow list and contain the correct
+// interchain account signer address retrieved from state using the provided controller port identifier
+func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string, allowMsgs []string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
 	for _, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
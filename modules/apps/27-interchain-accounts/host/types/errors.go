@@ -6,5 +6,6 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled   = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrExecuteGasLimitExceeded = sdkerrors.Register(SubModuleName, 3, "execute gas limit exceeded")
 )
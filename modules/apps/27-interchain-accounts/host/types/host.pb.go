@@ -30,6 +30,17 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// scoped_allow_messages defines lists of sdk message typeURLs allowed to be executed by interchain accounts
+	// registered over a given connection. A scoped list replaces allow_messages for the interchain accounts it
+	// applies to.
+	ScopedAllowMessages []ScopedAllowMessages `protobuf:"bytes,3,rep,name=scoped_allow_messages,json=scopedAllowMessages,proto3" json:"scoped_allow_messages" yaml:"scoped_allow_messages"`
+	// allow_queries defines a list of gRPC query paths interchain accounts are allowed to query on a host chain.
+	AllowQueries []string `protobuf:"bytes,4,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_query_gas defines the maximum amount of gas which may be consumed by the queries of a single packet.
+	MaxQueryGas uint64 `protobuf:"varint,5,opt,name=max_query_gas,json=maxQueryGas,proto3" json:"max_query_gas,omitempty" yaml:"max_query_gas"`
+	// max_execute_gas defines the maximum amount of gas which may be consumed executing the transaction of a single
+	// packet. A zero value does not limit the execution.
+	MaxExecuteGas uint64 `protobuf:"varint,6,opt,name=max_execute_gas,json=maxExecuteGas,proto3" json:"max_execute_gas,omitempty" yaml:"max_execute_gas"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +90,94 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetScopedAllowMessages() []ScopedAllowMessages {
+	if m != nil {
+		return m.ScopedAllowMessages
+	}
+	return nil
+}
+
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMaxQueryGas() uint64 {
+	if m != nil {
+		return m.MaxQueryGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecuteGas() uint64 {
+	if m != nil {
+		return m.MaxExecuteGas
+	}
+	return 0
+}
+
+// ScopedAllowMessages defines a list of sdk message typeURLs allowed to be executed by interchain accounts registered
+// over a host connection. Allow lists are only scoped to connections, as the chain identifier of a counterparty client
+// is chosen by whoever creates the client and cannot be trusted to identify the controller chain.
+type ScopedAllowMessages struct {
+	// connection_id defines the host connection identifier the allow list applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *ScopedAllowMessages) Reset()         { *m = ScopedAllowMessages{} }
+func (m *ScopedAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*ScopedAllowMessages) ProtoMessage()    {}
+func (*ScopedAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *ScopedAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopedAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopedAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopedAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopedAllowMessages.Merge(m, src)
+}
+func (m *ScopedAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopedAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopedAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopedAllowMessages proto.InternalMessageInfo
+
+func (m *ScopedAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ScopedAllowMessages) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*ScopedAllowMessages)(nil), "ibc.applications.interchain_accounts.host.v1.ScopedAllowMessages")
 }
 
 func init() {
@@ -88,25 +185,36 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd5, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0xa1, 0x7b, 0x2c, 0x74, 0x01,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x1b, 0xbb, 0x16, 0x9d, 0x76, 0x15, 0xd2, 0x5d, 0x8d, 0x8b, 0x24, 0x65, 0xf0, 0xd0,
+	0x83, 0xcd, 0xb0, 0x2b, 0xb2, 0xb0, 0x28, 0xb8, 0x81, 0x65, 0x51, 0x10, 0x34, 0x7b, 0xf3, 0x12,
+	0x26, 0xd3, 0x21, 0x1d, 0x48, 0x32, 0xb1, 0x6f, 0x52, 0xdb, 0x6f, 0xe1, 0xc9, 0x8b, 0x5f, 0x68,
+	0x8f, 0x7b, 0xf4, 0x14, 0xa4, 0xc5, 0x2f, 0x90, 0x4f, 0x20, 0x99, 0x59, 0x68, 0xa2, 0xbd, 0x08,
+	0x9e, 0x92, 0x67, 0x9e, 0xf9, 0xbd, 0xef, 0x3c, 0xf3, 0x07, 0x9d, 0x8a, 0x90, 0x11, 0x9a, 0x65,
+	0xb1, 0x60, 0x34, 0x17, 0x32, 0x05, 0x22, 0xd2, 0x9c, 0xcf, 0xd9, 0x8c, 0x8a, 0x34, 0xa0, 0x8c,
+	0xc9, 0x22, 0xcd, 0x81, 0xcc, 0x24, 0xe4, 0x64, 0x71, 0xac, 0xbe, 0x6e, 0x36, 0x97, 0xb9, 0x34,
+	0x9f, 0x8b, 0x90, 0xb9, 0x4d, 0xd0, 0xdd, 0x01, 0xba, 0x0a, 0x58, 0x1c, 0x1f, 0x1d, 0x44, 0x32,
+	0x92, 0x0a, 0x24, 0xf5, 0x9f, 0xae, 0x81, 0x7f, 0x75, 0x51, 0xef, 0x03, 0x9d, 0xd3, 0x04, 0xcc,
+	0x33, 0x34, 0xa8, 0xe7, 0x06, 0x3c, 0xa5, 0x61, 0xcc, 0xa7, 0x96, 0x31, 0x32, 0xc6, 0xf7, 0xbc,
+	0xc7, 0x55, 0xe9, 0x0c, 0x57, 0x34, 0x89, 0xcf, 0x70, 0xd3, 0xc5, 0x7e, 0xbf, 0x96, 0x17, 0x5a,
+	0x99, 0x6f, 0xd0, 0x03, 0x1a, 0xc7, 0xf2, 0x4b, 0x90, 0x70, 0x00, 0x1a, 0x71, 0xb0, 0xee, 0x8c,
+	0xba, 0xe3, 0xfb, 0xde, 0x93, 0xaa, 0x74, 0x0e, 0x35, 0xdd, 0xf6, 0xb1, 0xbf, 0xaf, 0x06, 0xde,
+	0xdf, 0x6a, 0xf3, 0xbb, 0x81, 0x0e, 0x81, 0xc9, 0x8c, 0x4f, 0x83, 0x3f, 0x2a, 0x75, 0x47, 0xdd,
+	0x71, 0xff, 0xe4, 0xdc, 0xfd, 0x97, 0xb4, 0xee, 0x95, 0x2a, 0x75, 0xde, 0x6c, 0xe1, 0x3d, 0xbb,
+	0x2e, 0x9d, 0x4e, 0x55, 0x3a, 0x4f, 0xf5, 0x82, 0x76, 0x76, 0xc3, 0xfe, 0x10, 0xfe, 0x46, 0xcd,
+	0xd7, 0x48, 0x2f, 0x37, 0xf8, 0x5c, 0xf0, 0xb9, 0xe0, 0x60, 0xed, 0xa9, 0x78, 0x56, 0x55, 0x3a,
+	0x07, 0xcd, 0x78, 0xb7, 0x36, 0xf6, 0x07, 0x4a, 0x7f, 0xd4, 0xd2, 0x7c, 0x85, 0xf6, 0x13, 0xba,
+	0x54, 0xee, 0x2a, 0x88, 0x28, 0x58, 0x77, 0x47, 0xc6, 0x78, 0xaf, 0x89, 0xb7, 0x6c, 0xec, 0xf7,
+	0x13, 0xba, 0xac, 0xe1, 0xd5, 0x25, 0x05, 0xd3, 0x43, 0x0f, 0x6b, 0x9b, 0x2f, 0x39, 0x2b, 0x72,
+	0xae, 0xf8, 0x9e, 0xe2, 0x8f, 0xaa, 0xd2, 0x79, 0xb4, 0xe5, 0x1b, 0x13, 0xb0, 0x5f, 0x37, 0xbc,
+	0xd0, 0x03, 0x97, 0x14, 0xf0, 0x37, 0x03, 0x0d, 0xaf, 0x76, 0x07, 0x63, 0x32, 0x4d, 0x39, 0xab,
+	0xb7, 0x34, 0x10, 0xfa, 0xd4, 0x5b, 0xc1, 0x5a, 0x36, 0xf6, 0x07, 0x5b, 0xfd, 0xf6, 0x3f, 0x9c,
+	0xbb, 0x37, 0xbd, 0x5e, 0xdb, 0xc6, 0xcd, 0xda, 0x36, 0x7e, 0xae, 0x6d, 0xe3, 0xeb, 0xc6, 0xee,
+	0xdc, 0x6c, 0xec, 0xce, 0x8f, 0x8d, 0xdd, 0xf9, 0xf4, 0x2e, 0x12, 0xf9, 0xac, 0x08, 0x5d, 0x26,
+	0x13, 0xc2, 0x24, 0x24, 0x12, 0x88, 0x08, 0xd9, 0x24, 0x92, 0x64, 0xf1, 0x92, 0x24, 0x72, 0x5a,
+	0xc4, 0x1c, 0xea, 0x77, 0x03, 0xe4, 0xe4, 0x74, 0xb2, 0xbd, 0x0b, 0x93, 0xf6, 0x93, 0xc9, 0x57,
+	0x19, 0x87, 0xb0, 0xa7, 0x6e, 0xfb, 0x8b, 0xdf, 0x03, 0x00, 0xd7, 0xe1, 0xb7, 0xb6, 0x6c, 0x03,
 	0x00, 0x00,
 }
 
@@ -130,6 +238,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecuteGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecuteGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxQueryGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxQueryGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScopedAllowMessages) > 0 {
+		for iNdEx := len(m.ScopedAllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopedAllowMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +293,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopedAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopedAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopedAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +358,43 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.ScopedAllowMessages) > 0 {
+		for _, e := range m.ScopedAllowMessages {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxQueryGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxQueryGas))
+	}
+	if m.MaxExecuteGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecuteGas))
+	}
+	return n
+}
+
+func (m *ScopedAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,6 +485,224 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopedAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopedAllowMessages = append(m.ScopedAllowMessages, ScopedAllowMessages{})
+			if err := m.ScopedAllowMessages[len(m.ScopedAllowMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryGas", wireType)
+			}
+			m.MaxQueryGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecuteGas", wireType)
+			}
+			m.MaxExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopedAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopedAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopedAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,17 +12,39 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// Wildcard is the allow list entry allowing all message types when used alone, or all message types
+	// sharing a prefix when used as the last character of a typeURL prefix, e.g. "/cosmos.staking.*"
+	Wildcard = "*"
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
+// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs or matches one of its wildcard
+// prefixes, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
-	if len(allowMsgs) == 1 && allowMsgs[0] == "*" {
+	if len(allowMsgs) == 1 && allowMsgs[0] == Wildcard {
 		return true
 	}
 
+	typeURL := sdk.MsgTypeURL(msg)
 	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+		if v == typeURL {
+			return true
+		}
+
+		// a lone wildcard is only valid as the single entry of the array
+		if v != Wildcard && strings.HasSuffix(v, Wildcard) && strings.HasPrefix(typeURL, strings.TrimSuffix(v, Wildcard)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsQueryPath returns true if the gRPC query path is present in allowQueries, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	for _, v := range allowQueries {
+		if v == path {
 			return true
 		}
 	}
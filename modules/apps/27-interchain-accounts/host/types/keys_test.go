@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestContainsMsgType(t *testing.T) {
+	msg := &banktypes.MsgSend{}
+
+	testCases := []struct {
+		name      string
+		allowMsgs []string
+		expPass   bool
+	}{
+		{"lone wildcard", []string{"*"}, true},
+		{"exact typeURL", []string{"/cosmos.staking.v1beta1.MsgDelegate", sdk.MsgTypeURL(msg)}, true},
+		{"wildcard prefix", []string{"/cosmos.bank.*"}, true},
+		{"wildcard prefix of package", []string{"/cosmos.bank.v1beta1.*"}, true},
+		{"empty allow list", nil, false},
+		{"other typeURL", []string{"/cosmos.staking.v1beta1.MsgDelegate"}, false},
+		{"other wildcard prefix", []string{"/cosmos.staking.*"}, false},
+		{"wildcard is not the only entry", []string{"*", "/cosmos.staking.v1beta1.MsgDelegate"}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPass, types.ContainsMsgType(tc.allowMsgs, msg), tc.name)
+	}
+}
+
+func TestContainsQueryPath(t *testing.T) {
+	allowQueries := []string{"/cosmos.bank.v1beta1.Query/Balance"}
+
+	require.True(t, types.ContainsQueryPath(allowQueries, "/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, types.ContainsQueryPath(allowQueries, "/cosmos.bank.v1beta1.Query/AllBalances"))
+	require.False(t, types.ContainsQueryPath(nil, "/cosmos.bank.v1beta1.Query/Balance"))
+}
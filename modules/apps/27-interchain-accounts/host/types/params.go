@@ -5,11 +5,17 @@ import (
 	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultMaxQueryGas is the default value for the max query gas param
+	DefaultMaxQueryGas = uint64(1_000_000)
+	// DefaultMaxExecuteGas is the default value for the max execute gas param (set to 0, which disables the limit)
+	DefaultMaxExecuteGas = uint64(0)
 )
 
 var (
@@ -17,6 +23,14 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyScopedAllowMessages is the store key for the ScopedAllowMessages Params
+	KeyScopedAllowMessages = []byte("ScopedAllowMessages")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyMaxQueryGas is the store key for the MaxQueryGas Params
+	KeyMaxQueryGas = []byte("MaxQueryGas")
+	// KeyMaxExecuteGas is the store key for the MaxExecuteGas Params
+	KeyMaxExecuteGas = []byte("MaxExecuteGas")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -34,7 +48,11 @@ func NewParams(enableHost bool, allowMsgs []string) Params {
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	params := NewParams(DefaultHostEnabled, nil)
+	params.MaxQueryGas = DefaultMaxQueryGas
+	params.MaxExecuteGas = DefaultMaxExecuteGas
+
+	return params
 }
 
 // Validate validates all host submodule parameters
@@ -47,14 +65,46 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateScopedAllowlists(p.ScopedAllowMessages); err != nil {
+		return err
+	}
+
+	if err := validateAllowQueries(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateMaxQueryGas(p.MaxQueryGas); err != nil {
+		return err
+	}
+
+	if err := validateMaxExecuteGas(p.MaxExecuteGas); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// AllowMessagesForConnection returns the allow list for interchain accounts registered over the given connection.
+// The list scoped to the connection is returned if it exists, otherwise the global allow list is returned.
+func (p Params) AllowMessagesForConnection(connectionID string) []string {
+	for _, scoped := range p.ScopedAllowMessages {
+		if scoped.ConnectionId == connectionID {
+			return scoped.AllowMessages
+		}
+	}
+
+	return p.AllowMessages
+}
+
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyScopedAllowMessages, p.ScopedAllowMessages, validateScopedAllowlists),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateAllowQueries),
+		paramtypes.NewParamSetPair(KeyMaxQueryGas, p.MaxQueryGas, validateMaxQueryGas),
+		paramtypes.NewParamSetPair(KeyMaxExecuteGas, p.MaxExecuteGas, validateMaxExecuteGas),
 	}
 }
 
@@ -77,6 +127,74 @@ func validateAllowlist(i interface{}) error {
 		if strings.TrimSpace(typeURL) == "" {
 			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
 		}
+
+		// the wildcard may only be used alone or as the last character of a prefix ending in a dot
+		if wildcardIndex := strings.Index(typeURL, Wildcard); wildcardIndex != -1 {
+			if typeURL != Wildcard && (wildcardIndex != len(typeURL)-1 || !strings.HasSuffix(typeURL, "."+Wildcard)) {
+				return fmt.Errorf("invalid wildcard message type: %s", typeURL)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateScopedAllowlists(i interface{}) error {
+	scopedAllowMsgs, ok := i.([]ScopedAllowMessages)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	connections := make(map[string]bool)
+	for _, scoped := range scopedAllowMsgs {
+		if err := host.ConnectionIdentifierValidator(scoped.ConnectionId); err != nil {
+			return fmt.Errorf("invalid scoped allow list connection ID: %w", err)
+		}
+
+		if connections[scoped.ConnectionId] {
+			return fmt.Errorf("duplicate scoped allow list for connection ID: %s", scoped.ConnectionId)
+		}
+
+		connections[scoped.ConnectionId] = true
+
+		if err := validateAllowlist(scoped.AllowMessages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAllowQueries(i interface{}) error {
+	allowQueries, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, path := range allowQueries {
+		// gRPC query paths are of the form /{package}.{service}/{method}
+		parts := strings.Split(path, "/")
+		if len(parts) != 3 || parts[0] != "" || strings.TrimSpace(parts[1]) == "" || strings.TrimSpace(parts[2]) == "" {
+			return fmt.Errorf("invalid gRPC query path: %s", path)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxQueryGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxExecuteGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
 	return nil
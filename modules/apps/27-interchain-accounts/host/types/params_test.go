@@ -11,4 +11,85 @@ import (
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
 	require.NoError(t, types.NewParams(false, []string{}).Validate())
+
+	testCases := []struct {
+		name      string
+		allowMsgs []string
+		scoped    []types.ScopedAllowMessages
+		expPass   bool
+	}{
+		{"wildcard allow list", []string{"*"}, nil, true},
+		{"wildcard prefix allow list", []string{"/cosmos.staking.*", "/cosmos.gov.v1beta1.MsgVote"}, nil, true},
+		{"scoped allow lists", nil, []types.ScopedAllowMessages{
+			{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.*"}},
+			{ConnectionId: "connection-1", AllowMessages: []string{"*"}},
+		}, true},
+		{"empty scoped allow list", nil, []types.ScopedAllowMessages{{ConnectionId: "connection-0"}}, true},
+		{"empty string in allow list", []string{""}, nil, false},
+		{"wildcard in the middle of a typeURL", []string{"/cosmos.*.v1beta1.MsgVote"}, nil, false},
+		{"wildcard prefix not ending in a dot", []string{"/cosmos.stak*"}, nil, false},
+		{"multiple wildcards", []string{"/cosmos.*.*"}, nil, false},
+		{"scoped allow list without connection ID", nil, []types.ScopedAllowMessages{{AllowMessages: []string{"*"}}}, false},
+		{"invalid connection ID", nil, []types.ScopedAllowMessages{{ConnectionId: "(invalid)"}}, false},
+		{"duplicate connection ID", nil, []types.ScopedAllowMessages{
+			{ConnectionId: "connection-0"}, {ConnectionId: "connection-0"},
+		}, false},
+		{"invalid scoped allow list", nil, []types.ScopedAllowMessages{
+			{ConnectionId: "connection-0", AllowMessages: []string{""}},
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		params := types.NewParams(true, tc.allowMsgs)
+		params.ScopedAllowMessages = tc.scoped
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateAllowQueries(t *testing.T) {
+	testCases := []struct {
+		name         string
+		allowQueries []string
+		expPass      bool
+	}{
+		{"empty allow list", nil, true},
+		{"valid query paths", []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.staking.v1beta1.Query/Delegation"}, true},
+		{"empty query path", []string{""}, false},
+		{"missing leading slash", []string{"cosmos.bank.v1beta1.Query/Balance"}, false},
+		{"missing method", []string{"/cosmos.bank.v1beta1.Query/"}, false},
+		{"missing service", []string{"//Balance"}, false},
+		{"too many path segments", []string{"/cosmos.bank.v1beta1.Query/Balance/extra"}, false},
+		{"wildcard", []string{"*"}, false},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		params.AllowQueries = tc.allowQueries
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAllowMessagesForConnection(t *testing.T) {
+	params := types.NewParams(true, []string{"/cosmos.gov.v1beta1.MsgVote"})
+	params.ScopedAllowMessages = []types.ScopedAllowMessages{
+		{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.*"}},
+		{ConnectionId: "connection-1", AllowMessages: []string{}},
+	}
+
+	require.Equal(t, []string{"/cosmos.bank.*"}, params.AllowMessagesForConnection("connection-0"))
+	require.Equal(t, []string{}, params.AllowMessagesForConnection("connection-1"))
+	require.Equal(t, []string{"/cosmos.gov.v1beta1.MsgVote"}, params.AllowMessagesForConnection("connection-2"))
+	require.Equal(t, []string{"/cosmos.gov.v1beta1.MsgVote"}, params.AllowMessagesForConnection(""))
 }
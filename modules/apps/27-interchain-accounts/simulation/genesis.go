@@ -29,6 +29,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	controllerParams := controllertypes.Params{
 		ControllerEnabled: controllerEnabled,
+		CallbackGasLimit:  controllertypes.DefaultCallbackGasLimit,
 	}
 
 	controllerGenesisState := genesistypes.ControllerGenesisState{
@@ -13,6 +13,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/stretchr/testify/require"
 
+	controllertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	genesistypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/simulation"
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
@@ -44,6 +45,7 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &icaGenesis)
 
 	require.True(t, icaGenesis.ControllerGenesisState.Params.ControllerEnabled)
+	require.Equal(t, controllertypes.DefaultCallbackGasLimit, icaGenesis.ControllerGenesisState.Params.CallbackGasLimit)
 	require.Empty(t, icaGenesis.ControllerGenesisState.ActiveChannels)
 	require.Empty(t, icaGenesis.ControllerGenesisState.InterchainAccounts)
 	require.Empty(t, icaGenesis.ControllerGenesisState.Ports)
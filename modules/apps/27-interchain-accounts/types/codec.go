@@ -23,11 +23,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
-// packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
+// packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
+// using the provided encoding, which must be either protobuf or proto3 JSON, and the resulting
 // bytes are returned. Only the ProtoCodec is supported for serializing messages.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg, encoding string) (bz []byte, err error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
@@ -44,7 +46,15 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 		Messages: msgAnys,
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = protoCdc.Marshal(cosmosTx)
+	case EncodingProto3JSON:
+		bz, err = protoCdc.MarshalJSON(cosmosTx)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -53,17 +63,27 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 }
 
 // DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
-// into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
-// deserialization.
-func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+// encoded using the provided encoding into a slice of sdk.Msg's. Only the
+// ProtoCodec is supported for message deserialization.
+func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte, encoding string) ([]sdk.Msg, error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
 	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
-		return nil, err
+	switch encoding {
+	case EncodingProtobuf:
+		if err := protoCdc.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+	case EncodingProto3JSON:
+		if err := protoCdc.UnmarshalJSON(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
 	}
 
 	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
@@ -81,3 +101,85 @@ func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error)
 
 	return msgs, nil
 }
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type. The CosmosQuery
+// is marshaled using the provided encoding, which must be either protobuf or proto3 JSON, and the
+// resulting bytes are returned.
+func SerializeCosmosQuery(cdc codec.BinaryCodec, requests []QueryRequest, encoding string) (bz []byte, err error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for sending queries to the host chain")
+	}
+
+	cosmosQuery := &CosmosQuery{
+		Requests: requests,
+	}
+
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = protoCdc.Marshal(cosmosQuery)
+	case EncodingProto3JSON:
+		bz, err = protoCdc.MarshalJSON(cosmosQuery)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query bytes encoded using the provided encoding
+// into a slice of query requests.
+func DeserializeCosmosQuery(cdc codec.BinaryCodec, data []byte, encoding string) ([]QueryRequest, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving queries on the host chain")
+	}
+
+	var cosmosQuery CosmosQuery
+	switch encoding {
+	case EncodingProtobuf:
+		if err := protoCdc.Unmarshal(data, &cosmosQuery); err != nil {
+			return nil, err
+		}
+	case EncodingProto3JSON:
+		if err := protoCdc.UnmarshalJSON(data, &cosmosQuery); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// DeserializeCosmosQueryResponse unmarshals the result bytes of a query packet acknowledgement encoded
+// using the provided encoding into a CosmosQueryResponse.
+func DeserializeCosmosQueryResponse(cdc codec.BinaryCodec, data []byte, encoding string) (CosmosQueryResponse, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return CosmosQueryResponse{}, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving query responses on the controller chain")
+	}
+
+	var queryResponse CosmosQueryResponse
+	switch encoding {
+	case EncodingProtobuf:
+		if err := protoCdc.Unmarshal(data, &queryResponse); err != nil {
+			return CosmosQueryResponse{}, err
+		}
+	case EncodingProto3JSON:
+		if err := protoCdc.UnmarshalJSON(data, &queryResponse); err != nil {
+			return CosmosQueryResponse{}, err
+		}
+	default:
+		return CosmosQueryResponse{}, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	return queryResponse, nil
+}
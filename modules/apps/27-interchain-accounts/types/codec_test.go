@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -112,14 +114,14 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 	testCasesAny := []caseRawBytes{}
 
 	for _, tc := range testCases {
-		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
+		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs, types.EncodingProtobuf)
 		suite.Require().NoError(err, tc.name)
 
 		testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
 	}
 
 	for i, tc := range testCasesAny {
-		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz)
+		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz, types.EncodingProtobuf)
 		if tc.expPass {
 			suite.Require().NoError(err, tc.name)
 			suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
@@ -129,11 +131,124 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 	}
 
 	// test deserializing unknown bytes
-	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"))
+	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"), types.EncodingProtobuf)
+	suite.Require().Error(err)
+	suite.Require().Empty(msgs)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTxProto3JSON() {
+	marshaler := simapp.MakeTestEncodingConfig().Marshaler
+
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+		},
+		&govtypes.MsgSubmitProposal{
+			InitialDeposit: sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+			Proposer:       TestOwnerAddress,
+		},
+	}
+
+	bz, err := types.SerializeCosmosTx(marshaler, msgs, types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), `"@type":"/cosmos.bank.v1beta1.MsgSend"`)
+
+	deserializedMsgs, err := types.DeserializeCosmosTx(marshaler, bz, types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().Equal(msgs, deserializedMsgs)
+
+	// a transaction written by hand, as done by non-Go controllers
+	bz = []byte(fmt.Sprintf(`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"bananas","amount":"100"}]}]}`, TestOwnerAddress, TestOwnerAddress))
+	deserializedMsgs, err = types.DeserializeCosmosTx(marshaler, bz, types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().Equal(msgs[:1], deserializedMsgs)
+
+	// protobuf bytes cannot be deserialized as proto3 JSON
+	bz, err = types.SerializeCosmosTx(marshaler, msgs, types.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	deserializedMsgs, err = types.DeserializeCosmosTx(marshaler, bz, types.EncodingProto3JSON)
 	suite.Require().Error(err)
+	suite.Require().Empty(deserializedMsgs)
+
+	// unregistered msg types cannot be serialized as proto3 JSON
+	bz, err = types.SerializeCosmosTx(marshaler, []sdk.Msg{&mockSdkMsg{}}, types.EncodingProto3JSON)
+	suite.Require().Error(err)
+	suite.Require().Empty(bz)
+
+	// unknown type URLs cannot be deserialized
+	deserializedMsgs, err = types.DeserializeCosmosTx(marshaler, []byte(`{"messages":[{"@type":"/unknown.MsgUnknown"}]}`), types.EncodingProto3JSON)
+	suite.Require().Error(err)
+	suite.Require().Empty(deserializedMsgs)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTxUnsupportedEncoding() {
+	marshaler := simapp.MakeTestEncodingConfig().Marshaler
+
+	bz, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}}, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+
+	msgs, err := types.DeserializeCosmosTx(marshaler, []byte("{}"), "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
 	suite.Require().Empty(msgs)
 }
 
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	marshaler := simapp.MakeTestEncodingConfig().Marshaler
+
+	requests := []types.QueryRequest{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: marshaler.MustMarshal(&banktypes.QueryBalanceRequest{Address: TestOwnerAddress, Denom: "bananas"}),
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosQuery(marshaler, requests, encoding)
+		suite.Require().NoError(err)
+
+		deserializedRequests, err := types.DeserializeCosmosQuery(marshaler, bz, encoding)
+		suite.Require().NoError(err)
+		suite.Require().Equal(requests, deserializedRequests)
+	}
+
+	bz, err := types.SerializeCosmosQuery(marshaler, requests, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+
+	deserializedRequests, err := types.DeserializeCosmosQuery(marshaler, []byte("{}"), "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(deserializedRequests)
+}
+
+func (suite *TypesTestSuite) TestDeserializeCosmosQueryResponse() {
+	marshaler := simapp.MakeTestEncodingConfig().Marshaler
+
+	queryResponse := types.CosmosQueryResponse{
+		Responses: []types.QueryResponse{
+			{Data: marshaler.MustMarshal(&banktypes.QueryBalanceResponse{Balance: &sdk.Coin{Denom: "bananas", Amount: sdk.NewInt(100)}})},
+		},
+		Height: 10,
+	}
+
+	bz := marshaler.MustMarshal(&queryResponse)
+	res, err := types.DeserializeCosmosQueryResponse(marshaler, bz, types.EncodingProtobuf)
+	suite.Require().NoError(err)
+	suite.Require().Equal(queryResponse, res)
+
+	bz = marshaler.MustMarshalJSON(&queryResponse)
+	res, err = types.DeserializeCosmosQueryResponse(marshaler, bz, types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().Equal(queryResponse, res)
+
+	res, err = types.DeserializeCosmosQueryResponse(marshaler, bz, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Equal(types.CosmosQueryResponse{}, res)
+}
+
 // unregistered bytes causes amino to panic.
 // test that DeserializeCosmosTx gracefully returns an error on
 // unsupported amino codec.
@@ -141,11 +256,11 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	cdc := codec.NewLegacyAmino()
 	marshaler := codec.NewAminoCodec(cdc)
 
-	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}})
+	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(msgs)
 
-	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0})
+	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(bz)
 }
@@ -2,10 +2,15 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket                   = "ics27_packet"
+	EventTypeTransferAccountOwnership = "ics27_transfer_account_ownership"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyControllerPortID    = "controller_port_id"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyNewOwner            = "new_owner"
 )
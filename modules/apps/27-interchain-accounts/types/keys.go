@@ -39,6 +39,16 @@ var (
 
 	// IsMiddlewareEnabledPrefix defines the key prefix used to store a flag for legacy API callback routing via ibc middleware
 	IsMiddlewareEnabledPrefix = "isMiddlewareEnabled"
+
+	// PacketOwnerKeyPrefix defines the key prefix used to store the owner of packets awaiting acknowledgement or timeout
+	PacketOwnerKeyPrefix = "packetOwner"
+
+	// AccountOwnerKeyPrefix defines the key prefix used to store the owners interchain accounts have been transferred to
+	AccountOwnerKeyPrefix = "accountOwner"
+
+	// ControllerPortKeyPrefix defines the key prefix used to store the controller ports of interchain accounts
+	// indexed by their owner
+	ControllerPortKeyPrefix = "controllerPort"
 )
 
 // KeyActiveChannel creates and returns a new key used for active channels store operations
@@ -60,3 +70,19 @@ func KeyPort(portID string) []byte {
 func KeyIsMiddlewareEnabled(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareEnabledPrefix, portID, channelID))
 }
+
+// KeyPacketOwner creates and returns a new key used for packet owner store operations
+func KeyPacketOwner(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PacketOwnerKeyPrefix, portID, channelID, sequence))
+}
+
+// KeyAccountOwner creates and returns a new key used for interchain account owner store operations
+func KeyAccountOwner(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", AccountOwnerKeyPrefix, portID, connectionID))
+}
+
+// KeyControllerPort creates and returns a new key used for owner controller port store operations.
+// The owner precedes the connectionID, allowing the interchain accounts of an owner to be iterated
+func KeyControllerPort(owner, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ControllerPortKeyPrefix, owner, connectionID))
+}
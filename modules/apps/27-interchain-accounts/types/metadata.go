@@ -1,15 +1,20 @@
 package types
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	connectiontypes "github.com/cosmos/ibc-go/v5/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 )
 
 const (
 	// EncodingProtobuf defines the protocol buffers proto3 encoding format
 	EncodingProtobuf = "proto3"
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
 
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
@@ -46,11 +51,70 @@ func NewDefaultMetadata(controllerConnectionID, hostConnectionID string) Metadat
 func NewDefaultMetadataString(controllerConnectionID, hostConnectionID string) string {
 	metadata := NewDefaultMetadata(controllerConnectionID, hostConnectionID)
 
-	return string(ModuleCdc.MustMarshalJSON(&metadata))
+	version, err := MetadataToVersion(metadata)
+	if err != nil {
+		panic(err)
+	}
+
+	return version
+}
+
+// MetadataToVersion returns the JSON encoded channel version string of the provided ICS27 Metadata.
+// An empty ordering is omitted, as counterparties which do not support the ordering field reject unknown fields.
+func MetadataToVersion(metadata Metadata) (string, error) {
+	bz, err := ModuleCdc.MarshalJSON(&metadata)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata.Ordering == "" {
+		// the ordering is the last field of the metadata, string values cannot contain unescaped quotes
+		bz = bytes.Replace(bz, []byte(`,"ordering":""`), nil, 1)
+	}
+
+	return string(bz), nil
+}
+
+// MetadataFromVersion unmarshals and returns the ICS27 Metadata contained in the provided channel version string
+func MetadataFromVersion(version string) (Metadata, error) {
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return Metadata{}, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return metadata, nil
+}
+
+// ChannelOrdering returns the channel ordering of the metadata. An empty ordering denotes an ORDERED channel,
+// as the metadata of counterparties which do not support the ordering field does not contain it.
+func (m Metadata) ChannelOrdering() channeltypes.Order {
+	if m.Ordering == "" {
+		return channeltypes.ORDERED
+	}
+
+	return channeltypes.Order(channeltypes.Order_value[m.Ordering])
+}
+
+// SetOrdering sets the metadata ordering to the provided channel ordering if it is empty.
+// An error is returned if the metadata ordering is set and does not match the channel ordering.
+// The ordering of ORDERED channels is left empty, such that the metadata remains compatible with
+// counterparties which do not support the ordering field.
+func (m *Metadata) SetOrdering(order channeltypes.Order) error {
+	if m.Ordering != "" && m.Ordering != order.String() {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s, got %s", order, m.Ordering)
+	}
+
+	m.Ordering = ""
+	if order != channeltypes.ORDERED {
+		m.Ordering = order.String()
+	}
+
+	return nil
 }
 
 // IsPreviousMetadataEqual compares a metadata to a previous version string set in a channel struct.
-// It ensures all fields are equal except the Address string
+// It ensures all fields are equal except the Address and Ordering strings, as a closed channel may be
+// reopened using a different ordering
 func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
 	var previousMetadata Metadata
 	if err := ModuleCdc.UnmarshalJSON([]byte(previousVersion), &previousMetadata); err != nil {
@@ -74,6 +138,10 @@ func ValidateControllerMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, co
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if metadata.Ordering != "" && !isSupportedOrdering(metadata.Ordering) {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "unsupported channel ordering %s", metadata.Ordering)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -106,6 +174,10 @@ func ValidateHostMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, connecti
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if metadata.Ordering != "" && !isSupportedOrdering(metadata.Ordering) {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "unsupported channel ordering %s", metadata.Ordering)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -141,7 +213,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf}
+	return []string{EncodingProtobuf, EncodingProto3JSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -160,6 +232,22 @@ func getSupportedTxTypes() []string {
 	return []string{TxTypeSDKMultiMsg}
 }
 
+// isSupportedOrdering returns true if the provided channel ordering is supported, otherwise false
+func isSupportedOrdering(ordering string) bool {
+	for _, o := range getSupportedOrderings() {
+		if o == ordering {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSupportedOrderings returns a string slice of supported channel orderings
+func getSupportedOrderings() []string {
+	return []string{channeltypes.ORDERED.String(), channeltypes.UNORDERED.String()}
+}
+
 // validateConnectionParams compares the given the controller and host connection IDs to those set in the provided ICS27 Metadata
 func validateConnectionParams(metadata Metadata, controllerConnectionID, hostConnectionID string) error {
 	if metadata.ControllerConnectionId != controllerConnectionID {
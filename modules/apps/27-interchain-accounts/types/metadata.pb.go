@@ -39,6 +39,10 @@ type Metadata struct {
 	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
 	// tx_type defines the type of transactions the interchain account can execute
 	TxType string `protobuf:"bytes,6,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// ordering defines the ordering of the channel, it is only set to ORDER_UNORDERED for unordered channels.
+	// NOTE: an empty ordering denotes an ORDER_ORDERED channel and is omitted from the channel version, such that the
+	// metadata remains compatible with counterparties which do not support the ordering field
+	Ordering string `protobuf:"bytes,7,opt,name=ordering,proto3" json:"ordering,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -116,6 +120,13 @@ func (m *Metadata) GetTxType() string {
 	return ""
 }
 
+func (m *Metadata) GetOrdering() string {
+	if m != nil {
+		return m.Ordering
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "ibc.applications.interchain_accounts.v1.Metadata")
 }
@@ -125,29 +136,30 @@ func init() {
 }
 
 var fileDescriptor_c29c32e397d1f21e = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x18, 0xc5, 0x9b, 0xde, 0x6b, 0x5b, 0x67, 0x25, 0x83, 0xe8, 0x58, 0x30, 0x95, 0xb8, 0xd0, 0x4d,
-	0x33, 0x54, 0x51, 0xc1, 0x65, 0xc5, 0x85, 0x88, 0x9b, 0xe2, 0x4a, 0x90, 0x30, 0x99, 0x19, 0xd2,
-	0x81, 0x64, 0xbe, 0x90, 0x99, 0x86, 0xf6, 0x2d, 0x7c, 0x03, 0x5f, 0xc7, 0x65, 0x97, 0xae, 0x8a,
-	0xb4, 0x6f, 0xd0, 0x27, 0x90, 0xa4, 0xb5, 0xf5, 0xef, 0x2e, 0x27, 0xe7, 0x9c, 0xdf, 0xc7, 0x70,
-	0xd0, 0xb9, 0x0a, 0x39, 0x65, 0x69, 0x1a, 0x2b, 0xce, 0xac, 0x02, 0x6d, 0xa8, 0xd2, 0x56, 0x66,
-	0xbc, 0xcf, 0x94, 0x0e, 0x18, 0xe7, 0x30, 0xd0, 0xd6, 0xd0, 0xbc, 0x43, 0x13, 0x69, 0x99, 0x60,
-	0x96, 0xf9, 0x69, 0x06, 0x16, 0xf0, 0x91, 0x0a, 0xb9, 0xff, 0xb9, 0xe7, 0xff, 0xd2, 0xf3, 0xf3,
-	0x4e, 0x73, 0x3b, 0x82, 0x08, 0xca, 0x0e, 0x2d, 0xbe, 0x16, 0x75, 0xef, 0xb9, 0x8a, 0x1a, 0x77,
-	0x4b, 0x22, 0x26, 0xa8, 0x9e, 0xcb, 0xcc, 0x28, 0xd0, 0xc4, 0x39, 0x70, 0x8e, 0x37, 0x7b, 0x1f,
-	0x12, 0x3f, 0x22, 0xc2, 0x41, 0xdb, 0x0c, 0xe2, 0x58, 0x66, 0x01, 0x07, 0xad, 0x25, 0x2f, 0xae,
-	0x05, 0x4a, 0x90, 0x6a, 0x11, 0xed, 0x1e, 0xce, 0x27, 0xad, 0xd6, 0x88, 0x25, 0xf1, 0xa5, 0xf7,
-	0x57, 0xd2, 0xeb, 0xed, 0xac, 0xad, 0xab, 0x95, 0x73, 0x23, 0xf0, 0x2d, 0xc2, 0x7d, 0x30, 0xf6,
-	0x1b, 0xf8, 0x5f, 0x09, 0xde, 0x9f, 0x4f, 0x5a, 0x7b, 0x0b, 0xf0, 0xcf, 0x8c, 0xd7, 0xdb, 0x2a,
-	0x7e, 0x7e, 0x81, 0x11, 0x54, 0x67, 0x42, 0x64, 0xd2, 0x18, 0xf2, 0x7f, 0xf1, 0x8a, 0xa5, 0xc4,
-	0x4d, 0xd4, 0x90, 0x9a, 0x83, 0x50, 0x3a, 0x22, 0x1b, 0xa5, 0xb5, 0xd2, 0x78, 0x17, 0xd5, 0xed,
-	0x30, 0xb0, 0xa3, 0x54, 0x92, 0x5a, 0x69, 0xd5, 0xec, 0xf0, 0x7e, 0x94, 0xca, 0x6e, 0xf0, 0x32,
-	0x75, 0x9d, 0xf1, 0xd4, 0x75, 0xde, 0xa6, 0xae, 0xf3, 0x34, 0x73, 0x2b, 0xe3, 0x99, 0x5b, 0x79,
-	0x9d, 0xb9, 0x95, 0x87, 0xeb, 0x48, 0xd9, 0xfe, 0x20, 0xf4, 0x39, 0x24, 0x94, 0x83, 0x49, 0xc0,
-	0x50, 0x15, 0xf2, 0x76, 0x04, 0x34, 0x3f, 0xa3, 0x09, 0x88, 0x41, 0x2c, 0x4d, 0x31, 0xa9, 0xa1,
-	0x27, 0x17, 0xed, 0xf5, 0x2a, 0xed, 0xd5, 0x9a, 0xc5, 0x35, 0x13, 0xd6, 0xca, 0x25, 0x4e, 0xdf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x57, 0x3d, 0x58, 0x02, 0x02, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4d, 0x6b, 0xdb, 0x30,
+	0x1c, 0xc6, 0xe3, 0x6c, 0x8b, 0x33, 0x9d, 0x86, 0x19, 0x9b, 0x16, 0x98, 0x33, 0xbc, 0xc3, 0x76,
+	0x89, 0x45, 0x36, 0xb6, 0xc1, 0x8e, 0x29, 0x3d, 0x94, 0xd2, 0x4b, 0xe8, 0xa9, 0x50, 0x8c, 0x2c,
+	0x09, 0x47, 0x60, 0xeb, 0x6f, 0x24, 0xc5, 0x24, 0xdf, 0xa2, 0xdf, 0xa7, 0x5f, 0xa0, 0xc7, 0x1c,
+	0x7b, 0x0a, 0x25, 0xf9, 0x06, 0xf9, 0x04, 0x45, 0x76, 0x5e, 0xfa, 0x7a, 0xf3, 0xe3, 0xe7, 0xf9,
+	0x3d, 0x92, 0x78, 0xd0, 0x5f, 0x99, 0x32, 0x42, 0xcb, 0x32, 0x97, 0x8c, 0x5a, 0x09, 0xca, 0x10,
+	0xa9, 0xac, 0xd0, 0x6c, 0x42, 0xa5, 0x4a, 0x28, 0x63, 0x30, 0x55, 0xd6, 0x90, 0x6a, 0x48, 0x0a,
+	0x61, 0x29, 0xa7, 0x96, 0xc6, 0xa5, 0x06, 0x0b, 0xc1, 0x0f, 0x99, 0xb2, 0xf8, 0x21, 0x17, 0xbf,
+	0xc0, 0xc5, 0xd5, 0xb0, 0xf7, 0x31, 0x83, 0x0c, 0x6a, 0x86, 0xb8, 0xaf, 0x06, 0x8f, 0xae, 0xdb,
+	0xa8, 0x7b, 0xb6, 0x6d, 0x0c, 0x30, 0xf2, 0x2b, 0xa1, 0x8d, 0x04, 0x85, 0xbd, 0x6f, 0xde, 0xcf,
+	0xf7, 0xe3, 0x9d, 0x0c, 0x2e, 0x11, 0x66, 0xa0, 0xac, 0x86, 0x3c, 0x17, 0x3a, 0x61, 0xa0, 0x94,
+	0x60, 0xee, 0xb4, 0x44, 0x72, 0xdc, 0x76, 0xd1, 0xd1, 0xf7, 0xcd, 0xb2, 0xdf, 0x9f, 0xd3, 0x22,
+	0xff, 0x1f, 0xbd, 0x96, 0x8c, 0xc6, 0x9f, 0x0e, 0xd6, 0xd1, 0xde, 0x39, 0xe1, 0xc1, 0x29, 0x0a,
+	0x26, 0x60, 0xec, 0x93, 0xe2, 0x37, 0x75, 0xf1, 0xd7, 0xcd, 0xb2, 0xff, 0xa5, 0x29, 0x7e, 0x9e,
+	0x89, 0xc6, 0x1f, 0xdc, 0xcf, 0x47, 0x65, 0x18, 0xf9, 0x94, 0x73, 0x2d, 0x8c, 0xc1, 0x6f, 0x9b,
+	0x57, 0x6c, 0x65, 0xd0, 0x43, 0x5d, 0xa1, 0x18, 0x70, 0xa9, 0x32, 0xfc, 0xae, 0xb6, 0xf6, 0x3a,
+	0xf8, 0x8c, 0x7c, 0x3b, 0x4b, 0xec, 0xbc, 0x14, 0xb8, 0x53, 0x5b, 0x1d, 0x3b, 0x3b, 0x9f, 0x97,
+	0xc2, 0x41, 0xa0, 0xb9, 0xd0, 0x0e, 0xf2, 0x1b, 0x68, 0xa7, 0x47, 0xc9, 0xcd, 0x2a, 0xf4, 0x16,
+	0xab, 0xd0, 0xbb, 0x5b, 0x85, 0xde, 0xd5, 0x3a, 0x6c, 0x2d, 0xd6, 0x61, 0xeb, 0x76, 0x1d, 0xb6,
+	0x2e, 0x8e, 0x33, 0x69, 0x27, 0xd3, 0x34, 0x66, 0x50, 0x10, 0x06, 0xa6, 0x00, 0x43, 0x64, 0xca,
+	0x06, 0x19, 0x90, 0xea, 0x0f, 0x29, 0x80, 0x4f, 0x73, 0x61, 0xdc, 0xdc, 0x86, 0xfc, 0xfa, 0x37,
+	0x38, 0x2c, 0x36, 0xd8, 0x2f, 0xed, 0x6e, 0x62, 0xd2, 0x4e, 0xbd, 0xd2, 0xef, 0xfb, 0x01, 0x00,
+	0xe2, 0xab, 0xdd, 0xd8, 0x1e, 0x02, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -170,6 +182,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Ordering) > 0 {
+		i -= len(m.Ordering)
+		copy(dAtA[i:], m.Ordering)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Ordering)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.TxType) > 0 {
 		i -= len(m.TxType)
 		copy(dAtA[i:], m.TxType)
@@ -256,6 +275,10 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	l = len(m.Ordering)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -486,6 +509,38 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.TxType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 )
 
@@ -37,6 +38,17 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 			},
 			true,
 		},
+		{
+			"success with different channel ordering",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED.String()
+
+				versionBytes, err := types.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+				previousVersion = string(versionBytes)
+			},
+			true,
+		},
 		{
 			"cannot decode previous version",
 			func() {
@@ -166,6 +178,21 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			false,
 		},
+		{
+			"unsupported channel ordering",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					Ordering:               channeltypes.NONE.String(),
+				}
+			},
+			false,
+		},
 		{
 			"unsupported transaction type",
 			func() {
@@ -307,6 +334,21 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			false,
 		},
+		{
+			"unsupported channel ordering",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					Ordering:               channeltypes.NONE.String(),
+				}
+			},
+			false,
+		},
 		{
 			"unsupported transaction type",
 			func() {
@@ -406,3 +448,68 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMetadataFromVersion() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProto3JSON, types.TxTypeSDKMultiMsg)
+
+	res, err := types.MetadataFromVersion(string(types.ModuleCdc.MustMarshalJSON(&metadata)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, res)
+
+	res, err = types.MetadataFromVersion("invalid-metadata-bytestring")
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+	suite.Require().Equal(types.Metadata{}, res)
+}
+
+func (suite *TypesTestSuite) TestSetOrdering() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, "", types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+
+	// an empty ordering is set to the channel ordering
+	err := metadata.SetOrdering(channeltypes.UNORDERED)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.UNORDERED.String(), metadata.Ordering)
+
+	// a matching ordering is accepted
+	err = metadata.SetOrdering(channeltypes.UNORDERED)
+	suite.Require().NoError(err)
+
+	// a mismatched ordering is rejected
+	err = metadata.SetOrdering(channeltypes.ORDERED)
+	suite.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+	suite.Require().Equal(channeltypes.UNORDERED.String(), metadata.Ordering)
+}
+
+func (suite *TypesTestSuite) TestSetOrderingOrdered() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, "", types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+	metadata.Ordering = channeltypes.ORDERED.String()
+
+	// the ordering of ORDERED channels is left empty
+	err := metadata.SetOrdering(channeltypes.ORDERED)
+	suite.Require().NoError(err)
+	suite.Require().Empty(metadata.Ordering)
+	suite.Require().Equal(channeltypes.ORDERED, metadata.ChannelOrdering())
+}
+
+func (suite *TypesTestSuite) TestMetadataToVersion() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, "", types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+
+	// an empty ordering is omitted
+	version, err := types.MetadataToVersion(metadata)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"version":"ics27-1","controller_connection_id":"connection-0","host_connection_id":"connection-0","address":"","encoding":"proto3","tx_type":"sdk_multi_msg"}`, version)
+
+	res, err := types.MetadataFromVersion(version)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, res)
+	suite.Require().Equal(channeltypes.ORDERED, res.ChannelOrdering())
+
+	metadata.Ordering = channeltypes.UNORDERED.String()
+
+	version, err = types.MetadataToVersion(metadata)
+	suite.Require().NoError(err)
+	suite.Require().Contains(version, `"ordering":"ORDER_UNORDERED"`)
+
+	res, err = types.MetadataFromVersion(version)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.UNORDERED, res.ChannelOrdering())
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -43,8 +44,26 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 }
 
 // GetBytes returns the JSON marshalled interchain account packet data.
+// The gas limit is omitted when it is not set, so that the packet data remains decodable by host chains which do not
+// support gas limits.
 func (iapd InterchainAccountPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
+	bz := ModuleCdc.MustMarshalJSON(&iapd)
+
+	if iapd.GasLimit == 0 {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			panic(err)
+		}
+
+		delete(fields, "gas_limit")
+
+		var err error
+		if bz, err = json.Marshal(fields); err != nil {
+			panic(err)
+		}
+	}
+
+	return sdk.MustSortJSON(bz)
 }
 
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
@@ -33,16 +33,20 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute a list of gRPC queries on an interchain accounts host chain
+	QUERY Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_QUERY",
 }
 
 var Type_value = map[string]int32{
 	"TYPE_UNSPECIFIED": 0,
 	"TYPE_EXECUTE_TX":  1,
+	"TYPE_QUERY":       2,
 }
 
 func (x Type) String() string {
@@ -53,11 +57,15 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_89a080d7401cd393, []int{0}
 }
 
-// InterchainAccountPacketData is comprised of a raw transaction, type of transaction and optional memo field.
+// InterchainAccountPacketData is comprised of a raw transaction, type of transaction, optional memo field and optional
+// gas limit.
 type InterchainAccountPacketData struct {
 	Type Type   `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// gas_limit defines the maximum amount of gas the host chain may consume executing the transaction. A zero value
+	// does not request a limit, the host chain may still enforce its own limit.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *InterchainAccountPacketData) Reset()         { *m = InterchainAccountPacketData{} }
@@ -114,6 +122,13 @@ func (m *InterchainAccountPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *InterchainAccountPacketData) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // CosmosTx contains a list of sdk.Msg's. It should be used when sending transactions to an SDK host chain.
 type CosmosTx struct {
 	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -159,10 +174,214 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// CosmosQuery contains a list of gRPC query requests. It should be used when querying the state of an SDK host chain.
+type CosmosQuery struct {
+	Requests []QueryRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+}
+
+func (m *CosmosQuery) Reset()         { *m = CosmosQuery{} }
+func (m *CosmosQuery) String() string { return proto.CompactTextString(m) }
+func (*CosmosQuery) ProtoMessage()    {}
+func (*CosmosQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *CosmosQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQuery.Merge(m, src)
+}
+func (m *CosmosQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQuery proto.InternalMessageInfo
+
+func (m *CosmosQuery) GetRequests() []QueryRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// QueryRequest defines a single gRPC query executed on the host chain.
+type QueryRequest struct {
+	// path defines the fully qualified gRPC method path of the query, e.g. /cosmos.bank.v1beta1.Query/Balance
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data defines the protobuf encoded gRPC request type of the query
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CosmosQueryResponse contains the responses to the queries of a CosmosQuery, in the order of the requests.
+type CosmosQueryResponse struct {
+	Responses []QueryResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses"`
+	// height defines the host chain block height at which the queries were executed
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CosmosQueryResponse) Reset()         { *m = CosmosQueryResponse{} }
+func (m *CosmosQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*CosmosQueryResponse) ProtoMessage()    {}
+func (*CosmosQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{4}
+}
+func (m *CosmosQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQueryResponse.Merge(m, src)
+}
+func (m *CosmosQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQueryResponse proto.InternalMessageInfo
+
+func (m *CosmosQueryResponse) GetResponses() []QueryResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *CosmosQueryResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryResponse defines the response to a single gRPC query executed on the host chain.
+type QueryResponse struct {
+	// data defines the protobuf encoded gRPC response type of the query
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
+func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResponse) ProtoMessage()    {}
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{5}
+}
+func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResponse.Merge(m, src)
+}
+func (m *QueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResponse proto.InternalMessageInfo
+
+func (m *QueryResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*CosmosQuery)(nil), "ibc.applications.interchain_accounts.v1.CosmosQuery")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.v1.QueryRequest")
+	proto.RegisterType((*CosmosQueryResponse)(nil), "ibc.applications.interchain_accounts.v1.CosmosQueryResponse")
+	proto.RegisterType((*QueryResponse)(nil), "ibc.applications.interchain_accounts.v1.QueryResponse")
 }
 
 func init() {
@@ -170,32 +389,41 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0x71, 0xe3, 0xa6, 0xb6, 0x11, 0xba, 0x91, 0x12, 0x53, 0xa8, 0x6e, 0xc2,
-	0x64, 0x3a, 0xa6, 0x83, 0x4d, 0x26, 0x74, 0x26, 0xc5, 0xfc, 0x41, 0xe9, 0xca, 0x1f, 0xe8, 0xca,
-	0x9f, 0x71, 0xd9, 0xa5, 0x4b, 0x69, 0x7f, 0x44, 0x32, 0xc1, 0xb6, 0x0b, 0x17, 0xee, 0x0e, 0x87,
-	0x7b, 0xce, 0xbd, 0xe7, 0x1e, 0xf8, 0x8a, 0xa7, 0x14, 0x93, 0xb2, 0x5c, 0x70, 0x4a, 0x14, 0x17,
-	0x85, 0xc4, 0xbc, 0x50, 0x6c, 0x49, 0xe7, 0x84, 0x17, 0x09, 0xa1, 0x54, 0x54, 0x85, 0x92, 0x78,
-	0xd5, 0xc5, 0x25, 0xa1, 0x5f, 0x99, 0x42, 0xe5, 0x52, 0x28, 0x61, 0x3d, 0xe3, 0x29, 0x45, 0xe7,
-	0x2a, 0xf4, 0x0f, 0x15, 0x5a, 0x75, 0x9d, 0x47, 0x99, 0x10, 0xd9, 0x82, 0x61, 0x2d, 0x4b, 0xab,
-	0x2f, 0x98, 0x14, 0x75, 0xeb, 0xe1, 0xdc, 0x66, 0x22, 0x13, 0x1a, 0xe2, 0x06, 0xb5, 0xec, 0xdd,
-	0x1a, 0xc0, 0xc7, 0xa3, 0xa3, 0x57, 0xbf, 0xb5, 0x1a, 0xeb, 0xdd, 0x43, 0xa2, 0x88, 0xd5, 0x87,
-	0xa6, 0xaa, 0x4b, 0x66, 0x03, 0x0f, 0xf8, 0xd7, 0xbd, 0x00, 0xfd, 0xe7, 0x21, 0x28, 0xae, 0x4b,
-	0x16, 0x69, 0xa9, 0x65, 0x41, 0x73, 0x46, 0x14, 0xb1, 0xef, 0x79, 0xc0, 0x7f, 0x18, 0x69, 0xdc,
-	0x70, 0x39, 0xcb, 0x85, 0x7d, 0xe1, 0x01, 0xff, 0x41, 0xa4, 0xf1, 0xdd, 0x5b, 0x78, 0x39, 0x10,
-	0x32, 0x17, 0x32, 0xfe, 0x66, 0xbd, 0x80, 0x97, 0x39, 0x93, 0x92, 0x64, 0x4c, 0xda, 0xc0, 0xbb,
-	0xf0, 0xaf, 0x7a, 0xb7, 0xa8, 0x8d, 0x86, 0xfe, 0x46, 0x43, 0xfd, 0xa2, 0x8e, 0x8e, 0x53, 0xcf,
-	0xa7, 0xd0, 0x6c, 0x76, 0x5a, 0x4f, 0x61, 0x27, 0xfe, 0x34, 0x0e, 0x93, 0xc9, 0x87, 0x8f, 0xe3,
-	0x70, 0x30, 0x7a, 0x3f, 0x0a, 0x87, 0x1d, 0xc3, 0xb9, 0xd9, 0x6c, 0xbd, 0xab, 0x33, 0xca, 0x7a,
-	0x02, 0x6f, 0xf4, 0x58, 0x38, 0x0d, 0x07, 0x93, 0x38, 0x4c, 0xe2, 0x69, 0x07, 0x38, 0xd7, 0x9b,
-	0xad, 0x07, 0x4f, 0x8c, 0x63, 0xae, 0x7f, 0xb8, 0xc6, 0xbb, 0xe4, 0xe7, 0xde, 0x05, 0xbb, 0xbd,
-	0x0b, 0x7e, 0xef, 0x5d, 0xf0, 0xfd, 0xe0, 0x1a, 0xbb, 0x83, 0x6b, 0xfc, 0x3a, 0xb8, 0xc6, 0xe7,
-	0x30, 0xe3, 0x6a, 0x5e, 0xa5, 0x88, 0x8a, 0x1c, 0x53, 0x7d, 0x3a, 0xe6, 0x29, 0x0d, 0x32, 0x81,
-	0x57, 0xaf, 0x71, 0x2e, 0x66, 0xd5, 0x82, 0xc9, 0xa6, 0x6c, 0x89, 0x7b, 0x6f, 0x82, 0xd3, 0xa3,
-	0x82, 0x63, 0xcf, 0xcd, 0x7f, 0x64, 0x7a, 0x5f, 0x47, 0x7a, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x74, 0x06, 0x2b, 0x17, 0x1c, 0x02, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xf4, 0x36, 0xa6, 0x4a, 0x36, 0xa5, 0x8d, 0x96, 0x0a, 0xa5, 0xae, 0x64, 0x2c, 0x57, 0x88,
+	0x08, 0x29, 0x5e, 0x1a, 0x68, 0xb9, 0x70, 0x49, 0x53, 0x23, 0x45, 0x42, 0x28, 0x35, 0x89, 0x68,
+	0x7b, 0x89, 0xd6, 0xee, 0xd6, 0xb1, 0x88, 0xbd, 0x26, 0xbb, 0x8e, 0xc8, 0x1b, 0x40, 0x4e, 0xbc,
+	0x40, 0x4e, 0x88, 0x77, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x2f, 0x82, 0xbc, 0xce, 0x1f, 0x52,
+	0x0f, 0xe5, 0x36, 0xdf, 0xe7, 0x6f, 0xc6, 0xf3, 0xcd, 0xee, 0xc2, 0x57, 0x81, 0xeb, 0x61, 0x12,
+	0xc7, 0xfd, 0xc0, 0x23, 0x22, 0x60, 0x11, 0xc7, 0x41, 0x24, 0xe8, 0xc0, 0xeb, 0x91, 0x20, 0xea,
+	0x12, 0xcf, 0x63, 0x49, 0x24, 0x38, 0x1e, 0x1e, 0xe2, 0x98, 0x78, 0x9f, 0xa8, 0xb0, 0xe2, 0x01,
+	0x13, 0x0c, 0x3d, 0x0b, 0x5c, 0xcf, 0x5a, 0x67, 0x59, 0x77, 0xb0, 0xac, 0xe1, 0xa1, 0xb6, 0xe7,
+	0x33, 0xe6, 0xf7, 0x29, 0x96, 0x34, 0x37, 0xb9, 0xc6, 0x24, 0x1a, 0x65, 0x1a, 0xda, 0xae, 0xcf,
+	0x7c, 0x26, 0x21, 0x4e, 0x51, 0xd6, 0x35, 0x7f, 0x02, 0xb8, 0xdf, 0x5c, 0x6a, 0xd5, 0x33, 0xa9,
+	0x96, 0xfc, 0xf7, 0x29, 0x11, 0x04, 0xd5, 0xa1, 0x2a, 0x46, 0x31, 0x2d, 0x03, 0x03, 0x54, 0xb6,
+	0x6b, 0x55, 0xeb, 0x9e, 0x46, 0xac, 0xf6, 0x28, 0xa6, 0x8e, 0xa4, 0x22, 0x04, 0xd5, 0x2b, 0x22,
+	0x48, 0x79, 0xc3, 0x00, 0x95, 0x2d, 0x47, 0xe2, 0xb4, 0x17, 0xd2, 0x90, 0x95, 0x73, 0x06, 0xa8,
+	0x14, 0x1c, 0x89, 0xd1, 0x3e, 0x2c, 0xf8, 0x84, 0x77, 0xfb, 0x41, 0x18, 0x88, 0xb2, 0x6a, 0x80,
+	0x8a, 0xea, 0xe4, 0x7d, 0xc2, 0xdf, 0xa5, 0xb5, 0xf9, 0x06, 0xe6, 0x1b, 0x8c, 0x87, 0x8c, 0xb7,
+	0xbf, 0xa0, 0x17, 0x30, 0x1f, 0x52, 0xce, 0x89, 0x4f, 0x79, 0x19, 0x18, 0xb9, 0x4a, 0xb1, 0xb6,
+	0x6b, 0x65, 0x7b, 0x5b, 0x8b, 0xbd, 0xad, 0x7a, 0x34, 0x72, 0x96, 0x53, 0xe6, 0x35, 0x2c, 0x66,
+	0xec, 0xb3, 0x84, 0x0e, 0x46, 0xe8, 0x23, 0xcc, 0x0f, 0xe8, 0xe7, 0x84, 0x72, 0xb1, 0x10, 0x38,
+	0xba, 0xf7, 0x62, 0x52, 0xc1, 0xc9, 0xd8, 0x27, 0xea, 0xcd, 0xef, 0x27, 0x8a, 0xb3, 0x14, 0x33,
+	0x8f, 0xe1, 0xd6, 0xfa, 0xf7, 0x74, 0xcd, 0x98, 0x88, 0x9e, 0x4c, 0xaf, 0xe0, 0x48, 0x7c, 0x57,
+	0x1c, 0xe6, 0x37, 0x00, 0x1f, 0xad, 0x19, 0x74, 0x28, 0x8f, 0x59, 0xc4, 0x29, 0xba, 0x84, 0x85,
+	0xc1, 0x1c, 0x2f, 0x9c, 0x1e, 0xff, 0xaf, 0xd3, 0x8c, 0x3e, 0xb7, 0xba, 0x92, 0x43, 0x8f, 0xe1,
+	0x66, 0x8f, 0x06, 0x7e, 0x4f, 0x48, 0x27, 0x39, 0x67, 0x5e, 0x99, 0x07, 0xf0, 0xe1, 0xbf, 0x26,
+	0x16, 0x86, 0xc1, 0xca, 0xf0, 0x73, 0x0e, 0xd5, 0xf4, 0x84, 0xd1, 0x53, 0x58, 0x6a, 0x5f, 0xb4,
+	0xec, 0x6e, 0xe7, 0xfd, 0x87, 0x96, 0xdd, 0x68, 0xbe, 0x6d, 0xda, 0xa7, 0x25, 0x45, 0xdb, 0x19,
+	0x4f, 0x8c, 0xe2, 0x5a, 0x0b, 0x1d, 0xc0, 0x1d, 0x39, 0x66, 0x9f, 0xdb, 0x8d, 0x4e, 0xdb, 0xee,
+	0xb6, 0xcf, 0x4b, 0x40, 0xdb, 0x1e, 0x4f, 0x0c, 0xb8, 0xea, 0xa0, 0x3d, 0x08, 0xe5, 0xd0, 0x59,
+	0xc7, 0x76, 0x2e, 0x4a, 0x1b, 0x5a, 0x61, 0x3c, 0x31, 0x1e, 0xc8, 0x42, 0x53, 0xbf, 0xfe, 0xd0,
+	0x95, 0x93, 0xee, 0xcd, 0x54, 0x07, 0xb7, 0x53, 0x1d, 0xfc, 0x99, 0xea, 0xe0, 0xfb, 0x4c, 0x57,
+	0x6e, 0x67, 0xba, 0xf2, 0x6b, 0xa6, 0x2b, 0x97, 0xb6, 0x1f, 0x88, 0x5e, 0xe2, 0x5a, 0x1e, 0x0b,
+	0xb1, 0x27, 0x73, 0xc4, 0x81, 0xeb, 0x55, 0x7d, 0x86, 0x87, 0x47, 0x38, 0x64, 0x57, 0x49, 0x9f,
+	0xf2, 0xf4, 0xd5, 0x71, 0x5c, 0x7b, 0x5d, 0x5d, 0xc5, 0x55, 0x5d, 0x3e, 0xb8, 0xf4, 0xa2, 0x72,
+	0x77, 0x53, 0x5e, 0x9f, 0x97, 0x7f, 0x07, 0x00, 0x02, 0x2c, 0xbc, 0xe7, 0xa5, 0x03, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -218,6 +446,11 @@ func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -277,6 +510,152 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CosmosQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -305,6 +684,9 @@ func (m *InterchainAccountPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovPacket(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -323,11 +705,74 @@ func (m *CosmosTx) Size() (n int) {
 	return n
 }
 
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CosmosQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
 }
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *CosmosQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -443,6 +888,25 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -548,6 +1012,393 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CosmosQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, QueryResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -82,3 +82,25 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestPacketDataGetBytes() {
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+		Memo: "memo",
+	}
+
+	// an unset gas limit is omitted for compatibility with hosts which do not support gas limits
+	suite.Require().NotContains(string(packetData.GetBytes()), "gas_limit")
+
+	var decoded types.InterchainAccountPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packetData.GetBytes(), &decoded))
+	suite.Require().Equal(packetData, decoded)
+
+	packetData.GasLimit = 100_000
+	suite.Require().Contains(string(packetData.GetBytes()), `"gas_limit":"100000"`)
+
+	decoded = types.InterchainAccountPacketData{}
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packetData.GetBytes(), &decoded))
+	suite.Require().Equal(packetData, decoded)
+}
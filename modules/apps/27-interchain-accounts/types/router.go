@@ -10,3 +10,9 @@ import (
 type MessageRouter interface {
 	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
 }
+
+// QueryRouter ADR 021 gRPC query routing
+// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
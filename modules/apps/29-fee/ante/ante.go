@@ -0,0 +1,38 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// HandshakeBountyDecorator records the signer of each MsgChannelOpenAck contained in a transaction on the context
+// used to execute the transaction messages. It allows the fee middleware to pay the handshake bounties of a
+// channel to the relayer opening the channel, without core IBC passing the relayer to the OnChanOpenAck callback.
+// NOTE: MsgChannelOpenAck messages nested in other messages are not recorded, their handshake bounties are refunded.
+type HandshakeBountyDecorator struct{}
+
+// NewHandshakeBountyDecorator creates and returns a new HandshakeBountyDecorator
+func NewHandshakeBountyDecorator() HandshakeBountyDecorator {
+	return HandshakeBountyDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (hbd HandshakeBountyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, m := range tx.GetMsgs() {
+		msg, ok := m.(*channeltypes.MsgChannelOpenAck)
+		if !ok {
+			continue
+		}
+
+		relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+		if err != nil {
+			return ctx, err
+		}
+
+		ctx = types.ContextWithHandshakeRelayer(ctx, msg.PortId, msg.ChannelId, relayer)
+	}
+
+	return next(ctx, tx, simulate)
+}
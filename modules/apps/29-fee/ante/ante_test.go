@@ -0,0 +1,58 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/ante"
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+// mockTx is a transaction containing the provided messages
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestHandshakeBountyDecorator(t *testing.T) {
+	relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(sender, relayer, sdk.NewCoins()),
+		channeltypes.NewMsgChannelOpenAck(ibctesting.MockFeePort, ibctesting.FirstChannelID, ibctesting.FirstChannelID, ibctesting.DefaultChannelVersion, []byte("proof"), clienttypes.NewHeight(0, 1), relayer.String()),
+	}
+
+	var nextCtx sdk.Context
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCtx = ctx
+		return ctx, nil
+	}
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+	_, err := ante.NewHandshakeBountyDecorator().AnteHandle(ctx, mockTx{msgs: msgs}, false, next)
+	require.NoError(t, err)
+
+	// the signer of MsgChannelOpenAck is recorded for its channel
+	recorded, found := types.HandshakeRelayerFromContext(nextCtx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.True(t, found)
+	require.Equal(t, relayer, recorded)
+
+	_, found = types.HandshakeRelayerFromContext(nextCtx, ibctesting.MockFeePort, "channel-1")
+	require.False(t, found)
+
+	// an invalid signer is rejected
+	msgs[1] = channeltypes.NewMsgChannelOpenAck(ibctesting.MockFeePort, ibctesting.FirstChannelID, ibctesting.FirstChannelID, ibctesting.DefaultChannelVersion, []byte("proof"), clienttypes.NewHeight(0, 1), "invalid-address")
+	_, err = ante.NewHandshakeBountyDecorator().AnteHandle(ctx, mockTx{msgs: msgs}, false, next)
+	require.Error(t, err)
+}
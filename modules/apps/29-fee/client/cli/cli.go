@@ -26,6 +26,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdMinRelayerFee(),
+		GetCmdMinRelayerFees(),
+		GetCmdRelayerStats(),
+		GetCmdAllRelayerStats(),
+		GetCmdExpiringPacketFees(),
+		GetCmdHandshakeBounty(),
+		GetCmdHandshakeBounties(),
 	)
 
 	return queryCmd
@@ -45,6 +52,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewPayHandshakeBountyTxCmd(),
 	)
 
 	return txCmd
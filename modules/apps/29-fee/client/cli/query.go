@@ -436,3 +436,289 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMinRelayerFees returns the command handler for the Query/MinRelayerFees rpc.
+func GetCmdMinRelayerFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "min-relayer-fees",
+		Short:   "Query the minimum relayer fees required on ibc-fee enabled channels",
+		Long:    "Query the minimum relayer fees required on ibc-fee enabled channels",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee min-relayer-fees", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMinRelayerFeesRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinRelayerFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "min-relayer-fees")
+
+	return cmd
+}
+
+// GetCmdMinRelayerFee returns the command handler for the Query/MinRelayerFee rpc.
+func GetCmdMinRelayerFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "min-relayer-fee [port-id] [channel-id]",
+		Short:   "Query the minimum relayer fee required for packets sent on a channel",
+		Long:    "Query the minimum recv, ack and timeout fees which must be escrowed for each packet sent on an ibc-fee enabled channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee min-relayer-fee transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMinRelayerFeeRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinRelayerFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllRelayerStats returns the command handler for the Query/AllRelayerStats rpc.
+func GetCmdAllRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "all-relayer-stats",
+		Short:   "Query the accumulated earnings of all relayers",
+		Long:    "Query the total recv, ack and timeout fees earned and the number of incentivized packets relayed by all relayers on each channel",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee all-relayer-stats", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllRelayerStatsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllRelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-relayer-stats")
+
+	return cmd
+}
+
+// GetCmdRelayerStats returns the command handler for the Query/RelayerStats rpc.
+func GetCmdRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-stats [relayer]",
+		Short:   "Query the accumulated earnings of a relayer",
+		Long:    "Query the total recv, ack and timeout fees earned and the number of incentivized packets relayed by a relayer on each channel",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-stats cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayerStatsRequest{
+				Relayer:    args[0],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayer-stats")
+
+	return cmd
+}
+
+// GetCmdExpiringPacketFees returns the command handler for the Query/ExpiringPacketFees rpc.
+func GetCmdExpiringPacketFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-packet-fees",
+		Short: "Query the packet fees which are about to expire",
+		Long: "Query the packet fees which expire at or before the provided block height or block time (in unix nanoseconds). " +
+			"Exactly one of --expiry-height or --expiry-timestamp must be provided",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee expiring-packet-fees --expiry-height 1000", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			expiryTimestamp, err := cmd.Flags().GetUint64(flagExpiryTimestamp)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryExpiringPacketFeesRequest{
+				Pagination:      pageReq,
+				ExpiryHeight:    expiryHeight,
+				ExpiryTimestamp: expiryTimestamp,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExpiringPacketFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Return the packet fees expiring at or before the provided block height")
+	cmd.Flags().Uint64(flagExpiryTimestamp, 0, "Return the packet fees expiring at or before the provided block time (in unix nanoseconds)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-packet-fees")
+
+	return cmd
+}
+
+// GetCmdHandshakeBounty returns the command handler for the Query/HandshakeBounty rpc.
+func GetCmdHandshakeBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "handshake-bounty [port-id] [channel-id]",
+		Short:   "Query the handshake bounties escrowed for a channel",
+		Long:    "Query the bounties escrowed to incentivize the opening handshake of a channel in the INIT state",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee handshake-bounty transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHandshakeBountyRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HandshakeBounty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdHandshakeBounties returns the command handler for the Query/HandshakeBounties rpc.
+func GetCmdHandshakeBounties() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "handshake-bounties",
+		Short:   "Query all handshake bounties",
+		Long:    "Query the bounties escrowed to incentivize the opening handshake of all channels in the INIT state",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee handshake-bounties", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHandshakeBountiesRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HandshakeBounties(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "handshake-bounties")
+
+	return cmd
+}
@@ -20,16 +20,22 @@ const (
 	flagRecvFee    = "recv-fee"
 	flagAckFee     = "ack-fee"
 	flagTimeoutFee = "timeout-fee"
+
+	flagExpiryHeight    = "expiry-height"
+	flagExpiryTimestamp = "expiry-timestamp"
 )
 
 // NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
 func NewRegisterPayeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "register-payee [port-id] [channel-id] [relayer] [payee] ",
-		Short:   "Register a payee on a given channel.",
-		Long:    strings.TrimSpace(`Register a payee address on a given channel.`),
-		Example: fmt.Sprintf("%s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5", version.AppName),
-		Args:    cobra.ExactArgs(4),
+		Use:   "register-payee [port-id] [channel-id] [relayer] [payee] ",
+		Short: "Register a payee on a given channel.",
+		Long: strings.TrimSpace(`Register a payee address on a given channel.
+Fees may be split between several payees by providing a comma separated list of payee:weight pairs,
+where each weight is expressed in basis points and all weights must sum to 10000.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5\n", version.AppName) +
+			fmt.Sprintf("%s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5:7000,cosmos1j2tqtv4l3rsfwuhdc2ef5udvmwdyg0u3jrj3yn:3000", version.AppName),
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -37,6 +43,14 @@ func NewRegisterPayeeCmd() *cobra.Command {
 			}
 
 			msg := types.NewMsgRegisterPayee(args[0], args[1], args[2], args[3])
+			if strings.Contains(args[3], ":") {
+				payees, err := parseWeightedPayees(args[3])
+				if err != nil {
+					return err
+				}
+
+				msg = types.NewMsgRegisterWeightedPayees(args[0], args[1], args[2], payees)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -47,6 +61,26 @@ func NewRegisterPayeeCmd() *cobra.Command {
 	return cmd
 }
 
+// parseWeightedPayees parses a comma separated list of payee:weight pairs into a list of weighted payees
+func parseWeightedPayees(arg string) ([]types.WeightedPayee, error) {
+	var payees []types.WeightedPayee
+	for _, pair := range strings.Split(arg, ",") {
+		split := strings.Split(strings.TrimSpace(pair), ":")
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid weighted payee %s, expected format payee:weight", pair)
+		}
+
+		weight, err := strconv.ParseUint(split[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for payee %s: %w", split[0], err)
+		}
+
+		payees = append(payees, types.NewWeightedPayee(split[0], weight))
+	}
+
+	return payees, nil
+}
+
 // NewRegisterCounterpartyPayeeCmd returns the command to create a MsgRegisterCounterpartyPayee
 func NewRegisterCounterpartyPayeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -78,7 +112,7 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 		Use:     "pay-packet-fee [src-port] [src-channel] [sequence]",
 		Short:   "Pay a fee to incentivize an existing IBC packet",
 		Long:    strings.TrimSpace(`Pay a fee to incentivize an existing IBC packet.`),
-		Example: fmt.Sprintf("%s tx ibc-fee pay-packet-fee transfer channel-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-packet-fee transfer channel-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake --expiry-height 1000", version.AppName),
 		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -134,6 +168,17 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 			}
 
 			packetFee := types.NewPacketFee(fee, sender, relayers)
+
+			packetFee.ExpiryHeight, err = cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			packetFee.ExpiryTimestamp, err = cmd.Flags().GetUint64(flagExpiryTimestamp)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -143,6 +188,53 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
 	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
 	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Block height at which the fee expires and is refunded, 0 disables expiry by height.")
+	cmd.Flags().Uint64(flagExpiryTimestamp, 0, "Block time (in unix nanoseconds) at which the fee expires and is refunded, 0 disables expiry by time.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayHandshakeBountyTxCmd returns the command to create a MsgPayHandshakeBounty
+func NewPayHandshakeBountyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-handshake-bounty [port-id] [channel-id] [bounty]",
+		Short: "Pay a bounty to incentivize the opening handshake of a channel",
+		Long: strings.TrimSpace(`Pay a bounty to incentivize relayers to complete the opening handshake of a channel in the INIT state.
+The bounty is paid out to the relayer which submits the channel open acknowledgement, or refunded to the sender
+once it expires. At least one of --expiry-height or --expiry-timestamp must be provided.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-handshake-bounty transfer channel-0 100stake --expiry-height 1000", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bounty, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			expiryTimestamp, err := cmd.Flags().GetUint64(flagExpiryTimestamp)
+			if err != nil {
+				return err
+			}
+
+			handshakeBounty := types.NewHandshakeBounty(bounty, clientCtx.GetFromAddress().String(), expiryHeight, expiryTimestamp)
+			msg := types.NewMsgPayHandshakeBounty(args[0], args[1], handshakeBounty)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Block height at which the bounty expires and is refunded, 0 disables expiry by height.")
+	cmd.Flags().Uint64(flagExpiryTimestamp, 0, "Block time (in unix nanoseconds) at which the bounty expires and is refunded, 0 disables expiry by time.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -131,6 +131,8 @@ func (im IBCMiddleware) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
+	appVersion := counterpartyVersion
+
 	// If handshake was initialized with fee enabled it must complete with fee enabled.
 	// If handshake was initialized with fee disabled it must complete with fee disabled.
 	if im.keeper.IsFeeEnabled(ctx, portID, channelID) {
@@ -143,12 +145,38 @@ func (im IBCMiddleware) OnChanOpenAck(
 			return sdkerrors.Wrapf(types.ErrInvalidVersion, "expected counterparty fee version: %s, got: %s", types.Version, versionMetadata.FeeVersion)
 		}
 
-		// call underlying app's OnChanOpenAck callback with the counterparty app version.
-		return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, versionMetadata.AppVersion)
+		appVersion = versionMetadata.AppVersion
 	}
 
 	// call underlying app's OnChanOpenAck callback with the counterparty app version.
-	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	if err := im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, appVersion); err != nil {
+		return err
+	}
+
+	// handshake bounties are paid out regardless of whether the channel is fee enabled
+	if im.keeper.IsLocked(ctx) {
+		return nil
+	}
+
+	// the relayer is recorded on the context by the HandshakeBountyDecorator
+	relayer, found := types.HandshakeRelayerFromContext(ctx, portID, channelID)
+	if !found {
+		// the handshake bounties can no longer be claimed once the channel is open, refund them
+		im.keeper.RefundHandshakeBounties(ctx, portID, channelID)
+		return nil
+	}
+
+	payees, err := im.keeper.GetFeeRecipients(ctx, relayer, channelID)
+	if err != nil {
+		// failing to pay the handshake bounties must not prevent the channel from opening, refund them
+		im.keeper.Logger(ctx).Error("failed to retrieve fee recipients, refunding handshake bounties", "relayer", relayer.String(), "port ID", portID, "channel ID", channelID, "error", err.Error())
+		im.keeper.RefundHandshakeBounties(ctx, portID, channelID)
+		return nil
+	}
+
+	im.keeper.DistributeHandshakeBounties(ctx, portID, channelID, relayer, payees)
+
+	return nil
 }
 
 // OnChanOpenConfirm implements the IBCMiddleware interface
@@ -272,20 +300,12 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
 	}
 
-	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
-	if !found {
-		im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, relayer, feesInEscrow.PacketFees, packetID)
-
-		// call underlying callback
-		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
-	}
-
-	payeeAddr, err := sdk.AccAddressFromBech32(payee)
+	payees, err := im.keeper.GetFeeRecipients(ctx, relayer, packet.SourceChannel)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+		return err
 	}
 
-	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, payeeAddr, feesInEscrow.PacketFees, packetID)
+	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, relayer, payees, feesInEscrow.PacketFees, packetID)
 
 	// call underlying callback
 	return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
@@ -314,20 +334,12 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return im.app.OnTimeoutPacket(ctx, packet, relayer)
 	}
 
-	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
-	if !found {
-		im.keeper.DistributePacketFeesOnTimeout(ctx, relayer, feesInEscrow.PacketFees, packetID)
-
-		// call underlying callback
-		return im.app.OnTimeoutPacket(ctx, packet, relayer)
-	}
-
-	payeeAddr, err := sdk.AccAddressFromBech32(payee)
+	payees, err := im.keeper.GetFeeRecipients(ctx, relayer, packet.SourceChannel)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+		return err
 	}
 
-	im.keeper.DistributePacketFeesOnTimeout(ctx, payeeAddr, feesInEscrow.PacketFees, packetID)
+	im.keeper.DistributePacketFeesOnTimeout(ctx, relayer, payees, feesInEscrow.PacketFees, packetID)
 
 	// call underlying callback
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
@@ -305,6 +305,143 @@ func (suite *FeeTestSuite) TestOnChanOpenAck() {
 	}
 }
 
+func (suite *FeeTestSuite) TestOnChanOpenAckHandshakeBounty() {
+	var (
+		ctx             sdk.Context
+		relayer         sdk.AccAddress
+		payee           sdk.AccAddress
+		refundAcc       sdk.AccAddress
+		expPayeeBalance sdk.Coins
+		expRefund       sdk.Coins
+		expBountiesLeft bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: bounty is paid to the relayer",
+			func() {
+				ctx = types.ContextWithHandshakeRelayer(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, relayer)
+				payee = relayer
+
+				expPayeeBalance = defaultRecvFee
+			},
+		},
+		{
+			"success: bounty is paid to the registered payee of the relayer",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(ctx, relayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+				ctx = types.ContextWithHandshakeRelayer(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, relayer)
+
+				expPayeeBalance = defaultRecvFee
+			},
+		},
+		{
+			"relayer not found in context, bounty is refunded",
+			func() {
+				expRefund = defaultRecvFee
+			},
+		},
+		{
+			"invalid registered payee address, bounty is refunded",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(ctx, relayer.String(), "invalid-address", suite.path.EndpointA.ChannelID)
+				ctx = types.ContextWithHandshakeRelayer(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, relayer)
+
+				expRefund = defaultRecvFee
+			},
+		},
+		{
+			"fee module is locked, bounty remains in escrow",
+			func() {
+				ctx = types.ContextWithHandshakeRelayer(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, relayer)
+				ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)).Set(types.KeyLocked(), []byte{1})
+
+				expBountiesLeft = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			err := suite.path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			ctx = suite.chainA.GetContext()
+			relayer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			payee = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			expPayeeBalance = sdk.NewCoins()
+			expRefund = sdk.NewCoins()
+			expBountiesLeft = false
+
+			handshakeBounty := types.NewHandshakeBounty(defaultRecvFee, refundAcc.String(), uint64(ctx.BlockHeight())+100, 0)
+			msg := types.NewMsgPayHandshakeBounty(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, handshakeBounty)
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(ctx), msg)
+			suite.Require().NoError(err)
+
+			err = suite.path.EndpointB.ChanOpenTry()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			payeeBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, payee)
+			refundBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+
+			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(ctx, ibctesting.MockFeePort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			err = cbs.OnChanOpenAck(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointA.Counterparty.ChannelID, suite.path.EndpointB.ChannelConfig.Version)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(payeeBalance.Add(expPayeeBalance...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, payee))
+			suite.Require().Equal(refundBalance.Add(expRefund...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().Equal(expBountiesLeft, found)
+		})
+	}
+}
+
+// TestHandshakeBountyChanOpenAck asserts the handshake bounty is paid to the relayer which submits MsgChannelOpenAck
+func (suite *FeeTestSuite) TestHandshakeBountyChanOpenAck() {
+	suite.coordinator.SetupConnections(suite.path)
+
+	err := suite.path.EndpointA.ChanOpenInit()
+	suite.Require().NoError(err)
+
+	// the testing endpoint submits handshake messages using the sender account of the chain
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	handshakeBounty := types.NewHandshakeBounty(defaultRecvFee, refundAcc.String(), uint64(suite.chainA.GetContext().BlockHeight())+100, 0)
+	msg := types.NewMsgPayHandshakeBounty(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, handshakeBounty)
+	_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointB.ChanOpenTry()
+	suite.Require().NoError(err)
+
+	relayerBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayer)
+
+	err = suite.path.EndpointA.ChanOpenAck()
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(relayerBalance.Add(defaultRecvFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayer))
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().False(found)
+}
+
 func (suite *FeeTestSuite) TestOnChanCloseInit() {
 	var (
 		refundAcc sdk.AccAddress
@@ -600,6 +737,7 @@ func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
 		relayerAddr         sdk.AccAddress
 		expRefundAccBalance sdk.Coins
 		expPayeeAccBalance  sdk.Coins
+		expPayeesBalances   []sdk.Coins
 	)
 
 	testCases := []struct {
@@ -668,6 +806,48 @@ func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
 				suite.Require().Equal(expRefundAccBalance, sdk.NewCoins(refundAccBalance))
 			},
 		},
+		{
+			"success: with registered weighted payees",
+			func() {
+				firstPayee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+				secondPayee := suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetWeightedPayees(
+					suite.chainA.GetContext(),
+					suite.chainA.SenderAccount.GetAddress().String(),
+					suite.path.EndpointA.ChannelID,
+					[]types.WeightedPayee{
+						types.NewWeightedPayee(firstPayee.String(), 7500),
+						types.NewWeightedPayee(secondPayee.String(), 2500),
+					},
+				)
+
+				// the ack fee of 200 is split 150/50 between the payees
+				firstPayeeBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), firstPayee, sdk.DefaultBondDenom))
+				secondPayeeBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), secondPayee, sdk.DefaultBondDenom))
+				expPayeesBalances = []sdk.Coins{
+					firstPayeeBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150))),
+					secondPayeeBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))),
+				}
+
+				// retrieve the refund acc balance and add the expected timeout fees
+				refundAccBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom))
+				expRefundAccBalance = refundAccBalance.Add(packetFee.Fee.TimeoutFee...)
+			},
+			true,
+			func() {
+				// assert that the packet fees have been distributed
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().False(found)
+
+				firstPayeeBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccounts[2].SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				secondPayeeBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccounts[3].SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(expPayeesBalances[0], sdk.NewCoins(firstPayeeBalance))
+				suite.Require().Equal(expPayeesBalances[1], sdk.NewCoins(secondPayeeBalance))
+
+				refundAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(expRefundAccBalance, sdk.NewCoins(refundAccBalance))
+			},
+		},
 		{
 			"success: no op without a packet fee",
 			func() {
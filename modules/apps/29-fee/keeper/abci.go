@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+)
+
+// BeginBlocker refunds the packet fees and handshake bounties which have expired at the current block height or block time.
+// At most types.MaxExpiredPacketFeesPerBlock packet fee and types.MaxExpiredHandshakeBountiesPerBlock handshake bounty
+// expiry index entries are processed per block, any remaining expired fees and bounties are refunded in subsequent blocks.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	// fee logic is skipped while the fee module is locked
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	height, timestamp := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UnixNano())
+
+	limit := types.MaxExpiredPacketFeesPerBlock
+	limit -= k.RefundExpiredPacketFees(ctx, types.ExpiryHeightKeyPrefix, height, limit)
+
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	if limit > 0 {
+		k.RefundExpiredPacketFees(ctx, types.ExpiryTimestampKeyPrefix, timestamp, limit)
+	}
+
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	limit = types.MaxExpiredHandshakeBountiesPerBlock
+	limit -= k.RefundExpiredHandshakeBounties(ctx, types.BountyExpiryHeightKeyPrefix, height, limit)
+
+	if k.IsLocked(ctx) || limit <= 0 {
+		return
+	}
+
+	k.RefundExpiredHandshakeBounties(ctx, types.BountyExpiryTimestampKeyPrefix, timestamp, limit)
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	var (
+		ctx             sdk.Context
+		packetFees      []types.PacketFee
+		expRefund       sdk.Coins
+		expFeesInEscrow []types.PacketFee
+		expLocked       bool
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: all packet fees expired by height",
+			func() {
+				for i := range packetFees {
+					packetFees[i].ExpiryHeight = uint64(ctx.BlockHeight())
+				}
+
+				expRefund = fee.Total().Add(fee.Total()...).Add(fee.Total()...)
+				expFeesInEscrow = nil
+			},
+		},
+		{
+			"success: packet fee expired by timestamp",
+			func() {
+				packetFees[0].ExpiryTimestamp = uint64(ctx.BlockTime().UnixNano())
+
+				expRefund = fee.Total()
+				expFeesInEscrow = []types.PacketFee{packetFees[1], packetFees[2]}
+			},
+		},
+		{
+			"success: only expired packet fees are refunded",
+			func() {
+				packetFees[0].ExpiryHeight = uint64(ctx.BlockHeight()) - 1
+				packetFees[1].ExpiryHeight = uint64(ctx.BlockHeight()) + 1
+				packetFees[1].ExpiryTimestamp = uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+
+				expRefund = fee.Total()
+				expFeesInEscrow = []types.PacketFee{packetFees[1], packetFees[2]}
+			},
+		},
+		{
+			"success: packet fees share an expiry, only the expired packet fee is refunded",
+			func() {
+				packetFees[0].ExpiryHeight = uint64(ctx.BlockHeight()) + 1
+				packetFees[0].ExpiryTimestamp = uint64(ctx.BlockTime().UnixNano())
+				packetFees[1].ExpiryHeight = uint64(ctx.BlockHeight()) + 1
+
+				expRefund = fee.Total()
+				expFeesInEscrow = []types.PacketFee{packetFees[1], packetFees[2]}
+			},
+		},
+		{
+			"success: packet fees have not yet expired",
+			func() {
+				packetFees[0].ExpiryHeight = uint64(ctx.BlockHeight()) + 1
+				packetFees[1].ExpiryTimestamp = uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+
+				expRefund = sdk.NewCoins()
+				expFeesInEscrow = packetFees
+			},
+		},
+		{
+			"fee module is locked",
+			func() {
+				packetFees[0].ExpiryHeight = uint64(ctx.BlockHeight())
+
+				lockFeeModule(suite.chainA)
+
+				expRefund = sdk.NewCoins()
+				expFeesInEscrow = packetFees
+				expLocked = true
+			},
+		},
+		{
+			"escrow account has insufficient balance, fee module becomes locked",
+			func() {
+				packetFees[0].ExpiryHeight = uint64(ctx.BlockHeight())
+
+				// remove the escrowed funds from the fee module account
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, suite.chainB.SenderAccount.GetAddress(), fee.Total().Add(fee.Total()...).Add(fee.Total()...))
+				suite.Require().NoError(err)
+
+				expRefund = sdk.NewCoins()
+				expFeesInEscrow = packetFees
+				expLocked = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			ctx = suite.chainA.GetContext()
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			refundAcc := suite.chainA.SenderAccount.GetAddress()
+
+			packetFees = []types.PacketFee{
+				types.NewPacketFee(fee, refundAcc.String(), nil),
+				types.NewPacketFee(fee, refundAcc.String(), nil),
+				types.NewPacketFee(fee, refundAcc.String(), nil),
+			}
+			expLocked = false
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total().Add(fee.Total()...).Add(fee.Total()...))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+
+			refundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+
+			feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+			if expFeesInEscrow == nil {
+				suite.Require().False(found)
+			} else {
+				suite.Require().True(found)
+				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
+			}
+
+			newRefundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom)
+			suite.Require().Equal(refundBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, expRefund.AmountOf(sdk.DefaultBondDenom))), newRefundBalance)
+
+			suite.Require().Equal(expLocked, suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
+
+			// expiry index entries exist only for the expiries of packet fees remaining in escrow
+			store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+			for _, packetFee := range packetFees {
+				if packetFee.ExpiryHeight != 0 {
+					indexed := hasPacketFeeWithExpiry(expFeesInEscrow, func(p types.PacketFee) bool { return p.ExpiryHeight == packetFee.ExpiryHeight })
+					suite.Require().Equal(indexed, store.Has(types.KeyExpiryHeight(packetFee.ExpiryHeight, packetID)))
+				}
+
+				if packetFee.ExpiryTimestamp != 0 {
+					indexed := hasPacketFeeWithExpiry(expFeesInEscrow, func(p types.PacketFee) bool { return p.ExpiryTimestamp == packetFee.ExpiryTimestamp })
+					suite.Require().Equal(indexed, store.Has(types.KeyExpiryTimestamp(packetFee.ExpiryTimestamp, packetID)))
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerSharedExpiry() {
+	suite.coordinator.Setup(suite.path)
+
+	ctx := suite.chainA.GetContext()
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	// both packet fees share the expiry height, the first packet fee expires earlier by timestamp
+	expiryHeight := uint64(ctx.BlockHeight()) + 1
+	packetFeeA := types.NewPacketFee(fee, refundAcc.String(), nil)
+	packetFeeA.ExpiryHeight = expiryHeight
+	packetFeeA.ExpiryTimestamp = uint64(ctx.BlockTime().UnixNano())
+
+	packetFeeB := types.NewPacketFee(fee, refundAcc.String(), nil)
+	packetFeeB.ExpiryHeight = expiryHeight
+
+	err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total().Add(fee.Total()...))
+	suite.Require().NoError(err)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees([]types.PacketFee{packetFeeA, packetFeeB}))
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+
+	// only the first packet fee is refunded, the shared expiry height index entry remains for the second packet fee
+	feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.PacketFee{packetFeeB}, feesInEscrow.PacketFees)
+
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	suite.Require().True(store.Has(types.KeyExpiryHeight(expiryHeight, packetID)))
+	suite.Require().False(store.Has(types.KeyExpiryTimestamp(packetFeeA.ExpiryTimestamp, packetID)))
+
+	// the second packet fee is refunded once the shared expiry height is reached
+	ctx = ctx.WithBlockHeight(int64(expiryHeight))
+	suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	suite.Require().False(found)
+	suite.Require().False(store.Has(types.KeyExpiryHeight(expiryHeight, packetID)))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerMaxExpiredPacketFeesPerBlock() {
+	suite.coordinator.Setup(suite.path)
+
+	ctx := suite.chainA.GetContext()
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	packetFee := types.NewPacketFee(fee, refundAcc.String(), nil)
+	packetFee.ExpiryHeight = uint64(ctx.BlockHeight())
+
+	numPackets := types.MaxExpiredPacketFeesPerBlock + 1
+	for i := 1; i <= numPackets; i++ {
+		packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(i))
+
+		err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total())
+		suite.Require().NoError(err)
+
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+	}
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+
+	// a single expired packet fee remains in escrow
+	suite.Require().Len(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllIdentifiedPacketFees(ctx), 1)
+
+	events := ctx.EventManager().Events()
+	var refundEvents int
+	for _, event := range events {
+		if event.Type == types.EventTypeRefundExpiredPacketFee {
+			refundEvents++
+		}
+	}
+	suite.Require().Equal(types.MaxExpiredPacketFeesPerBlock, refundEvents)
+
+	// the remaining expired packet fee is refunded in the next block
+	suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+	suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllIdentifiedPacketFees(ctx))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerHandshakeBounties() {
+	suite.coordinator.SetupConnections(suite.path)
+
+	err := suite.path.EndpointA.ChanOpenInit()
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	portID, channelID := suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID
+
+	expiredBounty := types.NewHandshakeBounty(defaultRecvFee, refundAcc.String(), 0, uint64(ctx.BlockTime().Add(time.Hour).UnixNano()))
+	activeBounty := types.NewHandshakeBounty(defaultAckFee, refundAcc.String(), uint64(ctx.BlockHeight())+100, 0)
+
+	for _, bounty := range []types.HandshakeBounty{expiredBounty, activeBounty} {
+		msg := types.NewMsgPayHandshakeBounty(portID, channelID, bounty)
+		_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	refundBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+
+	// advance the block time beyond the expiry timestamp of the first bounty
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+
+	suite.Require().Equal(refundBalance.Add(defaultRecvFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+
+	handshakeBounties, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.HandshakeBounty{activeBounty}, handshakeBounties.HandshakeBounties)
+
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	suite.Require().False(store.Has(types.KeyBountyExpiryTimestamp(expiredBounty.ExpiryTimestamp, portID, channelID)))
+	suite.Require().True(store.Has(types.KeyBountyExpiryHeight(activeBounty.ExpiryHeight, portID, channelID)))
+
+	// advance the block height to the expiry height of the remaining bounty
+	ctx = ctx.WithBlockHeight(int64(activeBounty.ExpiryHeight))
+	suite.chainA.GetSimApp().IBCFeeKeeper.BeginBlocker(ctx)
+
+	suite.Require().Equal(refundBalance.Add(defaultRecvFee...).Add(defaultAckFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(ctx, portID, channelID)
+	suite.Require().False(found)
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
+}
+
+// hasPacketFeeWithExpiry returns true if any of the provided packet fees matches the given expiry
+func hasPacketFeeWithExpiry(packetFees []types.PacketFee, matchExpiry func(types.PacketFee) bool) bool {
+	for _, packetFee := range packetFees {
+		if matchExpiry(packetFee) {
+			return true
+		}
+	}
+
+	return false
+}
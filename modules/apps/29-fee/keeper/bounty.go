@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+)
+
+// escrowHandshakeBounty sends the handshake bounty to the module account to hold in escrow until the channel
+// opening handshake is completed or the bounty expires
+func (k Keeper) escrowHandshakeBounty(ctx sdk.Context, portID, channelID string, handshakeBounty types.HandshakeBounty) error {
+	// check if the refund address is valid
+	refundAddr, err := sdk.AccAddressFromBech32(handshakeBounty.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	refundAcc := k.authKeeper.GetAccount(ctx, refundAddr)
+	if refundAcc == nil {
+		return sdkerrors.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", handshakeBounty.RefundAddress)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, handshakeBounty.Bounty); err != nil {
+		return err
+	}
+
+	// multiple bounties may be escrowed for a single channel, append the new bounty to any previously escrowed bounties
+	bounties := []types.HandshakeBounty{handshakeBounty}
+	if handshakeBounties, found := k.GetHandshakeBounties(ctx, portID, channelID); found {
+		bounties = append(bounties, handshakeBounties.HandshakeBounties...)
+	}
+
+	handshakeBounties := types.NewHandshakeBounties(bounties)
+	k.SetHandshakeBounties(ctx, portID, channelID, handshakeBounties)
+
+	EmitIncentivizedHandshakeEvent(ctx, portID, channelID, handshakeBounties)
+
+	return nil
+}
+
+// DistributeHandshakeBounties pays out all handshake bounties escrowed for the given port and channel identifiers
+// to the provided payees of the relayer which completed the channel opening handshake. If the escrow account runs
+// out of balance then the fee module will become locked and the bounties remain in escrow.
+func (k Keeper) DistributeHandshakeBounties(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress, payees []types.WeightedPayee) {
+	handshakeBounties, found := k.GetHandshakeBounties(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	if !k.EscrowAccountHasBalance(ctx, handshakeBounties.Total()) {
+		// if the escrow account does not have sufficient funds then there must exist a severe bug
+		// the fee module should be locked until manual intervention fixes the issue
+		k.lockFeeModule(ctx)
+		return
+	}
+
+	// cache context before trying to distribute bounties
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, handshakeBounty := range handshakeBounties.HandshakeBounties {
+		// refund address is validated when the bounty is escrowed
+		refundAddr, _ := sdk.AccAddressFromBech32(handshakeBounty.RefundAddress)
+		k.distributeWeightedFee(cacheCtx, payees, refundAddr, handshakeBounty.Bounty)
+	}
+
+	k.DeleteHandshakeBounties(cacheCtx, portID, channelID)
+
+	EmitDistributeHandshakeBountyEvent(cacheCtx, portID, channelID, relayer, handshakeBounties.Total())
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// write the cache
+	writeFn()
+}
+
+// RefundHandshakeBounties refunds all handshake bounties escrowed for the given port and channel identifiers to their
+// refund addresses. If the escrow account runs out of balance then the fee module will become locked and the bounties
+// remain in escrow.
+func (k Keeper) RefundHandshakeBounties(ctx sdk.Context, portID, channelID string) {
+	handshakeBounties, found := k.GetHandshakeBounties(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	k.refundHandshakeBounties(ctx, portID, channelID, handshakeBounties, 0, 0)
+}
+
+// RefundExpiredHandshakeBounties iterates the handshake bounty expiry index under the provided key prefix in ascending
+// order of expiry and refunds every handshake bounty which has expired at the current block height or block time.
+// At most limit index entries with an expiry at or before the provided expiry are processed, the number of processed
+// entries is returned. If the escrow account runs out of balance then the fee module will become locked and processing stops.
+func (k Keeper) RefundExpiredHandshakeBounties(ctx sdk.Context, keyPrefix string, expiry uint64, limit int) int {
+	type channel struct{ portID, channelID string }
+
+	var (
+		keys     [][]byte
+		channels []channel
+	)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(keyPrefix+"/"))
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keyExpiry, portID, channelID, err := types.ParseKeyBountyExpiry(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		// the index is ordered by expiry, all remaining entries have not yet expired
+		if keyExpiry > expiry {
+			break
+		}
+
+		keys = append(keys, append([]byte(nil), iterator.Key()...))
+		channels = append(channels, channel{portID, channelID})
+	}
+
+	// the iterator must be closed before writing to the store
+	iterator.Close()
+
+	height, timestamp := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UnixNano())
+	for i, ch := range channels {
+		if handshakeBounties, found := k.GetHandshakeBounties(ctx, ch.portID, ch.channelID); found {
+			if !k.refundHandshakeBounties(ctx, ch.portID, ch.channelID, handshakeBounties, height, timestamp) {
+				break
+			}
+		}
+
+		// ensure the processed index entry is removed even if no matching handshake bounty remains in escrow
+		store.Delete(keys[i])
+	}
+
+	return len(keys)
+}
+
+// refundHandshakeBounties refunds the handshake bounties which have expired at the provided block height or block time
+// to their refund addresses, the remaining handshake bounties stay in escrow. If both height and timestamp are zero all
+// handshake bounties are refunded. False is returned if the fee module has been locked.
+func (k Keeper) refundHandshakeBounties(ctx sdk.Context, portID, channelID string, handshakeBounties types.HandshakeBounties, height, timestamp uint64) bool {
+	refundAll := height == 0 && timestamp == 0
+
+	// cache context before trying to refund bounties
+	// if the escrow account has insufficient balance then we want to avoid partially refunding bounties
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var remainingBounties []types.HandshakeBounty
+	for _, handshakeBounty := range handshakeBounties.HandshakeBounties {
+		if !refundAll && !handshakeBounty.IsExpired(height, timestamp) {
+			remainingBounties = append(remainingBounties, handshakeBounty)
+			continue
+		}
+
+		if !k.EscrowAccountHasBalance(cacheCtx, handshakeBounty.Bounty) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			// the fee module should be locked until manual intervention fixes the issue
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx)
+			return false
+		}
+
+		// refund address is validated when the bounty is escrowed
+		refundAddr, _ := sdk.AccAddressFromBech32(handshakeBounty.RefundAddress)
+		k.distributeFee(cacheCtx, refundAddr, refundAddr, handshakeBounty.Bounty)
+
+		EmitRefundHandshakeBountyEvent(cacheCtx, portID, channelID, handshakeBounty)
+	}
+
+	k.DeleteHandshakeBounties(cacheCtx, portID, channelID)
+	if len(remainingBounties) != 0 {
+		k.SetHandshakeBounties(cacheCtx, portID, channelID, types.NewHandshakeBounties(remainingBounties))
+	}
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// write the cache
+	writeFn()
+
+	return true
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributeHandshakeBounties() {
+	var (
+		relayerA        sdk.AccAddress
+		relayerB        sdk.AccAddress
+		payees          []types.WeightedPayee
+		bounties        []types.HandshakeBounty
+		expBalanceA     sdk.Coins
+		expBalanceB     sdk.Coins
+		expBountiesLeft bool
+		expLocked       bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: bounties are paid to the relayer",
+			func() {
+				payees = []types.WeightedPayee{types.NewWeightedPayee(relayerA.String(), types.TotalPayeeWeight)}
+
+				expBalanceA = defaultRecvFee.Add(defaultAckFee...)
+				expBalanceB = sdk.NewCoins()
+			},
+		},
+		{
+			"success: bounties are split between weighted payees",
+			func() {
+				payees = []types.WeightedPayee{
+					types.NewWeightedPayee(relayerA.String(), types.TotalPayeeWeight/2),
+					types.NewWeightedPayee(relayerB.String(), types.TotalPayeeWeight/2),
+				}
+
+				expBalanceA = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)))
+				expBalanceB = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)))
+			},
+		},
+		{
+			"no bounties escrowed for the channel",
+			func() {
+				bounties = nil
+
+				expBalanceA = sdk.NewCoins()
+				expBalanceB = sdk.NewCoins()
+			},
+		},
+		{
+			"escrow account has insufficient balance, fee module becomes locked",
+			func() {
+				// remove the escrowed funds from the fee module account
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), defaultAckFee)
+				suite.Require().NoError(err)
+
+				expBalanceA = sdk.NewCoins()
+				expBalanceB = sdk.NewCoins()
+				expBountiesLeft = true
+				expLocked = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			err := suite.path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			relayerA = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			relayerB = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+			payees = []types.WeightedPayee{types.NewWeightedPayee(relayerA.String(), types.TotalPayeeWeight)}
+			expBountiesLeft = false
+			expLocked = false
+
+			expiryHeight := uint64(suite.chainA.GetContext().BlockHeight()) + 100
+			refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+			bounties = []types.HandshakeBounty{
+				types.NewHandshakeBounty(defaultRecvFee, refundAddr, expiryHeight, 0),
+				types.NewHandshakeBounty(defaultAckFee, refundAddr, expiryHeight, 0),
+			}
+
+			for _, bounty := range bounties {
+				msg := types.NewMsgPayHandshakeBounty(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, bounty)
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+			}
+
+			tc.malleate()
+
+			if len(bounties) == 0 {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			}
+
+			balanceA := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayerA)
+			balanceB := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayerB)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributeHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, relayerA, payees)
+
+			suite.Require().Equal(balanceA.Add(expBalanceA...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayerA))
+			suite.Require().Equal(balanceB.Add(expBalanceB...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayerB))
+
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().Equal(expBountiesLeft, found)
+			suite.Require().Equal(expLocked, suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundHandshakeBounties() {
+	suite.coordinator.SetupConnections(suite.path)
+
+	err := suite.path.EndpointA.ChanOpenInit()
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	portID, channelID := suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID
+
+	bounty := types.NewHandshakeBounty(defaultRecvFee, refundAcc.String(), uint64(ctx.BlockHeight())+100, 0)
+	msg := types.NewMsgPayHandshakeBounty(portID, channelID, bounty)
+	_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	refundBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.RefundHandshakeBounties(ctx, portID, channelID)
+
+	suite.Require().Equal(refundBalance.Add(defaultRecvFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(ctx, portID, channelID)
+	suite.Require().False(found)
+
+	// the expiry index entry is removed together with the handshake bounty
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	suite.Require().False(store.Has(types.KeyBountyExpiryHeight(bounty.ExpiryHeight, portID, channelID)))
+}
@@ -45,7 +45,10 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 }
 
 // DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
-func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+// The acknowledgement fees are split between the reverse relayer payees proportionally to their weights.
+// The fees paid out are accumulated in the relayer stats of the addresses which received them, the relayed packets
+// are counted in the relayer stats of the forward and reverse relayers.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, reversePayees []types.WeightedPayee, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
@@ -53,6 +56,11 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 	// forward relayer address will be empty if conversion fails
 	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
 
+	var (
+		recvFees sdk.Coins
+		ackFees  = make([]sdk.Coins, len(reversePayees))
+	)
+
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -71,7 +79,34 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		recvFee, ackShares := k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reversePayees, packetFee)
+
+		recvFees = recvFees.Add(recvFee...)
+		for i, share := range ackShares {
+			ackFees[i] = ackFees[i].Add(share...)
+		}
+	}
+
+	// the receive fee is only earned by a valid forward relayer, otherwise it is refunded
+	if !forwardAddr.Empty() && !k.bankKeeper.BlockedAddr(forwardAddr) {
+		k.updateRelayerStats(cacheCtx, forwardAddr.String(), packetID.ChannelId, func(stats *types.RelayerStats) {
+			stats.RecvFees = stats.RecvFees.Add(recvFees...)
+			stats.RecvPackets++
+		})
+	}
+
+	k.updateRelayerStats(cacheCtx, reverseRelayer.String(), packetID.ChannelId, func(stats *types.RelayerStats) {
+		stats.AckPackets++
+	})
+
+	for i, fee := range ackFees {
+		if fee.IsZero() {
+			continue
+		}
+
+		k.updateRelayerStats(cacheCtx, reversePayees[i].Address, packetID.ChannelId, func(stats *types.RelayerStats) {
+			stats.AckFees = stats.AckFees.Add(fee...)
+		})
 	}
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
@@ -86,29 +121,41 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// The receive fee paid to the forward relayer and the acknowledgement fee shares paid to each reverse relayer payee are returned.
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer sdk.AccAddress, reversePayees []types.WeightedPayee, packetFee types.PacketFee) (sdk.Coins, []sdk.Coins) {
+	var recvFee sdk.Coins
+
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
 		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+		if k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee) {
+			recvFee = packetFee.Fee.RecvFee
+		}
 	} else {
 		// refund onRecv fee as forward relayer is not valid address
 		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	ackShares := k.distributeWeightedFee(ctx, reversePayees, refundAddr, packetFee.Fee.AckFee)
 
 	// refund timeout fee for unused timeout
 	k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.TimeoutFee)
+
+	return recvFee, ackShares
 }
 
 // DistributePacketsFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
-func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+// The timeout fees are split between the timeout relayer payees proportionally to their weights.
+// The fees paid out are accumulated in the relayer stats of the payees which received them, the relayed timeout is
+// counted in the relayer stats of the timeout relayer.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, timeoutPayees []types.WeightedPayee, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	timeoutFees := make([]sdk.Coins, len(timeoutPayees))
+
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -127,7 +174,23 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		for i, share := range k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutPayees, packetFee) {
+			timeoutFees[i] = timeoutFees[i].Add(share...)
+		}
+	}
+
+	k.updateRelayerStats(cacheCtx, timeoutRelayer.String(), packetID.ChannelId, func(stats *types.RelayerStats) {
+		stats.TimeoutPackets++
+	})
+
+	for i, fee := range timeoutFees {
+		if fee.IsZero() {
+			continue
+		}
+
+		k.updateRelayerStats(cacheCtx, timeoutPayees[i].Address, packetID.ChannelId, func(stats *types.RelayerStats) {
+			stats.TimeoutFees = stats.TimeoutFees.Add(fee...)
+		})
 	}
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
@@ -140,8 +203,9 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 	k.DeleteFeesInEscrow(ctx, packetID)
 }
 
-// distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer payees and refunds the acknowledgement & receive fee.
+// The timeout fee shares paid to each timeout relayer payee are returned.
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr sdk.AccAddress, timeoutPayees []types.WeightedPayee, packetFee types.PacketFee) []sdk.Coins {
 	// refund receive fee for unused forward relaying
 	k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 
@@ -149,13 +213,67 @@ func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeou
 	k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.AckFee)
 
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	return k.distributeWeightedFee(ctx, timeoutPayees, refundAddr, packetFee.Fee.TimeoutFee)
+}
+
+// distributeWeightedFee splits the escrowed fee between the weighted payees and attempts to distribute each share.
+// A share which cannot be distributed to its payee is refunded to the refund account. If no payees are provided
+// the entire fee is refunded. The shares paid to each payee are returned, a refunded share is returned as empty.
+func (k Keeper) distributeWeightedFee(ctx sdk.Context, payees []types.WeightedPayee, refundAccAddress sdk.AccAddress, fee sdk.Coins) []sdk.Coins {
+	if len(payees) == 0 {
+		k.distributeFee(ctx, refundAccAddress, refundAccAddress, fee)
+		return nil
+	}
+
+	paid := make([]sdk.Coins, len(payees))
+	for i, share := range splitFee(payees, fee) {
+		if share.IsZero() {
+			continue
+		}
+
+		receiver, err := sdk.AccAddressFromBech32(payees[i].Address)
+		if err != nil {
+			k.Logger(ctx).Error("error parsing payee address, refunding fee share", "payee address", payees[i].Address, "fee", share)
+			k.distributeFee(ctx, refundAccAddress, refundAccAddress, share)
+			continue
+		}
+
+		if k.distributeFee(ctx, receiver, refundAccAddress, share) {
+			paid[i] = share
+		}
+	}
+
+	return paid
+}
+
+// splitFee splits the fee into shares proportional to the weight of each payee. Each share is truncated
+// to a whole amount and any remainder left over from rounding is allocated to the first payee, so that
+// the sum of all shares always equals the fee.
+func splitFee(payees []types.WeightedPayee, fee sdk.Coins) []sdk.Coins {
+	shares := make([]sdk.Coins, len(payees))
+	totalWeight := sdk.NewIntFromUint64(types.TotalPayeeWeight)
+
+	for _, coin := range fee {
+		remainder := coin.Amount
+		for i, payee := range payees {
+			amount := coin.Amount.Mul(sdk.NewIntFromUint64(payee.Weight)).Quo(totalWeight)
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, amount))
+			remainder = remainder.Sub(amount)
+		}
+
+		if remainder.IsPositive() {
+			shares[0] = shares[0].Add(sdk.NewCoin(coin.Denom, remainder))
+		}
+	}
+
+	return shares
 }
 
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// the state changes will be discarded and the fee is refunded to the refund address.
+// True is returned if the fee has been paid to the receiver address.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) bool {
 	// cache context before trying to distribute fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
@@ -163,7 +281,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 	if err != nil {
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			return false // if sending to the refund address already failed, then return (no-op)
 		}
 
 		// if an error is returned from x/bank and the receiver is not the refundAccAddress
@@ -171,7 +289,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			return false // if sending to the refund address fails, no-op
 		}
 	}
 
@@ -180,6 +298,8 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return err == nil
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
@@ -236,3 +356,111 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 
 	return nil
 }
+
+// RefundExpiredPacketFees iterates the expiry index under the provided key prefix in ascending order of expiry
+// and refunds every packet fee which has expired at the current block height or block time to its refund address.
+// At most limit index entries with an expiry at or before the provided expiry are processed, the number of
+// processed entries is returned. If the escrow account runs out of balance then the fee module will become
+// locked and processing stops.
+func (k Keeper) RefundExpiredPacketFees(ctx sdk.Context, keyPrefix string, expiry uint64, limit int) int {
+	var (
+		keys      [][]byte
+		packetIDs []channeltypes.PacketId
+	)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(keyPrefix+"/"))
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keyExpiry, packetID, err := types.ParseKeyPacketFeeExpiry(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		// the index is ordered by expiry, all remaining entries have not yet expired
+		if keyExpiry > expiry {
+			break
+		}
+
+		keys = append(keys, append([]byte(nil), iterator.Key()...))
+		packetIDs = append(packetIDs, packetID)
+	}
+
+	// the iterator must be closed before writing to the store
+	iterator.Close()
+
+	for i, packetID := range packetIDs {
+		if !k.refundExpiredPacketFeesForPacket(ctx, packetID, keys[i]) {
+			break
+		}
+	}
+
+	return len(keys)
+}
+
+// refundExpiredPacketFeesForPacket refunds the expired packet fees escrowed for the given packetID, the packet fees
+// which have not yet expired remain in escrow. The processed expiry index entry is removed, unless it is still
+// shared by a packet fee remaining in escrow. False is returned if the fee module has been locked.
+func (k Keeper) refundExpiredPacketFeesForPacket(ctx sdk.Context, packetID channeltypes.PacketId, indexKey []byte) bool {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		// ensure the processed index entry is removed even if no packet fee remains in escrow
+		ctx.KVStore(k.storeKey).Delete(indexKey)
+		return true
+	}
+
+	height, timestamp := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UnixNano())
+
+	// cache context before trying to refund fees
+	// if the escrow account has insufficient balance then we want to avoid partially refunding fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	// the processed index entry is removed before the remaining packet fees are re-indexed
+	cacheCtx.KVStore(k.storeKey).Delete(indexKey)
+
+	var remainingFees []types.PacketFee
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if !packetFee.IsExpired(height, timestamp) {
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			// the fee module should be locked until manual intervention fixes the issue
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx)
+			return false
+		}
+
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			// the refund address is validated when the fee is escrowed, an invalid address should never be stored
+			// the expiry is cleared so the packet fee remains in escrow until it is distributed or the channel is closed
+			k.Logger(ctx).Error("failed to refund expired packet fee", "refund address", packetFee.RefundAddress, "error", err.Error())
+
+			packetFee.ExpiryHeight, packetFee.ExpiryTimestamp = 0, 0
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		k.distributeFee(cacheCtx, refundAddr, refundAddr, packetFee.Fee.Total())
+
+		EmitRefundExpiredPacketFeeEvent(cacheCtx, packetID, packetFee)
+	}
+
+	// the index entries of the refunded packet fees are removed, while the remaining packet fees are re-indexed
+	if len(remainingFees) == 0 {
+		k.DeleteFeesInEscrow(cacheCtx, packetID)
+	} else {
+		k.SetFeesInEscrow(cacheCtx, packetID, types.NewPacketFees(remainingFees))
+	}
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// write the cache
+	writeFn()
+
+	return true
+}
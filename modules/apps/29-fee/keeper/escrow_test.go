@@ -192,7 +192,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			reverseRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, packetFees, packetID)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, []types.WeightedPayee{types.NewWeightedPayee(reverseRelayer.String(), types.TotalPayeeWeight)}, packetFees, packetID)
 			tc.expResult()
 		})
 	}
@@ -304,13 +304,230 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 			timeoutRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutRelayer, packetFees, packetID)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutRelayer, []types.WeightedPayee{types.NewWeightedPayee(timeoutRelayer.String(), types.TotalPayeeWeight)}, packetFees, packetID)
 
 			tc.expResult()
 		})
 	}
 }
 
+func (suite *KeeperTestSuite) TestDistributePacketFeesWeightedPayees() {
+	var (
+		payees     []types.WeightedPayee
+		refundAcc  sdk.AccAddress
+		packetFees []types.PacketFee
+		expShares  []sdk.Int
+		expRefund  sdk.Int
+		onTimeout  bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: ack fee split between payees",
+			func() {
+				expShares = []sdk.Int{sdk.NewInt(120), sdk.NewInt(60), sdk.NewInt(20)}
+				expRefund = defaultTimeoutFee.AmountOf(sdk.DefaultBondDenom)
+			},
+		},
+		{
+			"success: ack fee rounding remainder is paid to the first payee",
+			func() {
+				payees[0].Weight = 3334
+				payees[1].Weight = 3333
+				payees[2].Weight = 3333
+
+				// 200 * 3333 / 10000 = 66 for each payee, the remaining 2 is paid to the first payee
+				expShares = []sdk.Int{sdk.NewInt(68), sdk.NewInt(66), sdk.NewInt(66)}
+				expRefund = defaultTimeoutFee.AmountOf(sdk.DefaultBondDenom)
+			},
+		},
+		{
+			"success: timeout fee split between payees",
+			func() {
+				onTimeout = true
+
+				expShares = []sdk.Int{sdk.NewInt(180), sdk.NewInt(90), sdk.NewInt(30)}
+				expRefund = defaultRecvFee.Add(defaultAckFee...).AmountOf(sdk.DefaultBondDenom)
+			},
+		},
+		{
+			"blocked payee: share is refunded",
+			func() {
+				payees[1].Address = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress().String()
+
+				expShares = []sdk.Int{sdk.NewInt(120), sdk.ZeroInt(), sdk.NewInt(20)}
+				expRefund = defaultTimeoutFee.AmountOf(sdk.DefaultBondDenom).AddRaw(60)
+			},
+		},
+		{
+			"no payees: fee is refunded",
+			func() {
+				payees = nil
+
+				expShares = nil
+				expRefund = defaultTimeoutFee.Add(defaultAckFee...).AmountOf(sdk.DefaultBondDenom)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                   // reset
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			onTimeout = false
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			payees = []types.WeightedPayee{
+				types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 6000),
+				types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 3000),
+				types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 1000),
+			}
+
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAcc.String(), []string{})
+			packetFees = []types.PacketFee{packetFee}
+
+			tc.malleate()
+
+			// escrow the packet fees & store the fees in state
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, packetFee.Fee.Total())
+			suite.Require().NoError(err)
+
+			payeeBals := make([]sdk.Int, len(payees))
+			for i, payee := range payees {
+				payeeBals[i] = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(payee.Address), sdk.DefaultBondDenom).Amount
+			}
+			refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			if onTimeout {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), payees, packetFees, packetID)
+			} else {
+				// an empty forward relayer refunds the recv fee so only the acknowledgement fee is split
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), "", suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), payees, packetFees, packetID)
+				expRefund = expRefund.Add(defaultRecvFee.AmountOf(sdk.DefaultBondDenom))
+			}
+
+			for i, payee := range payees {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(payee.Address), sdk.DefaultBondDenom)
+				suite.Require().Equal(payeeBals[i].Add(expShares[i]), balance.Amount)
+			}
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+			suite.Require().Equal(refundAccBal.Amount.Add(expRefund), balance.Amount)
+
+			// all escrowed fees have been distributed
+			moduleBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+			suite.Require().True(moduleBal.IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesRelayerStats() {
+	suite.coordinator.Setup(suite.path)
+
+	var (
+		forwardRelayer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+		reverseRelayer = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+		refundAcc      = suite.chainA.SenderAccount.GetAddress()
+		channelID      = suite.path.EndpointA.ChannelID
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := []types.PacketFee{
+		types.NewPacketFee(fee, refundAcc.String(), nil),
+		types.NewPacketFee(fee, refundAcc.String(), nil),
+	}
+	payees := []types.WeightedPayee{types.NewWeightedPayee(reverseRelayer.String(), types.TotalPayeeWeight)}
+
+	escrowPacketFees := func(sequence uint64) channeltypes.PacketId {
+		packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, channelID, sequence)
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+
+		err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, fee.Total().Add(fee.Total()...))
+		suite.Require().NoError(err)
+
+		return packetID
+	}
+
+	// distribute fees on acknowledgement for two packets
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, payees, packetFees, escrowPacketFees(sequence))
+	}
+
+	forwardStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(400))), forwardStats.RecvFees)
+	suite.Require().Equal(uint64(2), forwardStats.RecvPackets)
+	suite.Require().Empty(forwardStats.AckFees)
+	suite.Require().Zero(forwardStats.AckPackets)
+
+	reverseStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(800))), reverseStats.AckFees)
+	suite.Require().Equal(uint64(2), reverseStats.AckPackets)
+	suite.Require().Empty(reverseStats.RecvFees)
+
+	// distribute fees on timeout, accumulating into the existing stats of the reverse relayer
+	suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), reverseRelayer, payees, packetFees, escrowPacketFees(3))
+
+	reverseStats, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(600))), reverseStats.TimeoutFees)
+	suite.Require().Equal(uint64(1), reverseStats.TimeoutPackets)
+	suite.Require().Equal(uint64(2), reverseStats.AckPackets)
+
+	// an invalid forward relayer does not earn the receive fee
+	invalidForwardRelayer := suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+	suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), invalidForwardRelayer.String(), reverseRelayer, payees, packetFees, escrowPacketFees(4))
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), invalidForwardRelayer.String(), channelID)
+	suite.Require().False(found)
+
+	// fees are recorded against the payees which received them, shares refunded from a blocked payee are not recorded
+	payee := suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+	splitPayees := []types.WeightedPayee{
+		types.NewWeightedPayee(payee.String(), types.TotalPayeeWeight/2),
+		types.NewWeightedPayee(invalidForwardRelayer.String(), types.TotalPayeeWeight/2),
+	}
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, splitPayees, packetFees, escrowPacketFees(5))
+	suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), reverseRelayer, splitPayees, packetFees, escrowPacketFees(6))
+
+	payeeStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), payee.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))), payeeStats.AckFees)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300))), payeeStats.TimeoutFees)
+	suite.Require().Zero(payeeStats.AckPackets)
+	suite.Require().Zero(payeeStats.TimeoutPackets)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), invalidForwardRelayer.String(), channelID)
+	suite.Require().False(found)
+
+	// the relayed packets are counted against the relayer which submitted them
+	reverseStats, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1200))), reverseStats.AckFees)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(600))), reverseStats.TimeoutFees)
+	suite.Require().Equal(uint64(4), reverseStats.AckPackets)
+	suite.Require().Equal(uint64(2), reverseStats.TimeoutPackets)
+
+	// no stats are recorded when the fee module is locked due to insufficient escrow balance
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, channelID, 7)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+	suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), forwardRelayer, payees, packetFees, packetID)
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+	forwardStats, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer.String(), channelID)
+	suite.Require().True(found)
+	suite.Require().Zero(forwardStats.TimeoutPackets)
+}
+
 func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 	var (
 		expIdentifiedPacketFees     []types.IdentifiedPacketFees
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -60,6 +61,16 @@ func EmitRegisterPayeeEvent(ctx sdk.Context, relayer, payee, channelID string) {
 	})
 }
 
+// formatWeightedPayees returns the weighted payees as a comma separated list of address:weight pairs
+func formatWeightedPayees(payees []types.WeightedPayee) string {
+	pairs := make([]string, len(payees))
+	for i, payee := range payees {
+		pairs[i] = fmt.Sprintf("%s:%d", payee.Address, payee.Weight)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
 // EmitRegisterCounterpartyPayeeEvent emits an event containing information of a registered counterparty payee for a relayer on a particular channel
 func EmitRegisterCounterpartyPayeeEvent(ctx sdk.Context, relayer, counterpartyPayee, channelID string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -75,3 +86,83 @@ func EmitRegisterCounterpartyPayeeEvent(ctx sdk.Context, relayer, counterpartyPa
 		),
 	})
 }
+
+// EmitMinRelayerFeeEvent emits an event of the provided type containing the minimum relayer fee of a particular channel
+func EmitMinRelayerFeeEvent(ctx sdk.Context, eventType, portID, channelID string, minFee types.Fee) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, minFee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, minFee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, minFee.TimeoutFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitRefundExpiredPacketFeeEvent emits an event containing information of an expired packet fee which has been
+// refunded to its refund address
+func EmitRefundExpiredPacketFeeEvent(ctx sdk.Context, packetID channeltypes.PacketId, packetFee types.PacketFee) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundExpiredPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, packetFee.RefundAddress),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, packetFee.Fee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, packetFee.Fee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, packetFee.Fee.TimeoutFee.String()),
+		),
+	)
+}
+
+// EmitIncentivizedHandshakeEvent emits an event containing information on the total bounty escrowed to incentivize
+// the opening handshake of a channel. It should be emitted on every handshake bounty escrowed for the given channel.
+func EmitIncentivizedHandshakeEvent(ctx sdk.Context, portID, channelID string, handshakeBounties types.HandshakeBounties) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIncentivizedHandshake,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyBounty, handshakeBounties.Total().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitDistributeHandshakeBountyEvent emits an event containing information on the handshake bounties paid out to the
+// relayer which completed the opening handshake of a channel
+func EmitDistributeHandshakeBountyEvent(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress, bounty sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeHandshakeBounty,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyBounty, bounty.String()),
+		),
+	)
+}
+
+// EmitRefundHandshakeBountyEvent emits an event containing information of a handshake bounty which has been refunded
+// to its refund address
+func EmitRefundHandshakeBountyEvent(ctx sdk.Context, portID, channelID string, handshakeBounty types.HandshakeBounty) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundHandshakeBounty,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, handshakeBounty.RefundAddress),
+			sdk.NewAttribute(types.AttributeKeyBounty, handshakeBounty.Bounty.String()),
+		),
+	)
+}
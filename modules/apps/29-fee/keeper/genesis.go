@@ -13,6 +13,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	for _, registeredPayee := range state.RegisteredPayees {
+		if len(registeredPayee.Payees) != 0 {
+			k.SetWeightedPayees(ctx, registeredPayee.Relayer, registeredPayee.ChannelId, registeredPayee.Payees)
+			continue
+		}
+
 		k.SetPayeeAddress(ctx, registeredPayee.Relayer, registeredPayee.Payee, registeredPayee.ChannelId)
 	}
 
@@ -27,6 +32,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, minRelayerFee := range state.MinRelayerFees {
+		k.SetMinRelayerFee(ctx, minRelayerFee.PortId, minRelayerFee.ChannelId, minRelayerFee.MinFee)
+	}
+
+	for _, relayerStats := range state.RelayerStats {
+		k.SetRelayerStats(ctx, relayerStats)
+	}
+
+	for _, identifiedBounties := range state.HandshakeBounties {
+		k.SetHandshakeBounties(ctx, identifiedBounties.PortId, identifiedBounties.ChannelId, types.NewHandshakeBounties(identifiedBounties.HandshakeBounties))
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +54,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		MinRelayerFees:               k.GetAllMinRelayerFees(ctx),
+		RelayerStats:                 k.GetAllRelayerStats(ctx),
+		HandshakeBounties:            k.GetAllHandshakeBounties(ctx),
 	}
 }
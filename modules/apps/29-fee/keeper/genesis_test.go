@@ -34,6 +34,14 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				Payee:     suite.chainB.SenderAccount.GetAddress().String(),
 				ChannelId: ibctesting.FirstChannelID,
 			},
+			{
+				Relayer: suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+				Payees: []types.WeightedPayee{
+					types.NewWeightedPayee(suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(), 6000),
+					types.NewWeightedPayee(suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), 4000),
+				},
+				ChannelId: ibctesting.FirstChannelID,
+			},
 		},
 		RegisteredCounterpartyPayees: []types.RegisteredCounterpartyPayee{
 			{
@@ -42,6 +50,28 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		MinRelayerFees: []types.MinRelayerFee{
+			{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+				MinFee:    types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+			},
+		},
+		RelayerStats: []types.RelayerStats{
+			{
+				Relayer:     suite.chainA.SenderAccount.GetAddress().String(),
+				ChannelId:   ibctesting.FirstChannelID,
+				RecvFees:    defaultRecvFee,
+				AckFees:     defaultAckFee,
+				RecvPackets: 1,
+				AckPackets:  1,
+			},
+		},
+		HandshakeBounties: []types.IdentifiedHandshakeBounties{
+			types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, "channel-1", []types.HandshakeBounty{
+				types.NewHandshakeBounty(defaultRecvFee, suite.chainA.SenderAccount.GetAddress().String(), 100, 0),
+			}),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -60,10 +90,30 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredPayees[0].Payee, payeeAddr)
 
+	// check weighted payees
+	weightedPayees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetWeightedPayees(suite.chainA.GetContext(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RegisteredPayees[1].Payees, weightedPayees)
+
 	// check relayers
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check minimum relayer fee
+	minFee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetMinRelayerFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.MinRelayerFees[0].MinFee, minFee)
+
+	// check relayer stats
+	relayerStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RelayerStats[0], relayerStats)
+
+	// check handshake bounties
+	handshakeBounties, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), ibctesting.MockFeePort, "channel-1")
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.HandshakeBounties[0].HandshakeBounties, handshakeBounties.HandshakeBounties)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -86,6 +136,18 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 		ibctesting.FirstChannelID,
 	)
 
+	// set weighted payees
+	weightedPayees := []types.WeightedPayee{
+		types.NewWeightedPayee(suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(), 6000),
+		types.NewWeightedPayee(suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), 4000),
+	}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetWeightedPayees(
+		suite.chainA.GetContext(),
+		suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+		ibctesting.FirstChannelID,
+		weightedPayees,
+	)
+
 	// set counterparty payee address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(
 		suite.chainA.GetContext(),
@@ -97,6 +159,19 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set minimum relayer fee
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, fee)
+
+	// set relayer stats
+	relayerStats := types.NewRelayerStats(suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	relayerStats.TimeoutFees = defaultTimeoutFee
+	relayerStats.TimeoutPackets = 2
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+	// set handshake bounties
+	handshakeBounties := []types.HandshakeBounty{types.NewHandshakeBounty(defaultRecvFee, refundAcc.String(), 100, 0)}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetHandshakeBounties(suite.chainA.GetContext(), ibctesting.MockFeePort, "channel-1", types.NewHandshakeBounties(handshakeBounties))
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -119,8 +194,24 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredPayees[0].Payee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredPayees[0].ChannelId)
 
+	// check weighted payees
+	suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), genesisState.RegisteredPayees[1].Relayer)
+	suite.Require().Equal(weightedPayees, genesisState.RegisteredPayees[1].Payees)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredPayees[1].ChannelId)
+
 	// check registered counterparty payee addresses
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check minimum relayer fees
+	suite.Require().Equal(ibctesting.MockFeePort, genesisState.MinRelayerFees[0].PortId)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.MinRelayerFees[0].ChannelId)
+	suite.Require().Equal(fee, genesisState.MinRelayerFees[0].MinFee)
+
+	// check relayer stats
+	suite.Require().Equal([]types.RelayerStats{relayerStats}, genesisState.RelayerStats)
+
+	// check handshake bounties
+	suite.Require().Equal([]types.IdentifiedHandshakeBounties{types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, "channel-1", handshakeBounties)}, genesisState.HandshakeBounties)
 }
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -171,7 +174,7 @@ func (k Keeper) TotalTimeoutFees(goCtx context.Context, req *types.QueryTotalTim
 	}, nil
 }
 
-// Payee implements the Query/Payee gRPC method and returns the registered payee address to which packet fees are paid out
+// Payee implements the Query/Payee gRPC method and returns the registered payee address, or weighted payees, to which packet fees are paid out
 func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -179,6 +182,12 @@ func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*typ
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if payees, found := k.GetWeightedPayees(ctx, req.Relayer, req.ChannelId); found {
+		return &types.QueryPayeeResponse{
+			Payees: payees,
+		}, nil
+	}
+
 	payeeAddr, found := k.GetPayeeAddress(ctx, req.Relayer, req.ChannelId)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "payee address not found for address: %s on channel: %s", req.Relayer, req.ChannelId)
@@ -256,3 +265,291 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// MinRelayerFees implements the Query/MinRelayerFees gRPC method and returns a list of minimum relayer fees
+func (k Keeper) MinRelayerFees(goCtx context.Context, req *types.QueryMinRelayerFeesRequest) (*types.QueryMinRelayerFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var minRelayerFees []types.MinRelayerFee
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MinRelayerFeeKeyPrefix))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, channelID, err := types.ParseKeyMinRelayerFee(types.MinRelayerFeeKeyPrefix + string(key))
+		if err != nil {
+			return err
+		}
+
+		var minFee types.Fee
+		if err := k.cdc.Unmarshal(value, &minFee); err != nil {
+			return err
+		}
+
+		minRelayerFee := types.MinRelayerFee{
+			PortId:    portID,
+			ChannelId: channelID,
+			MinFee:    minFee,
+		}
+
+		minRelayerFees = append(minRelayerFees, minRelayerFee)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryMinRelayerFeesResponse{
+		MinRelayerFees: minRelayerFees,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// MinRelayerFee implements the Query/MinRelayerFee gRPC method and returns the minimum relayer fee required for
+// packets sent on the provided port and channel identifiers
+func (k Keeper) MinRelayerFee(goCtx context.Context, req *types.QueryMinRelayerFeeRequest) (*types.QueryMinRelayerFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minFee, found := k.GetMinRelayerFee(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrMinRelayerFeeNotFound, "port: %s, channel: %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryMinRelayerFeeResponse{
+		MinFee: minFee,
+	}, nil
+}
+
+// AllRelayerStats implements the Query/AllRelayerStats gRPC method and returns the accumulated earnings of all relayers
+func (k Keeper) AllRelayerStats(goCtx context.Context, req *types.QueryAllRelayerStatsRequest) (*types.QueryAllRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RelayerStatsKeyPrefix))
+	relayerStats, pageRes, err := k.paginateRelayerStats(store, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryAllRelayerStatsResponse{
+		RelayerStats: relayerStats,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// RelayerStats implements the Query/RelayerStats gRPC method and returns the accumulated earnings of a relayer on each channel
+func (k Keeper) RelayerStats(goCtx context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Relayer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRelayerStatsPrefix(req.Relayer))
+	relayerStats, pageRes, err := k.paginateRelayerStats(store, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryRelayerStatsResponse{
+		RelayerStats: relayerStats,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// paginateRelayerStats returns the relayer stats stored in the provided prefix store for the given page request
+func (k Keeper) paginateRelayerStats(store prefix.Store, pageReq *query.PageRequest) ([]types.RelayerStats, *query.PageResponse, error) {
+	var relayerStats []types.RelayerStats
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var stats types.RelayerStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+
+		relayerStats = append(relayerStats, stats)
+
+		return nil
+	})
+
+	return relayerStats, pageRes, err
+}
+
+// ExpiringPacketFees implements the Query/ExpiringPacketFees gRPC method and returns the packet fees which expire
+// at or before the provided block height or block time
+func (k Keeper) ExpiringPacketFees(goCtx context.Context, req *types.QueryExpiringPacketFeesRequest) (*types.QueryExpiringPacketFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		keyPrefix string
+		expiry    uint64
+	)
+
+	switch {
+	case req.ExpiryHeight != 0 && req.ExpiryTimestamp != 0:
+		return nil, status.Error(codes.InvalidArgument, "expiry height and expiry timestamp cannot both be set")
+	case req.ExpiryHeight != 0:
+		keyPrefix, expiry = types.ExpiryHeightKeyPrefix, req.ExpiryHeight
+	case req.ExpiryTimestamp != 0:
+		keyPrefix, expiry = types.ExpiryTimestampKeyPrefix, req.ExpiryTimestamp
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either expiry height or expiry timestamp must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the index is ordered by expiry, iteration stops at the first entry expiring after the requested expiry
+	store := expiryBoundedStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix+"/")),
+		end:     sdk.PrefixEndBytes([]byte(fmt.Sprintf("%020d/", expiry))),
+	}
+
+	var (
+		expiringPacketFees []types.IdentifiedPacketFees
+		parseErr           error
+	)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		keyExpiry, packetID, err := types.ParseKeyPacketFeeExpiry(keyPrefix + "/" + string(key))
+		if err != nil {
+			parseErr = err
+			return false, err
+		}
+
+		feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+		if !found {
+			return false, nil
+		}
+
+		// only return the packet fees indexed by the current entry
+		var packetFees []types.PacketFee
+		for _, packetFee := range feesInEscrow.PacketFees {
+			if (req.ExpiryHeight != 0 && packetFee.ExpiryHeight == keyExpiry) || (req.ExpiryTimestamp != 0 && packetFee.ExpiryTimestamp == keyExpiry) {
+				packetFees = append(packetFees, packetFee)
+			}
+		}
+
+		if len(packetFees) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			expiringPacketFees = append(expiringPacketFees, types.NewIdentifiedPacketFees(packetID, packetFees))
+		}
+
+		return true, nil
+	})
+	if parseErr != nil {
+		return nil, status.Error(codes.Internal, parseErr.Error())
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryExpiringPacketFeesResponse{
+		ExpiringPacketFees: expiringPacketFees,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// expiryBoundedStore wraps a store of the packet fee expiry index, limiting its iterators to the keys before end
+type expiryBoundedStore struct {
+	storetypes.KVStore
+	end []byte
+}
+
+// Iterator implements KVStore, the end of the iterator is limited to the end of the expiryBoundedStore
+func (s expiryBoundedStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.Iterator(start, s.boundEnd(end))
+}
+
+// ReverseIterator implements KVStore, the end of the iterator is limited to the end of the expiryBoundedStore
+func (s expiryBoundedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.ReverseIterator(start, s.boundEnd(end))
+}
+
+// boundEnd returns the provided iterator end if it precedes the end of the expiryBoundedStore
+func (s expiryBoundedStore) boundEnd(end []byte) []byte {
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		return s.end
+	}
+
+	return end
+}
+
+// HandshakeBounties implements the Query/HandshakeBounties gRPC method and returns all handshake bounties
+// escrowed for channels in the INIT state
+func (k Keeper) HandshakeBounties(goCtx context.Context, req *types.QueryHandshakeBountiesRequest) (*types.QueryHandshakeBountiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var identifiedBounties []types.IdentifiedHandshakeBounties
+	keyPrefix := types.HandshakeBountyKeyPrefix + "/"
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, channelID, err := types.ParseKeyHandshakeBounties(keyPrefix + string(key))
+		if err != nil {
+			return err
+		}
+
+		var handshakeBounties types.HandshakeBounties
+		if err := k.cdc.Unmarshal(value, &handshakeBounties); err != nil {
+			return err
+		}
+
+		identifiedBounties = append(identifiedBounties, types.NewIdentifiedHandshakeBounties(portID, channelID, handshakeBounties.HandshakeBounties))
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryHandshakeBountiesResponse{
+		HandshakeBounties: identifiedBounties,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// HandshakeBounty implements the Query/HandshakeBounty gRPC method and returns the handshake bounties escrowed
+// for the given port and channel identifiers
+func (k Keeper) HandshakeBounty(goCtx context.Context, req *types.QueryHandshakeBountyRequest) (*types.QueryHandshakeBountyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	handshakeBounties, found := k.GetHandshakeBounties(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrHandshakeBountyNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryHandshakeBountyResponse{
+		HandshakeBounty: types.NewIdentifiedHandshakeBounties(req.PortId, req.ChannelId, handshakeBounties.HandshakeBounties),
+	}, nil
+}
@@ -2,10 +2,13 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
@@ -472,6 +475,29 @@ func (suite *KeeperTestSuite) TestQueryPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPayeeWeightedPayees() {
+	suite.coordinator.Setup(suite.path)
+
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+	expPayees := []types.WeightedPayee{
+		types.NewWeightedPayee(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), 5000),
+		types.NewWeightedPayee(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), 5000),
+	}
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID, expPayees)
+	suite.chainA.NextBlock()
+
+	req := &types.QueryPayeeRequest{
+		ChannelId: suite.path.EndpointA.ChannelID,
+		Relayer:   relayer,
+	}
+
+	res, err := suite.queryClient.Payee(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.PayeeAddress)
+	suite.Require().Equal(expPayees, res.Payees)
+}
+
 func (suite *KeeperTestSuite) TestQueryCounterpartyPayee() {
 	var req *types.QueryCounterpartyPayeeRequest
 
@@ -690,3 +716,636 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryMinRelayerFees() {
+	var (
+		req               *types.QueryMinRelayerFeesRequest
+		expMinRelayerFees []types.MinRelayerFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty pagination",
+			func() {
+				req = &types.QueryMinRelayerFeesRequest{}
+			},
+			true,
+		},
+		{
+			"success: pagination with multiple minimum relayer fees",
+			func() {
+				// start at index 1, as channel-0 is already added to expMinRelayerFees below
+				for i := 1; i < 10; i++ {
+					channelID := channeltypes.FormatChannelIdentifier(uint64(i))
+					minFee := types.NewFee(defaultRecvFee, nil, nil)
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), ibctesting.MockFeePort, channelID, minFee)
+
+					if i < 5 { // add only the first 5 minimum relayer fees, as our default pagination limit is 5
+						expMinRelayerFees = append(expMinRelayerFees, types.MinRelayerFee{
+							PortId:    ibctesting.MockFeePort,
+							ChannelId: channelID,
+							MinFee:    minFee,
+						})
+					}
+				}
+
+				suite.chainA.NextBlock()
+			},
+			true,
+		},
+		{
+			"empty response",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				expMinRelayerFees = nil
+
+				suite.chainA.NextBlock()
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.coordinator.Setup(suite.path)
+
+			minFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minFee)
+
+			suite.chainA.NextBlock()
+
+			expMinRelayerFees = []types.MinRelayerFee{
+				{
+					PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+					ChannelId: suite.path.EndpointA.ChannelID,
+					MinFee:    minFee,
+				},
+			}
+
+			req = &types.QueryMinRelayerFeesRequest{
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.MinRelayerFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expMinRelayerFees, res.MinRelayerFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryMinRelayerFee() {
+	var req *types.QueryMinRelayerFeeRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"minimum relayer fee not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.coordinator.Setup(suite.path)
+
+			minFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minFee)
+
+			suite.chainA.NextBlock()
+
+			req = &types.QueryMinRelayerFeeRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.MinRelayerFee(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(minFee, res.MinFee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAllRelayerStats() {
+	var (
+		req             *types.QueryAllRelayerStatsRequest
+		expRelayerStats []types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty pagination",
+			func() {
+				req = &types.QueryAllRelayerStatsRequest{}
+			},
+			true,
+		},
+		{
+			"success: pagination with multiple relayer stats",
+			func() {
+				// start at index 1, as channel-0 is already added to expRelayerStats below
+				for i := 1; i < 10; i++ {
+					relayerStats := types.NewRelayerStats(suite.chainA.SenderAccount.GetAddress().String(), channeltypes.FormatChannelIdentifier(uint64(i)))
+					relayerStats.RecvFees = defaultRecvFee
+					relayerStats.RecvPackets = 1
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+					if i < 5 { // add only the first 5 relayer stats, as our default pagination limit is 5
+						expRelayerStats = append(expRelayerStats, relayerStats)
+					}
+				}
+
+				suite.chainA.NextBlock()
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayerStats := types.NewRelayerStats(suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+			relayerStats.AckFees = defaultAckFee
+			relayerStats.AckPackets = 3
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+			suite.chainA.NextBlock()
+
+			expRelayerStats = []types.RelayerStats{relayerStats}
+
+			req = &types.QueryAllRelayerStatsRequest{
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.AllRelayerStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayerStats, res.RelayerStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRelayerStats() {
+	var (
+		req             *types.QueryRelayerStatsRequest
+		expRelayerStats []types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no stats for relayer",
+			func() {
+				req.Relayer = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+				expRelayerStats = nil
+			},
+			true,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				req.Relayer = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+
+			expRelayerStats = nil
+			for i := 0; i < 3; i++ {
+				relayerStats := types.NewRelayerStats(relayer, channeltypes.FormatChannelIdentifier(uint64(i)))
+				relayerStats.TimeoutFees = defaultTimeoutFee
+				relayerStats.TimeoutPackets = uint64(i + 1)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+				expRelayerStats = append(expRelayerStats, relayerStats)
+			}
+
+			// stats of another relayer are not returned
+			otherRelayerStats := types.NewRelayerStats(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+			otherRelayerStats.RecvFees = defaultRecvFee
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), otherRelayerStats)
+
+			suite.chainA.NextBlock()
+
+			req = &types.QueryRelayerStatsRequest{
+				Relayer: relayer,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.RelayerStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayerStats, res.RelayerStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExpiringPacketFees() {
+	var (
+		req                   *types.QueryExpiringPacketFeesRequest
+		expExpiringPacketFees []types.IdentifiedPacketFees
+		expPageRes            *query.PageResponse
+		expErrCode            codes.Code
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: packet fees expiring after the provided height are not returned",
+			func() {
+				req.ExpiryHeight = 99
+				expExpiringPacketFees = nil
+			},
+			true,
+		},
+		{
+			"success: expiry timestamp",
+			func() {
+				packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				packetFee.ExpiryTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+				suite.chainA.NextBlock()
+
+				req.ExpiryHeight = 0
+				req.ExpiryTimestamp = packetFee.ExpiryTimestamp
+				expExpiringPacketFees = []types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee})}
+			},
+			true,
+		},
+		{
+			"success: pagination with multiple expiring packet fees",
+			func() {
+				// start at sequence 2, as sequence 1 is already added to expExpiringPacketFees below
+				for seq := uint64(2); seq < 10; seq++ {
+					packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, seq)
+					packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+					packetFee.ExpiryHeight = 100 + seq
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+					if seq < 6 { // add only the first 5 packet fees, as our default pagination limit is 5
+						expExpiringPacketFees = append(expExpiringPacketFees, types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee}))
+					}
+				}
+
+				suite.chainA.NextBlock()
+
+				req.ExpiryHeight = 200
+			},
+			true,
+		},
+		{
+			"success: iteration stops at the first packet fee expiring after the provided height",
+			func() {
+				for seq := uint64(2); seq < 10; seq++ {
+					packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, seq)
+					packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+					packetFee.ExpiryHeight = 100 + seq
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+					if packetFee.ExpiryHeight <= 104 {
+						expExpiringPacketFees = append(expExpiringPacketFees, types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee}))
+					}
+				}
+
+				// an invalid entry expiring after the provided height is never iterated
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+				store.Set([]byte(fmt.Sprintf("%s/%020d/zzz", types.ExpiryHeightKeyPrefix, 105)), []byte{1})
+
+				suite.chainA.NextBlock()
+
+				req.ExpiryHeight = 104
+				req.Pagination.CountTotal = true
+
+				expPageRes = &query.PageResponse{Total: 4}
+			},
+			true,
+		},
+		{
+			"success: reverse pagination",
+			func() {
+				for seq := uint64(2); seq < 4; seq++ {
+					packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, seq)
+					packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+					packetFee.ExpiryHeight = 98 + seq
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+					if packetFee.ExpiryHeight <= 100 {
+						expExpiringPacketFees = append([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee})}, expExpiringPacketFees...)
+					}
+				}
+
+				suite.chainA.NextBlock()
+
+				req.Pagination.Reverse = true
+			},
+			true,
+		},
+		{
+			"invalid expiry index entry",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+				// the entry is ordered after the valid entry at the same expiry, which has not yet expired
+				store.Set([]byte(fmt.Sprintf("%s/%020d/zzz", types.ExpiryHeightKeyPrefix, 100)), []byte{1})
+
+				suite.chainA.NextBlock()
+
+				expErrCode = codes.Internal
+			},
+			false,
+		},
+		{
+			"expiry height and expiry timestamp are both empty",
+			func() {
+				req.ExpiryHeight = 0
+			},
+			false,
+		},
+		{
+			"expiry height and expiry timestamp are both set",
+			func() {
+				req.ExpiryTimestamp = 1000
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			packetFee.ExpiryHeight = 100
+
+			// packet fees without an expiry are not returned
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{
+				packetFee,
+				types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil),
+			}))
+
+			suite.chainA.NextBlock()
+
+			expExpiringPacketFees = []types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee})}
+			expPageRes = nil
+			expErrCode = codes.InvalidArgument
+
+			req = &types.QueryExpiringPacketFeesRequest{
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+				ExpiryHeight: 100,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.ExpiringPacketFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expExpiringPacketFees, res.ExpiringPacketFees)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				if expPageRes != nil {
+					suite.Require().Equal(expPageRes, res.Pagination)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(expErrCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryHandshakeBounties() {
+	var (
+		req                  *types.QueryHandshakeBountiesRequest
+		expHandshakeBounties []types.IdentifiedHandshakeBounties
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit:      1,
+					CountTotal: false,
+				}
+
+				expHandshakeBounties = expHandshakeBounties[:1]
+			},
+			true,
+		},
+		{
+			"empty pagination",
+			func() {
+				expHandshakeBounties = nil
+
+				// delete the previously stored handshake bounties
+				for _, identifiedBounties := range suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHandshakeBounties(suite.chainA.GetContext()) {
+					suite.chainA.GetSimApp().IBCFeeKeeper.DeleteHandshakeBounties(suite.chainA.GetContext(), identifiedBounties.PortId, identifiedBounties.ChannelId)
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			refundAcc := suite.chainA.SenderAccount.GetAddress().String()
+			expiryHeight := uint64(suite.chainA.GetContext().BlockHeight()) + 100
+			bounties := []types.HandshakeBounty{types.NewHandshakeBounty(defaultRecvFee, refundAcc, expiryHeight, 0)}
+
+			expHandshakeBounties = []types.IdentifiedHandshakeBounties{
+				types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, "channel-0", bounties),
+				types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, "channel-1", bounties),
+			}
+
+			for _, identifiedBounties := range expHandshakeBounties {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetHandshakeBounties(suite.chainA.GetContext(), identifiedBounties.PortId, identifiedBounties.ChannelId, types.NewHandshakeBounties(identifiedBounties.HandshakeBounties))
+			}
+
+			req = &types.QueryHandshakeBountiesRequest{
+				Pagination: &query.PageRequest{
+					Key:        nil,
+					Limit:      10,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			suite.chainA.NextBlock()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.HandshakeBounties(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expHandshakeBounties, res.HandshakeBounties)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryHandshakeBounty() {
+	var req *types.QueryHandshakeBountyRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"handshake bounty not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			refundAcc := suite.chainA.SenderAccount.GetAddress().String()
+			expiryHeight := uint64(suite.chainA.GetContext().BlockHeight()) + 100
+			bounties := []types.HandshakeBounty{types.NewHandshakeBounty(defaultRecvFee, refundAcc, expiryHeight, 0)}
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetHandshakeBounties(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewHandshakeBounties(bounties))
+
+			suite.chainA.NextBlock()
+
+			req = &types.QueryHandshakeBountyRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.HandshakeBounty(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID, bounties), res.HandshakeBounty)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -30,12 +31,17 @@ type Keeper struct {
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+
+	// the address capable of setting the minimum relayer fees. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		cdc:           cdc,
@@ -45,6 +51,7 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -53,6 +60,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
 }
 
+// GetAuthority returns the address capable of setting the minimum relayer fees
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // BindPort defines a wrapper function for the port Keeper's function in
 // order to expose it to module's InitGenesis function
 func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
@@ -148,6 +160,60 @@ func (k Keeper) GetAllFeeEnabledChannels(ctx sdk.Context) []types.FeeEnabledChan
 	return enabledChArr
 }
 
+// SetMinRelayerFee stores the minimum relayer fee required for packets sent on the given port and channel identifiers
+func (k Keeper) SetMinRelayerFee(ctx sdk.Context, portID, channelID string, minFee types.Fee) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyMinRelayerFee(portID, channelID), k.cdc.MustMarshal(&minFee))
+}
+
+// GetMinRelayerFee retrieves the minimum relayer fee required for packets sent on the given port and channel identifiers
+func (k Keeper) GetMinRelayerFee(ctx sdk.Context, portID, channelID string) (types.Fee, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMinRelayerFee(portID, channelID))
+	if bz == nil {
+		return types.Fee{}, false
+	}
+
+	var minFee types.Fee
+	k.cdc.MustUnmarshal(bz, &minFee)
+
+	return minFee, true
+}
+
+// DeleteMinRelayerFee deletes the minimum relayer fee for the given port and channel identifiers
+func (k Keeper) DeleteMinRelayerFee(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMinRelayerFee(portID, channelID))
+}
+
+// GetAllMinRelayerFees returns all minimum relayer fees stored in state
+func (k Keeper) GetAllMinRelayerFees(ctx sdk.Context) []types.MinRelayerFee {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.MinRelayerFeeKeyPrefix))
+	defer iterator.Close()
+
+	var minRelayerFees []types.MinRelayerFee
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyMinRelayerFee(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var minFee types.Fee
+		k.cdc.MustUnmarshal(iterator.Value(), &minFee)
+
+		minRelayerFee := types.MinRelayerFee{
+			PortId:    portID,
+			ChannelId: channelID,
+			MinFee:    minFee,
+		}
+
+		minRelayerFees = append(minRelayerFees, minRelayerFee)
+	}
+
+	return minRelayerFees
+}
+
 // GetPayeeAddress retrieves the fee payee address stored in state given the provided channel identifier and relayer address
 func (k Keeper) GetPayeeAddress(ctx sdk.Context, relayerAddr, channelID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -166,7 +232,40 @@ func (k Keeper) SetPayeeAddress(ctx sdk.Context, relayerAddr, payeeAddr, channel
 	store.Set(types.KeyPayee(relayerAddr, channelID), []byte(payeeAddr))
 }
 
-// GetAllPayees returns all registered payees addresses
+// DeletePayeeAddress removes the fee payee address stored in state for the provided channel identifier and relayer address
+func (k Keeper) DeletePayeeAddress(ctx sdk.Context, relayerAddr, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPayee(relayerAddr, channelID))
+}
+
+// GetWeightedPayees retrieves the weighted payees between which fees are split for the given relayer address and channel identifier
+func (k Keeper) GetWeightedPayees(ctx sdk.Context, relayerAddr, channelID string) ([]types.WeightedPayee, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyWeightedPayees(relayerAddr, channelID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var weightedPayees types.WeightedPayees
+	k.cdc.MustUnmarshal(bz, &weightedPayees)
+
+	return weightedPayees.Payees, true
+}
+
+// SetWeightedPayees stores the weighted payees in state keyed by the provided channel identifier and relayer address
+func (k Keeper) SetWeightedPayees(ctx sdk.Context, relayerAddr, channelID string, payees []types.WeightedPayee) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.WeightedPayees{Payees: payees})
+	store.Set(types.KeyWeightedPayees(relayerAddr, channelID), bz)
+}
+
+// DeleteWeightedPayees removes the weighted payees stored in state for the provided channel identifier and relayer address
+func (k Keeper) DeleteWeightedPayees(ctx sdk.Context, relayerAddr, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyWeightedPayees(relayerAddr, channelID))
+}
+
+// GetAllPayees returns all registered payees addresses, followed by all registered weighted payees
 func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PayeeKeyPrefix))
@@ -188,9 +287,50 @@ func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
 		registeredPayees = append(registeredPayees, payee)
 	}
 
+	weightedIterator := sdk.KVStorePrefixIterator(store, []byte(types.WeightedPayeeKeyPrefix))
+	defer weightedIterator.Close()
+
+	for ; weightedIterator.Valid(); weightedIterator.Next() {
+		relayerAddr, channelID, err := types.ParseKeyWeightedPayees(string(weightedIterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var weightedPayees types.WeightedPayees
+		k.cdc.MustUnmarshal(weightedIterator.Value(), &weightedPayees)
+
+		payee := types.RegisteredPayee{
+			Relayer:   relayerAddr,
+			Payees:    weightedPayees.Payees,
+			ChannelId: channelID,
+		}
+
+		registeredPayees = append(registeredPayees, payee)
+	}
+
 	return registeredPayees
 }
 
+// GetFeeRecipients returns the weighted payees to which reverse and timeout relayer fees are paid out for the given
+// relayer on the provided channel. If weighted payees are registered they are returned, otherwise the registered payee
+// address or the relayer itself receives the full fee.
+func (k Keeper) GetFeeRecipients(ctx sdk.Context, relayer sdk.AccAddress, channelID string) ([]types.WeightedPayee, error) {
+	if payees, found := k.GetWeightedPayees(ctx, relayer.String(), channelID); found {
+		return payees, nil
+	}
+
+	payee, found := k.GetPayeeAddress(ctx, relayer.String(), channelID)
+	if !found {
+		return []types.WeightedPayee{types.NewWeightedPayee(relayer.String(), types.TotalPayeeWeight)}, nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(payee); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+	}
+
+	return []types.WeightedPayee{types.NewWeightedPayee(payee, types.TotalPayeeWeight)}, nil
+}
+
 // SetCounterpartyPayeeAddress maps the destination chain counterparty payee address to the source relayer address
 // The receiving chain must store the mapping from: address -> counterpartyPayeeAddress for the given channel
 func (k Keeper) SetCounterpartyPayeeAddress(ctx sdk.Context, address, counterpartyAddress, channelID string) {
@@ -236,6 +376,56 @@ func (k Keeper) GetAllCounterpartyPayees(ctx sdk.Context) []types.RegisteredCoun
 	return registeredCounterpartyPayees
 }
 
+// GetRelayerStats retrieves the accumulated earnings of the relayer on the given channel
+func (k Keeper) GetRelayerStats(ctx sdk.Context, relayerAddr, channelID string) (types.RelayerStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRelayerStats(relayerAddr, channelID))
+	if bz == nil {
+		return types.RelayerStats{}, false
+	}
+
+	var relayerStats types.RelayerStats
+	k.cdc.MustUnmarshal(bz, &relayerStats)
+
+	return relayerStats, true
+}
+
+// SetRelayerStats stores the accumulated earnings of a relayer keyed by its relayer address and channel identifier
+func (k Keeper) SetRelayerStats(ctx sdk.Context, relayerStats types.RelayerStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&relayerStats)
+	store.Set(types.KeyRelayerStats(relayerStats.Relayer, relayerStats.ChannelId), bz)
+}
+
+// GetAllRelayerStats returns the accumulated earnings of all relayers on all channels
+func (k Keeper) GetAllRelayerStats(ctx sdk.Context) []types.RelayerStats {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.RelayerStatsKeyPrefix))
+	defer iterator.Close()
+
+	var allRelayerStats []types.RelayerStats
+	for ; iterator.Valid(); iterator.Next() {
+		var relayerStats types.RelayerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &relayerStats)
+
+		allRelayerStats = append(allRelayerStats, relayerStats)
+	}
+
+	return allRelayerStats
+}
+
+// updateRelayerStats applies the provided update to the accumulated earnings of the relayer on the given channel
+func (k Keeper) updateRelayerStats(ctx sdk.Context, relayerAddr, channelID string, update func(relayerStats *types.RelayerStats)) {
+	relayerStats, found := k.GetRelayerStats(ctx, relayerAddr, channelID)
+	if !found {
+		relayerStats = types.NewRelayerStats(relayerAddr, channelID)
+	}
+
+	update(&relayerStats)
+
+	k.SetRelayerStats(ctx, relayerStats)
+}
+
 // SetRelayerAddressForAsyncAck sets the forward relayer address during OnRecvPacket in case of async acknowledgement
 func (k Keeper) SetRelayerAddressForAsyncAck(ctx sdk.Context, packetID channeltypes.PacketId, address string) {
 	store := ctx.KVStore(k.storeKey)
@@ -305,20 +495,64 @@ func (k Keeper) HasFeesInEscrow(ctx sdk.Context, packetID channeltypes.PacketId)
 	return store.Has(key)
 }
 
-// SetFeesInEscrow sets the given packet fees in escrow keyed by the packetID
+// SetFeesInEscrow sets the given packet fees in escrow keyed by the packetID, replacing any packet fees previously
+// escrowed for the packetID. The packet fees which have an expiry set are indexed by their expiry height and timestamp.
+// As the expiry index is keyed by packetID, the index entries of the replaced packet fees are removed before the
+// given packet fees are indexed, such that an entry shared by several packet fees is kept while any of them remains.
 func (k Keeper) SetFeesInEscrow(ctx sdk.Context, packetID channeltypes.PacketId, fees types.PacketFees) {
+	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+		for _, packetFee := range feesInEscrow.PacketFees {
+			k.deletePacketFeeExpiry(ctx, packetID, packetFee)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.MustMarshalFees(fees)
 	store.Set(types.KeyFeesInEscrow(packetID), bz)
+
+	for _, packetFee := range fees.PacketFees {
+		k.setPacketFeeExpiry(ctx, packetID, packetFee)
+	}
 }
 
-// DeleteFeesInEscrow deletes the fee associated with the given packetID
+// DeleteFeesInEscrow deletes the fee associated with the given packetID along with any expiry index entries
 func (k Keeper) DeleteFeesInEscrow(ctx sdk.Context, packetID channeltypes.PacketId) {
+	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+		for _, packetFee := range feesInEscrow.PacketFees {
+			k.deletePacketFeeExpiry(ctx, packetID, packetFee)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.KeyFeesInEscrow(packetID)
 	store.Delete(key)
 }
 
+// setPacketFeeExpiry indexes the given packet fee by its expiry height and expiry timestamp, if set
+func (k Keeper) setPacketFeeExpiry(ctx sdk.Context, packetID channeltypes.PacketId, packetFee types.PacketFee) {
+	store := ctx.KVStore(k.storeKey)
+	if packetFee.ExpiryHeight != 0 {
+		store.Set(types.KeyExpiryHeight(packetFee.ExpiryHeight, packetID), []byte{1})
+	}
+
+	if packetFee.ExpiryTimestamp != 0 {
+		store.Set(types.KeyExpiryTimestamp(packetFee.ExpiryTimestamp, packetID), []byte{1})
+	}
+}
+
+// deletePacketFeeExpiry removes the expiry height and expiry timestamp index entries of the given packet fee.
+// The index entries may be shared with other packet fees escrowed for the packetID which must be re-indexed.
+func (k Keeper) deletePacketFeeExpiry(ctx sdk.Context, packetID channeltypes.PacketId, packetFee types.PacketFee) {
+	store := ctx.KVStore(k.storeKey)
+	if packetFee.ExpiryHeight != 0 {
+		store.Delete(types.KeyExpiryHeight(packetFee.ExpiryHeight, packetID))
+	}
+
+	if packetFee.ExpiryTimestamp != 0 {
+		store.Delete(types.KeyExpiryTimestamp(packetFee.ExpiryTimestamp, packetID))
+	}
+}
+
 // GetIdentifiedPacketFeesForChannel returns all the currently escrowed fees on a given channel.
 func (k Keeper) GetIdentifiedPacketFeesForChannel(ctx sdk.Context, portID, channelID string) []types.IdentifiedPacketFees {
 	var identifiedPacketFees []types.IdentifiedPacketFees
@@ -368,6 +602,79 @@ func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPa
 	return identifiedFees
 }
 
+// GetHandshakeBounties returns the handshake bounties escrowed for the given port and channel identifiers
+func (k Keeper) GetHandshakeBounties(ctx sdk.Context, portID, channelID string) (types.HandshakeBounties, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyHandshakeBounties(portID, channelID))
+	if bz == nil {
+		return types.HandshakeBounties{}, false
+	}
+
+	var handshakeBounties types.HandshakeBounties
+	k.cdc.MustUnmarshal(bz, &handshakeBounties)
+
+	return handshakeBounties, true
+}
+
+// SetHandshakeBounties stores the handshake bounties escrowed for the given port and channel identifiers.
+// Each handshake bounty is indexed by its expiry height and expiry timestamp.
+func (k Keeper) SetHandshakeBounties(ctx sdk.Context, portID, channelID string, handshakeBounties types.HandshakeBounties) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&handshakeBounties)
+	store.Set(types.KeyHandshakeBounties(portID, channelID), bz)
+
+	for _, handshakeBounty := range handshakeBounties.HandshakeBounties {
+		if handshakeBounty.ExpiryHeight != 0 {
+			store.Set(types.KeyBountyExpiryHeight(handshakeBounty.ExpiryHeight, portID, channelID), []byte{1})
+		}
+
+		if handshakeBounty.ExpiryTimestamp != 0 {
+			store.Set(types.KeyBountyExpiryTimestamp(handshakeBounty.ExpiryTimestamp, portID, channelID), []byte{1})
+		}
+	}
+}
+
+// DeleteHandshakeBounties deletes the handshake bounties escrowed for the given port and channel identifiers
+// along with any expiry index entries
+func (k Keeper) DeleteHandshakeBounties(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	if handshakeBounties, found := k.GetHandshakeBounties(ctx, portID, channelID); found {
+		for _, handshakeBounty := range handshakeBounties.HandshakeBounties {
+			if handshakeBounty.ExpiryHeight != 0 {
+				store.Delete(types.KeyBountyExpiryHeight(handshakeBounty.ExpiryHeight, portID, channelID))
+			}
+
+			if handshakeBounty.ExpiryTimestamp != 0 {
+				store.Delete(types.KeyBountyExpiryTimestamp(handshakeBounty.ExpiryTimestamp, portID, channelID))
+			}
+		}
+	}
+
+	store.Delete(types.KeyHandshakeBounties(portID, channelID))
+}
+
+// GetAllHandshakeBounties returns a list of all handshake bounties stored in state along with their port and channel identifiers
+func (k Keeper) GetAllHandshakeBounties(ctx sdk.Context) []types.IdentifiedHandshakeBounties {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.HandshakeBountyKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var identifiedBounties []types.IdentifiedHandshakeBounties
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyHandshakeBounties(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var handshakeBounties types.HandshakeBounties
+		k.cdc.MustUnmarshal(iterator.Value(), &handshakeBounties)
+
+		identifiedBounties = append(identifiedBounties, types.NewIdentifiedHandshakeBounties(portID, channelID, handshakeBounties.HandshakeBounties))
+	}
+
+	return identifiedBounties
+}
+
 // MustMarshalFees attempts to encode a Fee object and returns the
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustMarshalFees(fees types.PacketFees) []byte {
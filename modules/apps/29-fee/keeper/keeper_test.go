@@ -110,6 +110,68 @@ func (suite *KeeperTestSuite) TestGetSetPayeeAddress() {
 	suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), payeeAddr)
 }
 
+func (suite *KeeperTestSuite) TestGetSetWeightedPayees() {
+	suite.coordinator.Setup(suite.path)
+
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+
+	payees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().False(found)
+	suite.Require().Empty(payees)
+
+	expPayees := []types.WeightedPayee{
+		types.NewWeightedPayee(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), 8000),
+		types.NewWeightedPayee(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), 2000),
+	}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID, expPayees)
+
+	payees, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(expPayees, payees)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetFeeRecipients() {
+	suite.coordinator.Setup(suite.path)
+
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	payeeAddr := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	// no registered payee: the relayer receives the full fee
+	payees, err := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeRecipients(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.WeightedPayee{types.NewWeightedPayee(relayer.String(), types.TotalPayeeWeight)}, payees)
+
+	// registered payee address: the payee receives the full fee
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer.String(), payeeAddr, suite.path.EndpointA.ChannelID)
+
+	payees, err = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeRecipients(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.WeightedPayee{types.NewWeightedPayee(payeeAddr, types.TotalPayeeWeight)}, payees)
+
+	// registered weighted payees take precedence
+	expPayees := []types.WeightedPayee{
+		types.NewWeightedPayee(payeeAddr, 9000),
+		types.NewWeightedPayee(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), 1000),
+	}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetWeightedPayees(suite.chainA.GetContext(), relayer.String(), suite.path.EndpointA.ChannelID, expPayees)
+
+	payees, err = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeRecipients(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPayees, payees)
+
+	// invalid registered payee address
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteWeightedPayees(suite.chainA.GetContext(), relayer.String(), suite.path.EndpointA.ChannelID)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer.String(), "invalid-address", suite.path.EndpointA.ChannelID)
+
+	_, err = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeRecipients(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestFeesInEscrow() {
 	suite.coordinator.Setup(suite.path)
 
@@ -133,6 +195,39 @@ func (suite *KeeperTestSuite) TestFeesInEscrow() {
 	suite.Require().False(hasFeesInEscrow)
 }
 
+func (suite *KeeperTestSuite) TestFeesInEscrowExpiryIndex() {
+	suite.coordinator.Setup(suite.path)
+
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+	packetFee.ExpiryHeight = 100
+	packetFee.ExpiryTimestamp = 1000
+
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+
+	// packet fees with an expiry are indexed by their expiry height and expiry timestamp
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+	suite.Require().True(store.Has(types.KeyExpiryHeight(100, packetID)))
+	suite.Require().True(store.Has(types.KeyExpiryTimestamp(1000, packetID)))
+
+	// replacing the fees in escrow keeps the index entries shared with the remaining packet fees
+	otherPacketFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+	otherPacketFee.ExpiryHeight = 100
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees([]types.PacketFee{packetFee, otherPacketFee}))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees([]types.PacketFee{otherPacketFee}))
+	suite.Require().True(store.Has(types.KeyExpiryHeight(100, packetID)))
+	suite.Require().False(store.Has(types.KeyExpiryTimestamp(1000, packetID)))
+
+	// deleting the fees in escrow removes the expiry index entries
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(ctx, packetID)
+	suite.Require().False(store.Has(types.KeyExpiryHeight(100, packetID)))
+	suite.Require().False(store.Has(types.KeyExpiryTimestamp(1000, packetID)))
+}
+
 func (suite *KeeperTestSuite) TestIsLocked() {
 	ctx := suite.chainA.GetContext()
 	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
@@ -278,6 +373,23 @@ func (suite *KeeperTestSuite) TestGetAllPayees() {
 		expectedPayees = append(expectedPayees, registeredPayee)
 	}
 
+	weightedPayees := []types.WeightedPayee{
+		types.NewWeightedPayee(suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(), 5000),
+		types.NewWeightedPayee(suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), 5000),
+	}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetWeightedPayees(
+		suite.chainA.GetContext(),
+		suite.chainA.SenderAccounts[3].SenderAccount.GetAddress().String(),
+		ibctesting.FirstChannelID,
+		weightedPayees,
+	)
+
+	expectedPayees = append(expectedPayees, types.RegisteredPayee{
+		Relayer:   suite.chainA.SenderAccounts[3].SenderAccount.GetAddress().String(),
+		Payees:    weightedPayees,
+		ChannelId: ibctesting.FirstChannelID,
+	})
+
 	registeredPayees := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllPayees(suite.chainA.GetContext())
 	suite.Require().Len(registeredPayees, len(expectedPayees))
 	suite.Require().ElementsMatch(expectedPayees, registeredPayees)
@@ -301,3 +413,46 @@ func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
 	suite.Require().Len(counterpartyPayeeAddr, len(expectedCounterpartyPayee))
 	suite.Require().Equal(counterpartyPayeeAddr, expectedCounterpartyPayee)
 }
+
+func (suite *KeeperTestSuite) TestGetAllRelayerStats() {
+	var expectedRelayerStats []types.RelayerStats
+	for i := 0; i < 3; i++ {
+		relayerStats := types.NewRelayerStats(suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+		relayerStats.RecvFees = defaultRecvFee
+		relayerStats.RecvPackets = uint64(i + 1)
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+		expectedRelayerStats = append(expectedRelayerStats, relayerStats)
+	}
+
+	allRelayerStats := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllRelayerStats(suite.chainA.GetContext())
+	suite.Require().Len(allRelayerStats, len(expectedRelayerStats))
+	suite.Require().ElementsMatch(expectedRelayerStats, allRelayerStats)
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(), "channel-100")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetAllMinRelayerFees() {
+	var expectedMinRelayerFees []types.MinRelayerFee
+	for i := 0; i < 3; i++ {
+		channelID := channeltypes.FormatChannelIdentifier(uint64(i))
+		minFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), ibctesting.MockFeePort, channelID, minFee)
+
+		expectedMinRelayerFees = append(expectedMinRelayerFees, types.MinRelayerFee{
+			PortId:    ibctesting.MockFeePort,
+			ChannelId: channelID,
+			MinFee:    minFee,
+		})
+	}
+
+	minRelayerFees := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllMinRelayerFees(suite.chainA.GetContext())
+	suite.Require().Len(minRelayerFees, len(expectedMinRelayerFees))
+	suite.Require().ElementsMatch(expectedMinRelayerFees, minRelayerFees)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteMinRelayerFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetMinRelayerFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
@@ -14,19 +15,26 @@ var _ types.MsgServer = Keeper{}
 
 // RegisterPayee defines a rpc handler method for MsgRegisterPayee
 // RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
-// payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
-// the source chain from which packets originate as this is where fee distribution takes place. This function may be
-// called more than once by a relayer, in which case, the latest payee is always used.
+// payee, or a list of weighted payees, to which reverse and timeout relayer packet fees will be paid out. The payee should
+// be registered on the source chain from which packets originate as this is where fee distribution takes place. This function
+// may be called more than once by a relayer, in which case, the latest payee registration replaces any previous one.
 func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee) (*types.MsgRegisterPayeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	payee, err := sdk.AccAddressFromBech32(msg.Payee)
-	if err != nil {
-		return nil, err
+	payees := msg.Payees
+	if len(payees) == 0 {
+		payees = []types.WeightedPayee{types.NewWeightedPayee(msg.Payee, types.TotalPayeeWeight)}
 	}
 
-	if k.bankKeeper.BlockedAddr(payee) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
+	for _, weightedPayee := range payees {
+		payee, err := sdk.AccAddressFromBech32(weightedPayee.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.bankKeeper.BlockedAddr(payee) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
+		}
 	}
 
 	// only register payee address if the channel exists and is fee enabled
@@ -38,6 +46,20 @@ func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee
 		return nil, types.ErrFeeNotEnabled
 	}
 
+	if len(msg.Payees) != 0 {
+		k.DeletePayeeAddress(ctx, msg.Relayer, msg.ChannelId)
+		k.SetWeightedPayees(ctx, msg.Relayer, msg.ChannelId, msg.Payees)
+
+		weightedPayees := formatWeightedPayees(msg.Payees)
+
+		k.Logger(ctx).Info("registering weighted payees for relayer", "relayer", msg.Relayer, "payees", weightedPayees, "channel", msg.ChannelId)
+
+		EmitRegisterPayeeEvent(ctx, msg.Relayer, weightedPayees, msg.ChannelId)
+
+		return &types.MsgRegisterPayeeResponse{}, nil
+	}
+
+	k.DeleteWeightedPayees(ctx, msg.Relayer, msg.ChannelId)
 	k.SetPayeeAddress(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
 
 	k.Logger(ctx).Info("registering payee address for relayer", "relayer", msg.Relayer, "payee", msg.Payee, "channel", msg.ChannelId)
@@ -137,6 +159,10 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
 	}
 
+	if msg.PacketFee.IsExpired(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UnixNano())) {
+		return nil, sdkerrors.Wrapf(types.ErrPacketFeeExpired, "expiry height: %d, expiry timestamp: %d", msg.PacketFee.ExpiryHeight, msg.PacketFee.ExpiryTimestamp)
+	}
+
 	nextSeqSend, found := k.GetNextSequenceSend(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId)
 	if !found {
 		return nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "channel does not exist, portID: %s, channelID: %s", msg.PacketId.PortId, msg.PacketId.ChannelId)
@@ -158,3 +184,105 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// UpdateMinRelayerFee defines a rpc handler method for MsgUpdateMinRelayerFee
+// UpdateMinRelayerFee sets the minimum fee which must be escrowed for each packet sent on a fee enabled channel.
+// This method may only be executed by the module authority, typically the x/gov module account.
+func (k Keeper) UpdateMinRelayerFee(goCtx context.Context, msg *types.MsgUpdateMinRelayerFee) (*types.MsgUpdateMinRelayerFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// only set minimum relayer fees if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	k.SetMinRelayerFee(ctx, msg.PortId, msg.ChannelId, msg.MinFee)
+
+	k.Logger(ctx).Info("minimum relayer fee updated", "port", msg.PortId, "channel", msg.ChannelId, "min fee", msg.MinFee)
+
+	EmitMinRelayerFeeEvent(ctx, types.EventTypeUpdateMinRelayerFee, msg.PortId, msg.ChannelId, msg.MinFee)
+
+	return &types.MsgUpdateMinRelayerFeeResponse{}, nil
+}
+
+// RemoveMinRelayerFee defines a rpc handler method for MsgRemoveMinRelayerFee
+// RemoveMinRelayerFee removes the minimum fee requirement of a fee enabled channel.
+// This method may only be executed by the module authority, typically the x/gov module account.
+func (k Keeper) RemoveMinRelayerFee(goCtx context.Context, msg *types.MsgRemoveMinRelayerFee) (*types.MsgRemoveMinRelayerFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	minFee, found := k.GetMinRelayerFee(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrMinRelayerFeeNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	k.DeleteMinRelayerFee(ctx, msg.PortId, msg.ChannelId)
+
+	k.Logger(ctx).Info("minimum relayer fee removed", "port", msg.PortId, "channel", msg.ChannelId)
+
+	EmitMinRelayerFeeEvent(ctx, types.EventTypeRemoveMinRelayerFee, msg.PortId, msg.ChannelId, minFee)
+
+	return &types.MsgRemoveMinRelayerFeeResponse{}, nil
+}
+
+// PayHandshakeBounty defines a rpc handler method for MsgPayHandshakeBounty
+// PayHandshakeBounty is an open callback that may be called by any module/user that wishes to escrow funds in order to
+// incentivize relayers to complete the opening handshake of a channel in the INIT state. The bounty is paid out to the
+// relayer which submits the channel open acknowledgement, or refunded once it expires.
+func (k Keeper) PayHandshakeBounty(goCtx context.Context, msg *types.MsgPayHandshakeBounty) (*types.MsgPayHandshakeBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	refundAcc, err := sdk.AccAddressFromBech32(msg.HandshakeBounty.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(refundAcc) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to escrow handshake bounties", refundAcc)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	// only allow incentivizing of channels which are awaiting the counterparty handshake steps
+	if channel.State != channeltypes.INIT {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "expected channel state to be %s, got %s", channeltypes.INIT, channel.State)
+	}
+
+	if msg.HandshakeBounty.IsExpired(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UnixNano())) {
+		return nil, sdkerrors.Wrapf(types.ErrHandshakeBountyExpired, "expiry height: %d, expiry timestamp: %d", msg.HandshakeBounty.ExpiryHeight, msg.HandshakeBounty.ExpiryTimestamp)
+	}
+
+	if err := k.escrowHandshakeBounty(ctx, msg.PortId, msg.ChannelId, msg.HandshakeBounty); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayHandshakeBountyResponse{}, nil
+}
+
+// validateAuthority returns an error if the provided address is not the module authority
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
@@ -87,6 +89,91 @@ func (suite *KeeperTestSuite) TestRegisterPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterWeightedPayees() {
+	var (
+		msg     *types.MsgRegisterPayee
+		relayer string
+	)
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"success: replaces previously registered payee address",
+			true,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"channel is not fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"payee is a blocked address",
+			false,
+			func() {
+				msg.Payees[1].Address = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path)
+
+		relayer = suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+		msg = types.NewMsgRegisterWeightedPayees(
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			relayer,
+			[]types.WeightedPayee{
+				types.NewWeightedPayee(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), 7000),
+				types.NewWeightedPayee(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), 3000),
+			},
+		)
+
+		tc.malleate()
+
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterPayee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			payees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.Payees, payees)
+
+			_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+			suite.Require().False(found)
+
+			// registering a single payee address replaces the weighted payees
+			payeeAddr := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.RegisterPayee(
+				sdk.WrapSDKContext(suite.chainA.GetContext()),
+				types.NewMsgRegisterPayee(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, relayer, payeeAddr),
+			)
+			suite.Require().NoError(err)
+
+			_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetWeightedPayees(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+			suite.Require().False(found)
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
 	var (
 		msg                  *types.MsgRegisterCounterpartyPayee
@@ -347,6 +434,31 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 			},
 			true,
 		},
+		{
+			"success with expiry height and expiry timestamp",
+			func() {
+				ctx := suite.chainA.GetContext()
+				msg.PacketFee.ExpiryHeight = uint64(ctx.BlockHeight()) + 10
+				msg.PacketFee.ExpiryTimestamp = uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+
+				expFeesInEscrow[0] = msg.PacketFee
+			},
+			true,
+		},
+		{
+			"packet fee expiry height has been reached",
+			func() {
+				msg.PacketFee.ExpiryHeight = uint64(suite.chainA.GetContext().BlockHeight())
+			},
+			false,
+		},
+		{
+			"packet fee expiry timestamp has been reached",
+			func() {
+				msg.PacketFee.ExpiryTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			},
+			false,
+		},
 		{
 			"fee module is locked",
 			func() {
@@ -496,3 +608,250 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateMinRelayerFee() {
+	var msg *types.MsgUpdateMinRelayerFee
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"success: overwrite existing minimum relayer fee",
+			true,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, types.NewFee(defaultRecvFee, nil, nil))
+			},
+		},
+		{
+			"invalid authority",
+			false,
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+		},
+		{
+			"channel does not exist",
+			false,
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+		},
+		{
+			"channel is not fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path)
+
+		msg = types.NewMsgUpdateMinRelayerFee(
+			suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(),
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+		)
+
+		tc.malleate()
+
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateMinRelayerFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		minFee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetMinRelayerFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().NotNil(res)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.MinFee, minFee)
+		} else {
+			suite.Require().Error(err, tc.name)
+			suite.Require().False(found)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveMinRelayerFee() {
+	var msg *types.MsgRemoveMinRelayerFee
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"invalid authority",
+			false,
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+		},
+		{
+			"minimum relayer fee not found",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteMinRelayerFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path)
+
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(
+			suite.chainA.GetContext(),
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+		)
+
+		msg = types.NewMsgRemoveMinRelayerFee(
+			suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(),
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+		)
+
+		tc.malleate()
+
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RemoveMinRelayerFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().NotNil(res)
+
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetMinRelayerFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+			suite.Require().False(found)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayHandshakeBounty() {
+	var msg *types.MsgPayHandshakeBounty
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"success: multiple bounties escrowed for the same channel",
+			true,
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"fee module is locked",
+			false,
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+		},
+		{
+			"refund account is blocked module account",
+			false,
+			func() {
+				msg.HandshakeBounty.RefundAddress = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress().String()
+			},
+		},
+		{
+			"refund account does not exist",
+			false,
+			func() {
+				msg.HandshakeBounty.RefundAddress = suite.chainB.SenderAccount.GetAddress().String()
+			},
+		},
+		{
+			"channel does not exist",
+			false,
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+		},
+		{
+			"channel is not in INIT state",
+			false,
+			func() {
+				channel := suite.path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, channel)
+			},
+		},
+		{
+			"handshake bounty has expired",
+			false,
+			func() {
+				msg.HandshakeBounty.ExpiryHeight = uint64(suite.chainA.GetContext().BlockHeight())
+			},
+		},
+		{
+			"bank send failure: insufficient funds",
+			false,
+			func() {
+				msg.HandshakeBounty.Bounty = sdk.NewCoins(sdk.NewCoin("unknowndenom", sdk.NewInt(100)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			err := suite.path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			handshakeBounty := types.NewHandshakeBounty(defaultRecvFee, suite.chainA.SenderAccount.GetAddress().String(), uint64(suite.chainA.GetContext().BlockHeight())+100, 0)
+			msg = types.NewMsgPayHandshakeBounty(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, handshakeBounty)
+
+			tc.malleate()
+
+			// store the existing bounties prior to paying the handshake bounty
+			expBounties := []types.HandshakeBounty{msg.HandshakeBounty}
+			if handshakeBounties, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID); found {
+				expBounties = append(expBounties, handshakeBounties.HandshakeBounties...)
+			}
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			handshakeBounties, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().True(found)
+				suite.Require().Equal(expBounties, handshakeBounties.HandshakeBounties)
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(types.NewHandshakeBounties(expBounties).Total().AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
@@ -13,10 +13,48 @@ import (
 )
 
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
+// If a minimum relayer fee is required on the fee enabled sending channel, the packet is only sent if sufficient
+// fees have been escrowed for its sequence.
 func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if k.IsFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		if err := k.checkMinRelayerFee(ctx, packet); err != nil {
+			return err
+		}
+	}
+
 	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
 }
 
+// checkMinRelayerFee returns an error if the total fees escrowed for the provided packet do not satisfy the minimum
+// relayer fee required on its sending channel. Packet fees with an expiry may be refunded before the packet is
+// relayed and therefore do not count towards the minimum relayer fee.
+func (k Keeper) checkMinRelayerFee(ctx sdk.Context, packet ibcexported.PacketI) error {
+	minFee, found := k.GetMinRelayerFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return nil
+	}
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	feesInEscrow, _ := k.GetFeesInEscrow(ctx, packetID)
+
+	var nonExpiringFees []types.PacketFee
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.ExpiryHeight == 0 && packetFee.ExpiryTimestamp == 0 {
+			nonExpiringFees = append(nonExpiringFees, packetFee)
+		}
+	}
+
+	if totalFee := types.NewPacketFees(nonExpiringFees).TotalFee(); !totalFee.IsAllGTE(minFee) {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientRelayerFee, "escrowed fee (recv: %s, ack: %s, timeout: %s) does not satisfy the minimum relayer fee (recv: %s, ack: %s, timeout: %s) for packet with portID: %s, channelID: %s, sequence: %d",
+			totalFee.RecvFee, totalFee.AckFee, totalFee.TimeoutFee, minFee.RecvFee, minFee.AckFee, minFee.TimeoutFee, packetID.PortId, packetID.ChannelId, packetID.Sequence,
+		)
+	}
+
+	return nil
+}
+
 // WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
 // ICS29 WriteAcknowledgement is used for asynchronous acknowledgements
 func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
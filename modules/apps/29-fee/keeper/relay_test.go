@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
@@ -170,3 +174,132 @@ func (suite *KeeperTestSuite) TestGetAppVersion() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSendPacketMinRelayerFee() {
+	var packetFees []types.PacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: escrowed fee equals the minimum relayer fee",
+			func() {},
+			true,
+		},
+		{
+			"success: escrowed fees of multiple packet fees satisfy the minimum relayer fee",
+			func() {
+				minFee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minFee)
+
+				packetFees = append(packetFees, packetFees[0])
+			},
+			true,
+		},
+		{
+			"success: escrowed fees without an expiry satisfy the minimum relayer fee",
+			func() {
+				expiringPacketFee := packetFees[0]
+				expiringPacketFee.ExpiryTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+
+				packetFees = append(packetFees, expiringPacketFee)
+			},
+			true,
+		},
+		{
+			"success: minimum relayer fee not set",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				packetFees = nil
+			},
+			true,
+		},
+		{
+			"success: channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				packetFees = nil
+			},
+			true,
+		},
+		{
+			"no fees escrowed for packet",
+			func() {
+				packetFees = nil
+			},
+			false,
+		},
+		{
+			"escrowed fee with an expiry does not count towards the minimum relayer fee",
+			func() {
+				packetFees[0].ExpiryHeight = uint64(suite.chainA.GetContext().BlockHeight()) + 1
+			},
+			false,
+		},
+		{
+			"escrowed recv fee is insufficient",
+			func() {
+				minFee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minFee)
+			},
+			false,
+		},
+		{
+			"escrowed timeout fee is paid in a different denomination",
+			func() {
+				minFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee.Add(sdk.NewCoin("atom", sdk.NewInt(100))))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minFee)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFees = []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)}
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetMinRelayerFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee)
+
+			tc.malleate()
+
+			sequence, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			if len(packetFees) > 0 {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+			}
+
+			packet := channeltypes.NewPacket(
+				ibctesting.MockPacketData,
+				sequence,
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.path.EndpointB.ChannelConfig.PortID,
+				suite.path.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+
+			chanCap := suite.chainA.GetChannelCapability(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.SendPacket(suite.chainA.GetContext(), chanCap, packet)
+
+			commitment := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), sequence)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInsufficientRelayerFee)
+				suite.Require().Empty(commitment)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// MaxExpiredHandshakeBountiesPerBlock is the maximum number of expiry index entries processed in a single block
+// when refunding expired handshake bounties
+const MaxExpiredHandshakeBountiesPerBlock = 100
+
+// NewHandshakeBounty creates and returns a new HandshakeBounty struct including the bounty, refund address and expiry
+func NewHandshakeBounty(bounty sdk.Coins, refundAddr string, expiryHeight, expiryTimestamp uint64) HandshakeBounty {
+	return HandshakeBounty{
+		Bounty:          bounty,
+		RefundAddress:   refundAddr,
+		ExpiryHeight:    expiryHeight,
+		ExpiryTimestamp: expiryTimestamp,
+	}
+}
+
+// Validate performs basic stateless validation of the associated HandshakeBounty
+func (hb HandshakeBounty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(hb.RefundAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
+	}
+
+	if !hb.Bounty.IsValid() || hb.Bounty.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bounty must be a valid non-zero amount: %s", hb.Bounty)
+	}
+
+	// a bounty without an expiry could never be refunded if the channel is not opened
+	if hb.ExpiryHeight == 0 && hb.ExpiryTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidHandshakeBounty, "expiry height or expiry timestamp must be set")
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the HandshakeBounty has expired at the provided block height or block time (in unix nanoseconds).
+// A HandshakeBounty expires once either the expiry height or the expiry timestamp has been reached.
+func (hb HandshakeBounty) IsExpired(height, timestamp uint64) bool {
+	if hb.ExpiryHeight != 0 && height >= hb.ExpiryHeight {
+		return true
+	}
+
+	return hb.ExpiryTimestamp != 0 && timestamp >= hb.ExpiryTimestamp
+}
+
+// NewHandshakeBounties creates and returns a new HandshakeBounties struct including a list of type HandshakeBounty
+func NewHandshakeBounties(handshakeBounties []HandshakeBounty) HandshakeBounties {
+	return HandshakeBounties{
+		HandshakeBounties: handshakeBounties,
+	}
+}
+
+// Total returns the sum of all handshake bounties
+func (hb HandshakeBounties) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, handshakeBounty := range hb.HandshakeBounties {
+		total = total.Add(handshakeBounty.Bounty...)
+	}
+
+	return total
+}
+
+// NewIdentifiedHandshakeBounties creates and returns a new IdentifiedHandshakeBounties struct containing the port and
+// channel identifiers and a list of handshake bounties
+func NewIdentifiedHandshakeBounties(portID, channelID string, handshakeBounties []HandshakeBounty) IdentifiedHandshakeBounties {
+	return IdentifiedHandshakeBounties{
+		PortId:            portID,
+		ChannelId:         channelID,
+		HandshakeBounties: handshakeBounties,
+	}
+}
+
+// Validate performs basic stateless validation of the associated IdentifiedHandshakeBounties
+func (ihb IdentifiedHandshakeBounties) Validate() error {
+	if err := host.PortIdentifierValidator(ihb.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(ihb.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+
+	for _, handshakeBounty := range ihb.HandshakeBounties {
+		if err := handshakeBounty.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
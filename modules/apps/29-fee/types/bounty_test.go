@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+)
+
+func TestHandshakeBountyIsExpired(t *testing.T) {
+	handshakeBounty := types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress, 10, 0)
+	require.False(t, handshakeBounty.IsExpired(9, 100))
+	require.True(t, handshakeBounty.IsExpired(10, 0))
+
+	handshakeBounty = types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress, 0, 1000)
+	require.False(t, handshakeBounty.IsExpired(100, 999))
+	require.True(t, handshakeBounty.IsExpired(0, 1000))
+
+	// a handshake bounty expires once either the expiry height or the expiry timestamp is reached
+	handshakeBounty = types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress, 10, 1000)
+	require.True(t, handshakeBounty.IsExpired(10, 999))
+	require.True(t, handshakeBounty.IsExpired(9, 1000))
+	require.False(t, handshakeBounty.IsExpired(9, 999))
+}
+
+func TestHandshakeBountiesTotal(t *testing.T) {
+	handshakeBounties := types.NewHandshakeBounties([]types.HandshakeBounty{
+		types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress, 10, 0),
+		types.NewHandshakeBounty(defaultAckFee, defaultAccAddress, 10, 0),
+	})
+
+	require.Equal(t, sdk.NewInt(300), handshakeBounties.Total().AmountOf(sdk.DefaultBondDenom))
+	require.True(t, types.NewHandshakeBounties(nil).Total().IsZero())
+}
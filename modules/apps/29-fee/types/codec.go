@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync", nil)
 	cdc.RegisterConcrete(&MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee", nil)
 	cdc.RegisterConcrete(&MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee", nil)
+	cdc.RegisterConcrete(&MsgUpdateMinRelayerFee{}, "cosmos-sdk/MsgUpdateMinRelayerFee", nil)
+	cdc.RegisterConcrete(&MsgRemoveMinRelayerFee{}, "cosmos-sdk/MsgRemoveMinRelayerFee", nil)
+	cdc.RegisterConcrete(&MsgPayHandshakeBounty{}, "cosmos-sdk/MsgPayHandshakeBounty", nil)
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgUpdateMinRelayerFee{},
+		&MsgRemoveMinRelayerFee{},
+		&MsgPayHandshakeBounty{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
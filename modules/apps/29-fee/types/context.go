@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// handshakeRelayersContextKey is the context key under which the relayers submitting MsgChannelOpenAck are stored
+type handshakeRelayersContextKey struct{}
+
+// ContextWithHandshakeRelayer returns a copy of the provided context which records the relayer submitting
+// MsgChannelOpenAck for the given port and channel identifiers. The relayers are recorded by the
+// HandshakeBountyDecorator, such that handshake bounties can be paid out in the OnChanOpenAck callback.
+func ContextWithHandshakeRelayer(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress) sdk.Context {
+	relayers := make(map[string]sdk.AccAddress)
+	if existing, ok := ctx.Value(handshakeRelayersContextKey{}).(map[string]sdk.AccAddress); ok {
+		for channelPath, existingRelayer := range existing {
+			relayers[channelPath] = existingRelayer
+		}
+	}
+
+	relayers[host.ChannelPath(portID, channelID)] = relayer
+
+	return ctx.WithValue(handshakeRelayersContextKey{}, relayers)
+}
+
+// HandshakeRelayerFromContext returns the relayer submitting MsgChannelOpenAck for the given port and channel
+// identifiers and true, or false if no relayer has been recorded on the provided context.
+func HandshakeRelayerFromContext(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, bool) {
+	relayers, ok := ctx.Value(handshakeRelayersContextKey{}).(map[string]sdk.AccAddress)
+	if !ok {
+		return nil, false
+	}
+
+	relayer, found := relayers[host.ChannelPath(portID, channelID)]
+	if !found || relayer.Empty() {
+		return nil, false
+	}
+
+	return relayer, true
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func TestHandshakeRelayerFromContext(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	relayer, found := types.HandshakeRelayerFromContext(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.False(t, found)
+	require.Nil(t, relayer)
+
+	expRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx = types.ContextWithHandshakeRelayer(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID, expRelayer)
+
+	otherRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx = types.ContextWithHandshakeRelayer(ctx, ibctesting.MockFeePort, "channel-1", otherRelayer)
+
+	relayer, found = types.HandshakeRelayerFromContext(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.True(t, found)
+	require.Equal(t, expRelayer, relayer)
+
+	relayer, found = types.HandshakeRelayerFromContext(ctx, ibctesting.MockFeePort, "channel-1")
+	require.True(t, found)
+	require.Equal(t, otherRelayer, relayer)
+
+	// the relayer is recorded per channel
+	relayer, found = types.HandshakeRelayerFromContext(ctx, ibctesting.MockFeePort, "channel-2")
+	require.False(t, found)
+	require.Nil(t, relayer)
+}
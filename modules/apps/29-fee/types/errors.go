@@ -16,4 +16,11 @@ var (
 	ErrFeeNotEnabled                 = sdkerrors.Register(ModuleName, 9, "fee module is not enabled for this channel. If this error occurs after channel setup, fee module may not be enabled")
 	ErrRelayerNotFoundForAsyncAck    = sdkerrors.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = sdkerrors.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
+	ErrMinRelayerFeeNotFound         = sdkerrors.Register(ModuleName, 12, "minimum relayer fee not found")
+	ErrInsufficientRelayerFee        = sdkerrors.Register(ModuleName, 13, "insufficient relayer fee escrowed for packet")
+	ErrInvalidPayeeWeights           = sdkerrors.Register(ModuleName, 14, "invalid weighted payees")
+	ErrPacketFeeExpired              = sdkerrors.Register(ModuleName, 15, "packet fee has expired")
+	ErrInvalidHandshakeBounty        = sdkerrors.Register(ModuleName, 16, "invalid handshake bounty")
+	ErrHandshakeBountyExpired        = sdkerrors.Register(ModuleName, 17, "handshake bounty has expired")
+	ErrHandshakeBountyNotFound       = sdkerrors.Register(ModuleName, 18, "handshake bounty not found")
 )
@@ -5,12 +5,21 @@ const (
 	EventTypeIncentivizedPacket        = "incentivized_ibc_packet"
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
+	EventTypeUpdateMinRelayerFee       = "update_min_relayer_fee"
+	EventTypeRemoveMinRelayerFee       = "remove_min_relayer_fee"
+	EventTypeRefundExpiredPacketFee    = "refund_expired_packet_fee"
+	EventTypeIncentivizedHandshake     = "incentivized_channel_handshake"
+	EventTypeDistributeHandshakeBounty = "distribute_handshake_bounty"
+	EventTypeRefundHandshakeBounty     = "refund_handshake_bounty"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
 	AttributeKeyTimeoutFee        = "timeout_fee"
 	AttributeKeyChannelID         = "channel_id"
+	AttributeKeyPortID            = "port_id"
 	AttributeKeyRelayer           = "relayer"
 	AttributeKeyPayee             = "payee"
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
+	AttributeKeyRefundAddress     = "refund_address"
+	AttributeKeyBounty            = "bounty"
 )
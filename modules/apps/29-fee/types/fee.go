@@ -9,6 +9,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 )
 
+// MaxExpiredPacketFeesPerBlock is the maximum number of expiry index entries processed in a single block
+// when refunding expired packet fees
+const MaxExpiredPacketFeesPerBlock = 100
+
 // NewPacketFee creates and returns a new PacketFee struct including the incentivization fees, refund address and relayers
 func NewPacketFee(fee Fee, refundAddr string, relayers []string) PacketFee {
 	return PacketFee{
@@ -37,6 +41,16 @@ func (p PacketFee) Validate() error {
 	return nil
 }
 
+// IsExpired returns true if the PacketFee has expired at the provided block height or block time (in unix nanoseconds).
+// A PacketFee expires once either the expiry height or the expiry timestamp has been reached.
+func (p PacketFee) IsExpired(height, timestamp uint64) bool {
+	if p.ExpiryHeight != 0 && height >= p.ExpiryHeight {
+		return true
+	}
+
+	return p.ExpiryTimestamp != 0 && timestamp >= p.ExpiryTimestamp
+}
+
 // NewPacketFees creates and returns a new PacketFees struct including a list of type PacketFee
 func NewPacketFees(packetFees []PacketFee) PacketFees {
 	return PacketFees{
@@ -44,6 +58,18 @@ func NewPacketFees(packetFees []PacketFee) PacketFees {
 	}
 }
 
+// TotalFee returns the sum of the recv, ack and timeout fees of all packet fees
+func (p PacketFees) TotalFee() Fee {
+	var total Fee
+	for _, packetFee := range p.PacketFees {
+		total.RecvFee = total.RecvFee.Add(packetFee.Fee.RecvFee...)
+		total.AckFee = total.AckFee.Add(packetFee.Fee.AckFee...)
+		total.TimeoutFee = total.TimeoutFee.Add(packetFee.Fee.TimeoutFee...)
+	}
+
+	return total
+}
+
 // NewIdentifiedPacketFees creates and returns a new IdentifiedPacketFees struct containing a packet ID and packet fees
 func NewIdentifiedPacketFees(packetID channeltypes.PacketId, packetFees []PacketFee) IdentifiedPacketFees {
 	return IdentifiedPacketFees{
@@ -66,6 +92,12 @@ func (f Fee) Total() sdk.Coins {
 	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
 }
 
+// IsAllGTE returns true if each of the recv, ack and timeout fees is greater than or equal to the corresponding
+// fee of the provided Fee
+func (f Fee) IsAllGTE(other Fee) bool {
+	return f.RecvFee.IsAllGTE(other.RecvFee) && f.AckFee.IsAllGTE(other.AckFee) && f.TimeoutFee.IsAllGTE(other.TimeoutFee)
+}
+
 // Validate asserts that each Fee is valid and all three Fees are not empty or zero
 func (f Fee) Validate() error {
 	var errFees []string
@@ -98,6 +98,11 @@ type PacketFee struct {
 	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
 	// optional list of relayers permitted to receive fees
 	Relayers []string `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// optional block height at which the packet fee expires and is refunded to the refund address, zero means no expiry
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// optional block time (in unix nanoseconds) at which the packet fee expires and is refunded to the refund address,
+	// zero means no expiry
+	ExpiryTimestamp uint64 `protobuf:"varint,5,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty" yaml:"expiry_timestamp"`
 }
 
 func (m *PacketFee) Reset()         { *m = PacketFee{} }
@@ -154,6 +159,20 @@ func (m *PacketFee) GetRelayers() []string {
 	return nil
 }
 
+func (m *PacketFee) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PacketFee) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 // PacketFees contains a list of type PacketFee
 type PacketFees struct {
 	// list of packet fees
@@ -255,50 +274,357 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
+// WeightedPayee defines a payee address and the share of relayer fees it is entitled to, expressed in basis points
+type WeightedPayee struct {
+	// the payee address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the share of fees paid out to the payee in basis points (1/10000)
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedPayee) Reset()         { *m = WeightedPayee{} }
+func (m *WeightedPayee) String() string { return proto.CompactTextString(m) }
+func (*WeightedPayee) ProtoMessage()    {}
+func (*WeightedPayee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
+}
+func (m *WeightedPayee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedPayee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedPayee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedPayee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedPayee.Merge(m, src)
+}
+func (m *WeightedPayee) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedPayee) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedPayee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedPayee proto.InternalMessageInfo
+
+func (m *WeightedPayee) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WeightedPayee) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// WeightedPayees contains a list of type WeightedPayee
+type WeightedPayees struct {
+	// list of weighted payees
+	Payees []WeightedPayee `protobuf:"bytes,1,rep,name=payees,proto3" json:"payees"`
+}
+
+func (m *WeightedPayees) Reset()         { *m = WeightedPayees{} }
+func (m *WeightedPayees) String() string { return proto.CompactTextString(m) }
+func (*WeightedPayees) ProtoMessage()    {}
+func (*WeightedPayees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{5}
+}
+func (m *WeightedPayees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedPayees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedPayees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedPayees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedPayees.Merge(m, src)
+}
+func (m *WeightedPayees) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedPayees) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedPayees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedPayees proto.InternalMessageInfo
+
+func (m *WeightedPayees) GetPayees() []WeightedPayee {
+	if m != nil {
+		return m.Payees
+	}
+	return nil
+}
+
+// HandshakeBounty defines a bounty escrowed to incentivize relayers to complete the opening handshake of a channel
+type HandshakeBounty struct {
+	// the bounty paid out to the relayer which submits the channel open acknowledgement
+	Bounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
+	// the refund address for the bounty if the channel is not opened before the expiry
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
+	// block height at which the bounty expires and is refunded to the refund address, zero means no expiry height
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// block time (in unix nanoseconds) at which the bounty expires and is refunded to the refund address, zero means no
+	// expiry timestamp
+	ExpiryTimestamp uint64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty" yaml:"expiry_timestamp"`
+}
+
+func (m *HandshakeBounty) Reset()         { *m = HandshakeBounty{} }
+func (m *HandshakeBounty) String() string { return proto.CompactTextString(m) }
+func (*HandshakeBounty) ProtoMessage()    {}
+func (*HandshakeBounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{6}
+}
+func (m *HandshakeBounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandshakeBounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandshakeBounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandshakeBounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeBounty.Merge(m, src)
+}
+func (m *HandshakeBounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandshakeBounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeBounty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeBounty proto.InternalMessageInfo
+
+func (m *HandshakeBounty) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bounty
+	}
+	return nil
+}
+
+func (m *HandshakeBounty) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *HandshakeBounty) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *HandshakeBounty) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+// HandshakeBounties contains a list of type HandshakeBounty
+type HandshakeBounties struct {
+	// list of handshake bounties
+	HandshakeBounties []HandshakeBounty `protobuf:"bytes,1,rep,name=handshake_bounties,json=handshakeBounties,proto3" json:"handshake_bounties" yaml:"handshake_bounties"`
+}
+
+func (m *HandshakeBounties) Reset()         { *m = HandshakeBounties{} }
+func (m *HandshakeBounties) String() string { return proto.CompactTextString(m) }
+func (*HandshakeBounties) ProtoMessage()    {}
+func (*HandshakeBounties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{7}
+}
+func (m *HandshakeBounties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandshakeBounties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandshakeBounties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandshakeBounties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeBounties.Merge(m, src)
+}
+func (m *HandshakeBounties) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandshakeBounties) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeBounties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeBounties proto.InternalMessageInfo
+
+func (m *HandshakeBounties) GetHandshakeBounties() []HandshakeBounty {
+	if m != nil {
+		return m.HandshakeBounties
+	}
+	return nil
+}
+
+// IdentifiedHandshakeBounties contains a list of type HandshakeBounty and the associated port and channel identifiers
+type IdentifiedHandshakeBounties struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// list of handshake bounties
+	HandshakeBounties []HandshakeBounty `protobuf:"bytes,3,rep,name=handshake_bounties,json=handshakeBounties,proto3" json:"handshake_bounties" yaml:"handshake_bounties"`
+}
+
+func (m *IdentifiedHandshakeBounties) Reset()         { *m = IdentifiedHandshakeBounties{} }
+func (m *IdentifiedHandshakeBounties) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedHandshakeBounties) ProtoMessage()    {}
+func (*IdentifiedHandshakeBounties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{8}
+}
+func (m *IdentifiedHandshakeBounties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedHandshakeBounties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedHandshakeBounties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedHandshakeBounties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedHandshakeBounties.Merge(m, src)
+}
+func (m *IdentifiedHandshakeBounties) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedHandshakeBounties) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedHandshakeBounties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedHandshakeBounties proto.InternalMessageInfo
+
+func (m *IdentifiedHandshakeBounties) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IdentifiedHandshakeBounties) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IdentifiedHandshakeBounties) GetHandshakeBounties() []HandshakeBounty {
+	if m != nil {
+		return m.HandshakeBounties
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
 	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
 	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
 	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*WeightedPayee)(nil), "ibc.applications.fee.v1.WeightedPayee")
+	proto.RegisterType((*WeightedPayees)(nil), "ibc.applications.fee.v1.WeightedPayees")
+	proto.RegisterType((*HandshakeBounty)(nil), "ibc.applications.fee.v1.HandshakeBounty")
+	proto.RegisterType((*HandshakeBounties)(nil), "ibc.applications.fee.v1.HandshakeBounties")
+	proto.RegisterType((*IdentifiedHandshakeBounties)(nil), "ibc.applications.fee.v1.IdentifiedHandshakeBounties")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0x73, 0x09, 0x6a, 0x1b, 0x47, 0x14, 0x74, 0x2a, 0x22, 0x8d, 0xe0, 0x52, 0x3c, 0x65,
-	0x89, 0xad, 0x04, 0x3a, 0xc0, 0x04, 0x57, 0x29, 0x52, 0x27, 0xd0, 0x89, 0x89, 0x25, 0xf2, 0xd9,
-	0x2f, 0xa9, 0x95, 0xdc, 0xf9, 0x74, 0xbe, 0x44, 0xca, 0xca, 0x27, 0xe0, 0x1b, 0xb0, 0xf3, 0x49,
-	0xba, 0x20, 0x75, 0x64, 0x0a, 0x28, 0xf9, 0x06, 0xdd, 0x91, 0x90, 0x7d, 0x4e, 0x94, 0x82, 0xaa,
-	0xaa, 0x12, 0xd3, 0xf9, 0xd9, 0xef, 0xef, 0xdf, 0xb3, 0xdf, 0xff, 0x8c, 0x5e, 0xc8, 0x98, 0x53,
-	0x96, 0x65, 0x53, 0xc9, 0x59, 0x21, 0x55, 0xaa, 0xe9, 0x08, 0x80, 0xce, 0x7b, 0xe6, 0x43, 0xb2,
-	0x5c, 0x15, 0xca, 0x7f, 0x2a, 0x63, 0x4e, 0x76, 0x53, 0x88, 0x59, 0x9b, 0xf7, 0x5a, 0x01, 0x57,
-	0x3a, 0x51, 0x9a, 0xc6, 0x4c, 0x1b, 0x49, 0x0c, 0x05, 0xeb, 0x51, 0xae, 0x64, 0x5a, 0x0a, 0x5b,
-	0x47, 0x63, 0x35, 0x56, 0x76, 0x48, 0xcd, 0xc8, 0xcd, 0x5a, 0x22, 0x57, 0x39, 0x50, 0x7e, 0xc1,
-	0xd2, 0x14, 0xa6, 0x86, 0xe6, 0x86, 0x65, 0x0a, 0xfe, 0x5d, 0x45, 0xb5, 0x01, 0x80, 0xbf, 0x40,
-	0x07, 0x39, 0xf0, 0xf9, 0x70, 0x04, 0xd0, 0xf4, 0x4e, 0x6a, 0x9d, 0x46, 0xff, 0x98, 0x94, 0x4c,
-	0x62, 0x98, 0xc4, 0x31, 0xc9, 0x99, 0x92, 0x69, 0x78, 0x76, 0xb9, 0x6c, 0x57, 0xae, 0x97, 0xed,
-	0x47, 0x0b, 0x96, 0x4c, 0xdf, 0xe0, 0x8d, 0x10, 0x7f, 0xfb, 0xd9, 0xee, 0x8c, 0x65, 0x71, 0x31,
-	0x8b, 0x09, 0x57, 0x09, 0x75, 0x35, 0x97, 0x9f, 0xae, 0x16, 0x13, 0x5a, 0x2c, 0x32, 0xd0, 0x76,
-	0x0f, 0x1d, 0xed, 0x1b, 0x99, 0x41, 0xcf, 0xd1, 0x3e, 0xe3, 0x13, 0x4b, 0xae, 0xde, 0x45, 0x0e,
-	0x1d, 0xf9, 0xb0, 0x24, 0x3b, 0xdd, 0xfd, 0xc0, 0x7b, 0x8c, 0x4f, 0x0c, 0xf7, 0xb3, 0x87, 0x1a,
-	0x85, 0x4c, 0x40, 0xcd, 0x0a, 0x0b, 0xaf, 0xdd, 0x05, 0x1f, 0x38, 0xb8, 0x5f, 0xc2, 0x77, 0xb4,
-	0xf7, 0x2b, 0x00, 0x39, 0xe5, 0x00, 0x00, 0x7f, 0xf5, 0x50, 0xfd, 0x03, 0xe3, 0x13, 0x30, 0x91,
-	0xff, 0x0a, 0xd5, 0xca, 0x06, 0x78, 0x9d, 0x46, 0xff, 0x19, 0xb9, 0xc5, 0x0d, 0x64, 0x00, 0x10,
-	0x3e, 0x30, 0xc5, 0x44, 0x26, 0xdd, 0x7f, 0x8b, 0x0e, 0x73, 0x18, 0xcd, 0x52, 0x31, 0x64, 0x42,
-	0xe4, 0xa0, 0x75, 0xb3, 0x7a, 0xe2, 0x75, 0xea, 0xe1, 0xf1, 0xf5, 0xb2, 0xfd, 0x64, 0xd3, 0xa2,
-	0xdd, 0x75, 0x1c, 0x3d, 0x2c, 0x27, 0xde, 0x95, 0xb1, 0xdf, 0x32, 0xdd, 0x9f, 0xb2, 0x05, 0xe4,
-	0xda, 0x5e, 0x43, 0x3d, 0xda, 0xc6, 0x38, 0x41, 0x68, 0x5b, 0xa0, 0xf6, 0x87, 0xa8, 0x91, 0xd9,
-	0xc8, 0x1c, 0x5b, 0x3b, 0xab, 0xe0, 0x5b, 0x2b, 0xdd, 0x2a, 0xc3, 0xd6, 0xcd, 0xcb, 0xdb, 0xd9,
-	0x04, 0x47, 0x28, 0xdb, 0x02, 0xf0, 0x77, 0x0f, 0x1d, 0x9d, 0x0b, 0x48, 0x0b, 0x39, 0x92, 0x20,
-	0x76, 0xc8, 0x1f, 0x51, 0xdd, 0x89, 0xa4, 0x70, 0x37, 0xf4, 0xdc, 0x72, 0x8d, 0xc1, 0xc9, 0xc6,
-	0xd5, 0x5b, 0xe6, 0xb9, 0x08, 0x9b, 0x0e, 0xf9, 0xf8, 0x06, 0x52, 0x0a, 0x1c, 0x1d, 0x64, 0x2e,
-	0xe7, 0xef, 0xf3, 0x54, 0xff, 0xf7, 0x79, 0xc2, 0xf7, 0x97, 0xab, 0xc0, 0xbb, 0x5a, 0x05, 0xde,
-	0xaf, 0x55, 0xe0, 0x7d, 0x59, 0x07, 0x95, 0xab, 0x75, 0x50, 0xf9, 0xb1, 0x0e, 0x2a, 0x9f, 0x4e,
-	0xff, 0x35, 0x8c, 0x8c, 0x79, 0x77, 0xac, 0xe8, 0xfc, 0x94, 0x26, 0x4a, 0xcc, 0xa6, 0xa0, 0xcd,
-	0x7b, 0xa1, 0x69, 0xff, 0x75, 0xd7, 0x3c, 0x15, 0xd6, 0x43, 0xf1, 0x9e, 0xfd, 0x71, 0x5f, 0xfe,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x16, 0x75, 0x8d, 0x4f, 0x04, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x6f, 0x12, 0x4d,
+	0x18, 0xc0, 0x59, 0xe0, 0x85, 0x32, 0xbc, 0xfd, 0x37, 0x69, 0xdf, 0x52, 0xfa, 0x0a, 0xed, 0x1c,
+	0x0c, 0x89, 0xe9, 0xae, 0xad, 0xed, 0x41, 0x13, 0x13, 0xbb, 0x35, 0xa4, 0x9c, 0x34, 0x9b, 0x46,
+	0x13, 0x2f, 0x64, 0xff, 0x0c, 0x30, 0x01, 0x76, 0x36, 0x3b, 0x0b, 0xba, 0x1e, 0xfd, 0x02, 0xfa,
+	0x29, 0x3c, 0xf8, 0x25, 0xbc, 0xf6, 0x62, 0xd2, 0xa3, 0x27, 0x34, 0xed, 0x37, 0xe0, 0xac, 0x89,
+	0x99, 0x9d, 0x61, 0x81, 0x12, 0xd2, 0x54, 0x1b, 0x4f, 0xcc, 0x33, 0xf3, 0x3c, 0xcf, 0x6f, 0x9e,
+	0x7f, 0xc3, 0x82, 0x1d, 0x62, 0xd9, 0x9a, 0xe9, 0x79, 0x1d, 0x62, 0x9b, 0x01, 0xa1, 0x2e, 0xd3,
+	0x1a, 0x18, 0x6b, 0xfd, 0x3d, 0xfe, 0xa3, 0x7a, 0x3e, 0x0d, 0x28, 0xdc, 0x20, 0x96, 0xad, 0x4e,
+	0xaa, 0xa8, 0xfc, 0xac, 0xbf, 0x57, 0x2c, 0xd9, 0x94, 0x75, 0x29, 0xd3, 0x2c, 0x93, 0x71, 0x13,
+	0x0b, 0x07, 0xe6, 0x9e, 0x66, 0x53, 0xe2, 0x0a, 0xc3, 0xe2, 0x5a, 0x93, 0x36, 0x69, 0xb4, 0xd4,
+	0xf8, 0x4a, 0xee, 0x46, 0x44, 0x9b, 0xfa, 0x58, 0xb3, 0x5b, 0xa6, 0xeb, 0xe2, 0x0e, 0xa7, 0xc9,
+	0xa5, 0x50, 0x41, 0x3f, 0x93, 0x20, 0x55, 0xc5, 0x18, 0x86, 0x60, 0xc1, 0xc7, 0x76, 0xbf, 0xde,
+	0xc0, 0xb8, 0xa0, 0x6c, 0xa7, 0x2a, 0xf9, 0xfd, 0x4d, 0x55, 0x30, 0x55, 0xce, 0x54, 0x25, 0x53,
+	0x3d, 0xa6, 0xc4, 0xd5, 0x8f, 0xcf, 0x06, 0xe5, 0xc4, 0x70, 0x50, 0x5e, 0x0e, 0xcd, 0x6e, 0xe7,
+	0x11, 0x1a, 0x19, 0xa2, 0x4f, 0xdf, 0xca, 0x95, 0x26, 0x09, 0x5a, 0x3d, 0x4b, 0xb5, 0x69, 0x57,
+	0x93, 0x77, 0x16, 0x3f, 0xbb, 0xcc, 0x69, 0x6b, 0x41, 0xe8, 0x61, 0x16, 0xf9, 0x60, 0x46, 0x96,
+	0x9b, 0x71, 0x74, 0x1f, 0x64, 0x4d, 0xbb, 0x1d, 0x91, 0x93, 0xd7, 0x91, 0x75, 0x49, 0x5e, 0x12,
+	0x64, 0x69, 0x77, 0x33, 0x70, 0xc6, 0xb4, 0xdb, 0x9c, 0xfb, 0x4e, 0x01, 0xf9, 0x80, 0x74, 0x31,
+	0xed, 0x05, 0x11, 0x3c, 0x75, 0x1d, 0xbc, 0x2a, 0xe1, 0x50, 0xc0, 0x27, 0x6c, 0x6f, 0x76, 0x01,
+	0x20, 0x2d, 0xab, 0x18, 0xa3, 0x8f, 0x49, 0x90, 0x7b, 0x6e, 0xda, 0x6d, 0xcc, 0x25, 0x78, 0x00,
+	0x52, 0xa2, 0x00, 0x4a, 0x25, 0xbf, 0xff, 0xbf, 0x3a, 0xa7, 0x1b, 0xd4, 0x2a, 0xc6, 0x7a, 0x9a,
+	0x5f, 0xc6, 0xe0, 0xea, 0xf0, 0x09, 0x58, 0xf2, 0x71, 0xa3, 0xe7, 0x3a, 0x75, 0xd3, 0x71, 0x7c,
+	0xcc, 0x58, 0x21, 0xb9, 0xad, 0x54, 0x72, 0xfa, 0xe6, 0x70, 0x50, 0x5e, 0x1f, 0x95, 0x68, 0xf2,
+	0x1c, 0x19, 0x8b, 0x62, 0xe3, 0x48, 0xc8, 0xb0, 0xc8, 0xab, 0xdf, 0x31, 0x43, 0xec, 0xb3, 0x28,
+	0x0d, 0x39, 0x23, 0x96, 0xe1, 0x63, 0xb0, 0x88, 0xdf, 0x78, 0xc4, 0x0f, 0xeb, 0x2d, 0x4c, 0x9a,
+	0xad, 0xa0, 0x90, 0xde, 0x56, 0x2a, 0x69, 0xbd, 0x30, 0x1c, 0x94, 0xd7, 0x84, 0xf3, 0xa9, 0x63,
+	0x64, 0xfc, 0x2b, 0xe4, 0x93, 0x48, 0x84, 0x55, 0xb0, 0x22, 0xcf, 0x79, 0xd4, 0x2c, 0x30, 0xbb,
+	0x5e, 0xe1, 0x9f, 0xc8, 0xc3, 0xd6, 0x70, 0x50, 0xde, 0x98, 0xf2, 0x10, 0x6b, 0x20, 0x63, 0x59,
+	0x6c, 0x9d, 0xc6, 0x3b, 0x5d, 0x00, 0xe2, 0x3c, 0x31, 0x58, 0x07, 0x79, 0x2f, 0x92, 0x78, 0xf6,
+	0x99, 0xec, 0x58, 0x34, 0x37, 0x61, 0xb1, 0xa5, 0x5e, 0x9c, 0xae, 0xe1, 0x84, 0x13, 0x64, 0x00,
+	0x2f, 0x06, 0xa0, 0x2f, 0x0a, 0x58, 0xab, 0x39, 0xd8, 0x0d, 0x48, 0x83, 0x60, 0x67, 0x82, 0x7c,
+	0x0a, 0x72, 0xd2, 0x88, 0x38, 0xb2, 0x50, 0x77, 0x22, 0x2e, 0x9f, 0x33, 0x75, 0x34, 0x5c, 0x31,
+	0xb3, 0xe6, 0xe8, 0x05, 0x89, 0x5c, 0x99, 0x42, 0x12, 0x07, 0x19, 0x0b, 0x9e, 0xd4, 0xb9, 0x1a,
+	0x4f, 0xf2, 0xd6, 0xe3, 0x39, 0x02, 0x8b, 0x2f, 0xa3, 0x82, 0xf0, 0x60, 0x42, 0x8c, 0x61, 0x01,
+	0x64, 0x47, 0xdd, 0xc2, 0xa3, 0xc8, 0x19, 0x23, 0x11, 0xfe, 0x07, 0x32, 0xaf, 0x45, 0xa5, 0x79,
+	0x1b, 0xa5, 0x0d, 0x29, 0xa1, 0x17, 0x60, 0x69, 0xca, 0x05, 0x83, 0x4f, 0x41, 0xc6, 0x33, 0xc3,
+	0x71, 0x01, 0xee, 0xce, 0xbd, 0xf0, 0x94, 0xa1, 0xec, 0x5d, 0x69, 0x8b, 0x3e, 0x27, 0xc1, 0xf2,
+	0x89, 0xe9, 0x3a, 0xac, 0x65, 0xb6, 0xb1, 0x4e, 0x7b, 0x6e, 0x10, 0x42, 0x1b, 0x64, 0xac, 0x68,
+	0x75, 0xfd, 0x63, 0x74, 0x9f, 0x3b, 0xbb, 0xd9, 0x03, 0x20, 0x5c, 0xdf, 0xc2, 0xdc, 0xcc, 0xcc,
+	0x46, 0xea, 0x8f, 0x67, 0x23, 0xfd, 0x1b, 0xb3, 0xf1, 0x5e, 0x01, 0xab, 0xd3, 0x19, 0x24, 0x98,
+	0xc1, 0xb7, 0x00, 0xb6, 0x46, 0x9b, 0x75, 0x4b, 0xee, 0xca, 0x7c, 0x56, 0xe6, 0x56, 0xea, 0x4a,
+	0x25, 0xf4, 0x1d, 0xd9, 0x60, 0x9b, 0xe2, 0x36, 0xb3, 0x1e, 0x91, 0xb1, 0xda, 0xba, 0xca, 0x46,
+	0x3f, 0x14, 0xb0, 0x35, 0x1e, 0x9f, 0xd9, 0xbb, 0xdd, 0x03, 0x59, 0x8f, 0xfa, 0xf1, 0x0c, 0xe5,
+	0x74, 0x38, 0x7e, 0xd4, 0xe5, 0x01, 0x32, 0x32, 0x7c, 0x55, 0x73, 0xe0, 0x01, 0x00, 0x72, 0xae,
+	0xb8, 0xbe, 0xa8, 0xd1, 0xfa, 0x70, 0x50, 0x5e, 0x15, 0xfa, 0xe3, 0x33, 0x64, 0xe4, 0xa4, 0x50,
+	0x73, 0xe6, 0x84, 0x9f, 0xfa, 0x1b, 0xe1, 0xeb, 0xcf, 0xce, 0x2e, 0x4a, 0xca, 0xf9, 0x45, 0x49,
+	0xf9, 0x7e, 0x51, 0x52, 0x3e, 0x5c, 0x96, 0x12, 0xe7, 0x97, 0xa5, 0xc4, 0xd7, 0xcb, 0x52, 0xe2,
+	0xd5, 0xe1, 0x6c, 0x97, 0x12, 0xcb, 0xde, 0x6d, 0x52, 0xad, 0x7f, 0xa8, 0x75, 0xa9, 0xd3, 0xeb,
+	0x60, 0xc6, 0x3f, 0x12, 0x98, 0xb6, 0xff, 0x70, 0x97, 0x7f, 0x1f, 0x44, 0x8d, 0x6b, 0x65, 0xa2,
+	0x7f, 0xeb, 0x07, 0xbf, 0x06, 0x00, 0x72, 0x69, 0xf4, 0x3b, 0x44, 0x08, 0x00, 0x00,
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -386,6 +712,16 @@ func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Relayers) > 0 {
 		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relayers[iNdEx])
@@ -499,66 +835,286 @@ func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFee(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WeightedPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Fee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WeightedPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecvFee) > 0 {
-		for _, e := range m.RecvFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
-	}
-	if len(m.AckFee) > 0 {
-		for _, e := range m.AckFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
+	if m.Weight != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TimeoutFee) > 0 {
-		for _, e := range m.TimeoutFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PacketFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WeightedPayees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedPayees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedPayees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovFee(uint64(l))
-	l = len(m.RefundAddress)
-	if l > 0 {
-		n += 1 + l + sovFee(uint64(l))
-	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovFee(uint64(l))
+	if len(m.Payees) > 0 {
+		for iNdEx := len(m.Payees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PacketFees) Size() (n int) {
+func (m *HandshakeBounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeBounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeBounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandshakeBounties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeBounties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeBounties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandshakeBounties) > 0 {
+		for iNdEx := len(m.HandshakeBounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeBounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedHandshakeBounties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedHandshakeBounties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedHandshakeBounties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandshakeBounties) > 0 {
+		for iNdEx := len(m.HandshakeBounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeBounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovFee(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
+func (m *PacketFees) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -590,13 +1146,528 @@ func (m *IdentifiedPacketFees) Size() (n int) {
 	return n
 }
 
+func (m *WeightedPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovFee(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *WeightedPayees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payees) > 0 {
+		for _, e := range m.Payees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HandshakeBounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovFee(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
+func (m *HandshakeBounties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HandshakeBounties) > 0 {
+		for _, e := range m.HandshakeBounties {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedHandshakeBounties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.HandshakeBounties) > 0 {
+		for _, e := range m.HandshakeBounties {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovFee(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozFee(x uint64) (n int) {
 	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Fee) Unmarshal(dAtA []byte) error {
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -619,15 +1690,15 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -654,14 +1725,13 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFee = append(m.RecvFee, types.Coin{})
-			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -688,14 +1758,165 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFee = append(m.AckFee, types.Coin{})
-			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedPayees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedPayees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedPayees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -722,8 +1943,8 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
-			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payees = append(m.Payees, WeightedPayee{})
+			if err := m.Payees[len(m.Payees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -748,7 +1969,7 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFee) Unmarshal(dAtA []byte) error {
+func (m *HandshakeBounty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,15 +1992,15 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandshakeBounty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandshakeBounty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -806,7 +2027,8 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -843,10 +2065,10 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -856,24 +2078,30 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFee
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFee
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -895,7 +2123,7 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFees) Unmarshal(dAtA []byte) error {
+func (m *HandshakeBounties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -918,15 +2146,15 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandshakeBounties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandshakeBounties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeBounties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -953,8 +2181,8 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HandshakeBounties = append(m.HandshakeBounties, HandshakeBounty{})
+			if err := m.HandshakeBounties[len(m.HandshakeBounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -979,7 +2207,7 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedHandshakeBounties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1002,17 +2230,17 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedHandshakeBounties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedHandshakeBounties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -1022,28 +2250,59 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeBounties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1070,8 +2329,8 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HandshakeBounties = append(m.HandshakeBounties, HandshakeBounty{})
+			if err := m.HandshakeBounties[len(m.HandshakeBounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
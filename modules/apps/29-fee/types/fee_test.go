@@ -34,6 +34,29 @@ func TestFeeTotal(t *testing.T) {
 	require.Equal(t, sdk.NewInt(600), total.AmountOf(sdk.DefaultBondDenom))
 }
 
+func TestFeeIsAllGTE(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	require.True(t, fee.IsAllGTE(fee))
+	require.True(t, fee.IsAllGTE(types.NewFee(defaultRecvFee, nil, nil)))
+	require.True(t, fee.IsAllGTE(types.Fee{}))
+	require.False(t, fee.IsAllGTE(types.NewFee(defaultRecvFee.Add(defaultRecvFee...), nil, nil)))
+	require.False(t, fee.IsAllGTE(types.NewFee(nil, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1))), nil)))
+	require.False(t, types.NewFee(nil, defaultAckFee, defaultTimeoutFee).IsAllGTE(types.NewFee(defaultRecvFee, nil, nil)))
+}
+
+func TestPacketFeesTotalFee(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := types.NewPacketFees([]types.PacketFee{
+		types.NewPacketFee(fee, defaultAccAddress, nil),
+		types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), defaultAccAddress, nil),
+	})
+
+	expFee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+	require.Equal(t, expFee, packetFees.TotalFee())
+	require.Equal(t, types.Fee{}, types.NewPacketFees(nil).TotalFee())
+}
+
 func TestPacketFeeValidation(t *testing.T) {
 	var packetFee types.PacketFee
 
@@ -133,3 +156,26 @@ func TestPacketFeeValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestPacketFeeIsExpired(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	packetFee := types.NewPacketFee(fee, defaultAccAddress, nil)
+	require.False(t, packetFee.IsExpired(100, 100))
+
+	packetFee.ExpiryHeight = 10
+	require.False(t, packetFee.IsExpired(9, 100))
+	require.True(t, packetFee.IsExpired(10, 0))
+	require.True(t, packetFee.IsExpired(11, 0))
+
+	packetFee.ExpiryHeight = 0
+	packetFee.ExpiryTimestamp = 1000
+	require.False(t, packetFee.IsExpired(100, 999))
+	require.True(t, packetFee.IsExpired(0, 1000))
+
+	// a packet fee expires once either the expiry height or the expiry timestamp is reached
+	packetFee.ExpiryHeight = 10
+	require.True(t, packetFee.IsExpired(10, 999))
+	require.True(t, packetFee.IsExpired(9, 1000))
+	require.False(t, packetFee.IsExpired(9, 999))
+}
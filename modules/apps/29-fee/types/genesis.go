@@ -16,6 +16,9 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	minRelayerFees []MinRelayerFee,
+	relayerStats []RelayerStats,
+	handshakeBounties []IdentifiedHandshakeBounties,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -23,6 +26,9 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		MinRelayerFees:               minRelayerFees,
+		RelayerStats:                 relayerStats,
+		HandshakeBounties:            handshakeBounties,
 	}
 }
 
@@ -34,6 +40,9 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		MinRelayerFees:               []MinRelayerFee{},
+		RelayerStats:                 []RelayerStats{},
+		HandshakeBounties:            []IdentifiedHandshakeBounties{},
 	}
 }
 
@@ -65,16 +74,26 @@ func (gs GenesisState) Validate() error {
 
 	// Validate RegisteredPayees
 	for _, registeredPayee := range gs.RegisteredPayees {
-		if registeredPayee.Relayer == registeredPayee.Payee {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "relayer address and payee address must not be equal")
-		}
-
 		if _, err := sdk.AccAddressFromBech32(registeredPayee.Relayer); err != nil {
 			return sdkerrors.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
 		}
 
-		if _, err := sdk.AccAddressFromBech32(registeredPayee.Payee); err != nil {
-			return sdkerrors.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+		if len(registeredPayee.Payees) != 0 {
+			if registeredPayee.Payee != "" {
+				return sdkerrors.Wrap(ErrInvalidPayeeWeights, "payee and weighted payees must not both be set")
+			}
+
+			if err := ValidateWeightedPayees(registeredPayee.Payees); err != nil {
+				return err
+			}
+		} else {
+			if registeredPayee.Relayer == registeredPayee.Payee {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "relayer address and payee address must not be equal")
+			}
+
+			if _, err := sdk.AccAddressFromBech32(registeredPayee.Payee); err != nil {
+				return sdkerrors.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+			}
 		}
 
 		if err := host.ChannelIdentifierValidator(registeredPayee.ChannelId); err != nil {
@@ -108,5 +127,32 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate MinRelayerFees
+	for _, minRelayerFee := range gs.MinRelayerFees {
+		if err := host.PortIdentifierValidator(minRelayerFee.PortId); err != nil {
+			return sdkerrors.Wrap(err, "invalid source port ID")
+		}
+		if err := host.ChannelIdentifierValidator(minRelayerFee.ChannelId); err != nil {
+			return sdkerrors.Wrap(err, "invalid source channel ID")
+		}
+		if err := minRelayerFee.MinFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate RelayerStats
+	for _, relayerStats := range gs.RelayerStats {
+		if err := relayerStats.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate HandshakeBounties
+	for _, identifiedBounties := range gs.HandshakeBounties {
+		if err := identifiedBounties.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
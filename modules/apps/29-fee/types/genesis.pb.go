@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -36,6 +38,12 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees" yaml:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers" yaml:"forward_relayers"`
+	// list of minimum relayer fees required on fee enabled channels
+	MinRelayerFees []MinRelayerFee `protobuf:"bytes,6,rep,name=min_relayer_fees,json=minRelayerFees,proto3" json:"min_relayer_fees" yaml:"min_relayer_fees"`
+	// list of accumulated relayer earnings per channel
+	RelayerStats []RelayerStats `protobuf:"bytes,7,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats" yaml:"relayer_stats"`
+	// list of handshake bounties escrowed for channels in the INIT state
+	HandshakeBounties []IdentifiedHandshakeBounties `protobuf:"bytes,8,rep,name=handshake_bounties,json=handshakeBounties,proto3" json:"handshake_bounties" yaml:"handshake_bounties"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +114,27 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetMinRelayerFees() []MinRelayerFee {
+	if m != nil {
+		return m.MinRelayerFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHandshakeBounties() []IdentifiedHandshakeBounties {
+	if m != nil {
+		return m.HandshakeBounties
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -169,6 +198,8 @@ type RegisteredPayee struct {
 	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
 	// the payee address
 	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// the weighted payees between which fees are split, set instead of payee
+	Payees []WeightedPayee `protobuf:"bytes,4,rep,name=payees,proto3" json:"payees"`
 }
 
 func (m *RegisteredPayee) Reset()         { *m = RegisteredPayee{} }
@@ -225,6 +256,13 @@ func (m *RegisteredPayee) GetPayee() string {
 	return ""
 }
 
+func (m *RegisteredPayee) GetPayees() []WeightedPayee {
+	if m != nil {
+		return m.Payees
+	}
+	return nil
+}
+
 // RegisteredCounterpartyPayee contains the relayer address and counterparty payee address for a specific channel (used
 // for recv fee distribution)
 type RegisteredCounterpartyPayee struct {
@@ -345,12 +383,188 @@ func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
 	return types.PacketId{}
 }
 
+// MinRelayerFee contains the minimum recv, ack and timeout fees which must be escrowed for each packet sent on the
+// fee enabled channel identified by the PortID & ChannelID
+type MinRelayerFee struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the minimum fee required to be escrowed for each packet
+	MinFee Fee `protobuf:"bytes,3,opt,name=min_fee,json=minFee,proto3" json:"min_fee" yaml:"min_fee"`
+}
+
+func (m *MinRelayerFee) Reset()         { *m = MinRelayerFee{} }
+func (m *MinRelayerFee) String() string { return proto.CompactTextString(m) }
+func (*MinRelayerFee) ProtoMessage()    {}
+func (*MinRelayerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{5}
+}
+func (m *MinRelayerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinRelayerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinRelayerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinRelayerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinRelayerFee.Merge(m, src)
+}
+func (m *MinRelayerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinRelayerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinRelayerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinRelayerFee proto.InternalMessageInfo
+
+func (m *MinRelayerFee) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MinRelayerFee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MinRelayerFee) GetMinFee() Fee {
+	if m != nil {
+		return m.MinFee
+	}
+	return Fee{}
+}
+
+// RelayerStats contains the total fees earned and the number of packets relayed by a relayer on a specific channel
+type RelayerStats struct {
+	// the relayer address
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the total receive fees earned for forward relaying
+	RecvFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recv_fees,json=recvFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fees" yaml:"recv_fees"`
+	// the total acknowledgement fees earned for reverse relaying
+	AckFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=ack_fees,json=ackFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fees" yaml:"ack_fees"`
+	// the total timeout fees earned for timeout relaying
+	TimeoutFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=timeout_fees,json=timeoutFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"timeout_fees" yaml:"timeout_fees"`
+	// the number of incentivized packets forward relayed
+	RecvPackets uint64 `protobuf:"varint,6,opt,name=recv_packets,json=recvPackets,proto3" json:"recv_packets,omitempty" yaml:"recv_packets"`
+	// the number of incentivized acknowledgements relayed
+	AckPackets uint64 `protobuf:"varint,7,opt,name=ack_packets,json=ackPackets,proto3" json:"ack_packets,omitempty" yaml:"ack_packets"`
+	// the number of incentivized timeouts relayed
+	TimeoutPackets uint64 `protobuf:"varint,8,opt,name=timeout_packets,json=timeoutPackets,proto3" json:"timeout_packets,omitempty" yaml:"timeout_packets"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{6}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetRecvFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFees
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetAckFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFees
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetTimeoutFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TimeoutFees
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetRecvPackets() uint64 {
+	if m != nil {
+		return m.RecvPackets
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetAckPackets() uint64 {
+	if m != nil {
+		return m.AckPackets
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetTimeoutPackets() uint64 {
+	if m != nil {
+		return m.TimeoutPackets
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
 	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
 	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
 	proto.RegisterType((*RegisteredCounterpartyPayee)(nil), "ibc.applications.fee.v1.RegisteredCounterpartyPayee")
 	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
+	proto.RegisterType((*MinRelayerFee)(nil), "ibc.applications.fee.v1.MinRelayerFee")
+	proto.RegisterType((*RelayerStats)(nil), "ibc.applications.fee.v1.RelayerStats")
 }
 
 func init() {
@@ -358,48 +572,72 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xd4, 0x40,
-	0x1c, 0xdf, 0x82, 0x80, 0x0c, 0x06, 0xd8, 0x09, 0x48, 0x05, 0xe9, 0xe2, 0x18, 0x12, 0xa2, 0xd9,
-	0x36, 0x20, 0x1c, 0xf4, 0x66, 0x89, 0x98, 0x4d, 0x4c, 0x24, 0xa3, 0x27, 0x2f, 0x9b, 0x6e, 0xfb,
-	0xef, 0xd2, 0xb8, 0xdb, 0x69, 0x66, 0x86, 0x25, 0xeb, 0xcd, 0x93, 0x57, 0x5f, 0xc3, 0xf8, 0x08,
-	0xbe, 0x00, 0x47, 0x8e, 0x9e, 0x36, 0x06, 0xde, 0x60, 0x9f, 0xc0, 0x4c, 0x67, 0x0a, 0xcb, 0x7e,
-	0x18, 0x0e, 0xde, 0x66, 0x3a, 0xbf, 0xaf, 0xe9, 0x6f, 0xf2, 0x47, 0xdb, 0x49, 0x23, 0xf4, 0x82,
-	0x2c, 0x6b, 0x25, 0x61, 0x20, 0x13, 0x96, 0x0a, 0x2f, 0x06, 0xf0, 0x3a, 0xbb, 0x5e, 0x13, 0x52,
-	0x10, 0x89, 0x70, 0x33, 0xce, 0x24, 0xc3, 0x6b, 0x49, 0x23, 0x74, 0x07, 0x61, 0x6e, 0x0c, 0xe0,
-	0x76, 0x76, 0xd7, 0x57, 0x9a, 0xac, 0xc9, 0x72, 0x8c, 0xa7, 0x56, 0x1a, 0xbe, 0xfe, 0x64, 0x92,
-	0xaa, 0x62, 0x0d, 0x40, 0x42, 0xc6, 0xc1, 0x0b, 0x4f, 0x82, 0x34, 0x85, 0x96, 0x3a, 0x36, 0x4b,
-	0x0d, 0x21, 0x3f, 0x67, 0xd0, 0x83, 0xb7, 0x3a, 0xc6, 0x07, 0x19, 0x48, 0xc0, 0x1d, 0xb4, 0x94,
-	0x44, 0x90, 0xca, 0x24, 0x4e, 0x20, 0xaa, 0xc7, 0x00, 0xc2, 0xb6, 0xb6, 0xa6, 0x77, 0x16, 0xf6,
-	0xaa, 0xee, 0x84, 0x7c, 0x6e, 0xed, 0x1a, 0x7f, 0x1c, 0x84, 0x9f, 0x41, 0x1e, 0x01, 0x08, 0xdf,
-	0x39, 0xef, 0x55, 0x4a, 0xfd, 0x5e, 0xe5, 0x61, 0x37, 0x68, 0xb7, 0x5e, 0x91, 0x21, 0x4d, 0x42,
-	0x17, 0x6f, 0xbe, 0x28, 0x3c, 0xfe, 0x6a, 0xa1, 0x95, 0x18, 0xa0, 0x0e, 0x69, 0xd0, 0x68, 0x41,
-	0x54, 0x37, 0x31, 0x85, 0x3d, 0x95, 0xbb, 0x3f, 0x9b, 0xe8, 0x7e, 0x04, 0xf0, 0x46, 0x73, 0x0e,
-	0x35, 0xc5, 0x7f, 0x6a, 0xac, 0x37, 0xb4, 0xf5, 0x38, 0x55, 0x42, 0x71, 0x3c, 0xcc, 0x13, 0xf8,
-	0x0c, 0x95, 0x39, 0x34, 0x13, 0x21, 0x81, 0x43, 0x54, 0xcf, 0x82, 0xae, 0xba, 0xfd, 0x74, 0xee,
-	0xbf, 0x33, 0xd1, 0x9f, 0x5e, 0x33, 0x8e, 0x15, 0xc1, 0xdf, 0x32, 0xee, 0xb6, 0x76, 0x1f, 0x11,
-	0x24, 0x74, 0x99, 0xdf, 0xa6, 0x08, 0xfc, 0xc3, 0x42, 0xce, 0x00, 0x30, 0x64, 0xa7, 0xa9, 0x04,
-	0x9e, 0x05, 0x5c, 0x76, 0x8b, 0x18, 0xf7, 0xf2, 0x18, 0xfb, 0x77, 0x88, 0x71, 0x38, 0xc0, 0xd6,
-	0x91, 0xaa, 0x26, 0xd2, 0xf6, 0x48, 0xa4, 0x31, 0x4e, 0x84, 0x3e, 0xe6, 0x93, 0xb5, 0x04, 0xfe,
-	0x82, 0x96, 0x63, 0xc6, 0xcf, 0x02, 0x1e, 0xd5, 0x39, 0xb4, 0x82, 0x2e, 0x70, 0x61, 0xcf, 0xe4,
-	0xe1, 0xdc, 0xc9, 0x1d, 0x69, 0x02, 0xd5, 0xf8, 0xd7, 0x51, 0xc4, 0x41, 0x08, 0xbf, 0x62, 0x62,
-	0xad, 0x99, 0x9e, 0x86, 0x54, 0x09, 0x5d, 0x8a, 0x6f, 0xf1, 0x04, 0xe9, 0xa0, 0xf2, 0x48, 0xdd,
-	0xf8, 0x39, 0x9a, 0xcb, 0x18, 0x97, 0xf5, 0x24, 0xb2, 0xad, 0x2d, 0x6b, 0x67, 0xde, 0xc7, 0xfd,
-	0x5e, 0x65, 0x51, 0x6b, 0x9a, 0x03, 0x42, 0x67, 0xd5, 0xaa, 0x16, 0xe1, 0x7d, 0x84, 0xcc, 0x1b,
-	0x50, 0xf8, 0xa9, 0x1c, 0xbf, 0xda, 0xef, 0x55, 0xca, 0x1a, 0x7f, 0x73, 0x46, 0xe8, 0xbc, 0xd9,
-	0xd4, 0x22, 0x72, 0x86, 0x96, 0x86, 0x6a, 0x1e, 0x12, 0xb2, 0xee, 0x26, 0x84, 0x6d, 0x34, 0x67,
-	0xae, 0xa7, 0xbd, 0x69, 0xb1, 0xc5, 0x2b, 0x68, 0x26, 0xff, 0xff, 0xf6, 0x74, 0xfe, 0x5d, 0x6f,
-	0xc8, 0x2f, 0x0b, 0x6d, 0xfc, 0xa3, 0xd9, 0xff, 0x9e, 0xe2, 0x1d, 0xc2, 0xa3, 0x4f, 0x42, 0x47,
-	0xf2, 0x37, 0xfb, 0xbd, 0xca, 0x23, 0xa3, 0x3b, 0x82, 0x21, 0xb4, 0x1c, 0x0e, 0xa7, 0x23, 0xdf,
-	0x2c, 0xb4, 0x3a, 0xb6, 0x7a, 0x95, 0x20, 0xd0, 0x4b, 0x1d, 0x9a, 0x16, 0x5b, 0xfc, 0x11, 0xcd,
-	0x67, 0xf9, 0x14, 0x29, 0xfa, 0x59, 0xd8, 0xdb, 0xcc, 0xdf, 0x95, 0x9a, 0x63, 0x6e, 0x31, 0xbc,
-	0x3a, 0xbb, 0xae, 0x9e, 0x35, 0xb5, 0xc8, 0xb7, 0xcd, 0x33, 0x5a, 0x36, 0x95, 0x17, 0x6c, 0x42,
-	0xef, 0x67, 0x05, 0xe6, 0xfd, 0xf9, 0xa5, 0x63, 0x5d, 0x5c, 0x3a, 0xd6, 0x9f, 0x4b, 0xc7, 0xfa,
-	0x7e, 0xe5, 0x94, 0x2e, 0xae, 0x9c, 0xd2, 0xef, 0x2b, 0xa7, 0xf4, 0xe9, 0xa0, 0x99, 0xc8, 0x93,
-	0xd3, 0x86, 0x1b, 0xb2, 0xb6, 0x17, 0x32, 0xd1, 0x66, 0xc2, 0x4b, 0x1a, 0x61, 0xb5, 0xc9, 0xbc,
-	0xce, 0x81, 0xd7, 0x66, 0xd1, 0x69, 0x0b, 0x84, 0x1a, 0xb3, 0xc2, 0xdb, 0x7b, 0x59, 0x55, 0x13,
-	0x56, 0x76, 0x33, 0x10, 0x8d, 0xd9, 0x7c, 0x7c, 0xbe, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf4,
-	0x0f, 0x0d, 0xd4, 0xdc, 0x05, 0x00, 0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x36, 0x1f, 0x76, 0xc6, 0x69, 0x3e, 0x86, 0x34, 0x71, 0xd3, 0xd4, 0x4e, 0x07, 0x05,
+	0x45, 0xa0, 0xec, 0x2a, 0xa1, 0x15, 0xa2, 0x37, 0x36, 0x25, 0x25, 0x12, 0x15, 0xd5, 0x80, 0x84,
+	0xc4, 0xc5, 0x1a, 0xef, 0xbe, 0x6b, 0x8f, 0x6c, 0xef, 0x9a, 0x9d, 0x8d, 0x2b, 0x23, 0x2e, 0x1c,
+	0x80, 0x2b, 0x1c, 0xf9, 0x09, 0xf0, 0x17, 0x10, 0x07, 0x6e, 0x3d, 0xf6, 0xc8, 0xc9, 0xa0, 0xe4,
+	0x1f, 0xe4, 0x17, 0xa0, 0xf9, 0xd8, 0xec, 0x7a, 0x1d, 0xa7, 0x2d, 0x42, 0x3d, 0xed, 0xcc, 0xee,
+	0xf3, 0xbc, 0xcf, 0xb3, 0xef, 0xcc, 0x3b, 0xf3, 0xa2, 0x5d, 0xde, 0xf4, 0x1c, 0xd6, 0xef, 0x77,
+	0xb9, 0xc7, 0x12, 0x1e, 0x85, 0xc2, 0x09, 0x00, 0x9c, 0xc1, 0x81, 0xd3, 0x82, 0x10, 0x04, 0x17,
+	0x76, 0x3f, 0x8e, 0x92, 0x08, 0x6f, 0xf2, 0xa6, 0x67, 0xe7, 0x61, 0x76, 0x00, 0x60, 0x0f, 0x0e,
+	0xb6, 0x6a, 0x5e, 0x24, 0x7a, 0x91, 0x70, 0x9a, 0x4c, 0x48, 0x5a, 0x13, 0x12, 0x76, 0xe0, 0x78,
+	0x11, 0x0f, 0x35, 0x71, 0x6b, 0xbd, 0x15, 0xb5, 0x22, 0x35, 0x74, 0xe4, 0xc8, 0xbc, 0xbd, 0x37,
+	0x4d, 0x55, 0x46, 0xcd, 0x41, 0xbc, 0x28, 0x06, 0xc7, 0x6b, 0xb3, 0x30, 0x84, 0xae, 0xfc, 0x6c,
+	0x86, 0x1a, 0x42, 0x7e, 0x2e, 0xa3, 0xa5, 0xc7, 0xda, 0xe6, 0xe7, 0x09, 0x4b, 0x00, 0x0f, 0xd0,
+	0x0a, 0xf7, 0x21, 0x4c, 0x78, 0xc0, 0xc1, 0x6f, 0x04, 0x00, 0xa2, 0x6a, 0xed, 0xcc, 0xee, 0x55,
+	0x0e, 0xf7, 0xed, 0x29, 0xfe, 0xed, 0x93, 0x4b, 0xfc, 0x53, 0xe6, 0x75, 0x20, 0x39, 0x06, 0x10,
+	0x6e, 0xed, 0xf9, 0xa8, 0x3e, 0x73, 0x31, 0xaa, 0x6f, 0x0c, 0x59, 0xaf, 0xfb, 0x90, 0x14, 0x62,
+	0x12, 0xba, 0x9c, 0xbd, 0x91, 0x78, 0xfc, 0x9d, 0x85, 0xd6, 0x03, 0x80, 0x06, 0x84, 0xac, 0xd9,
+	0x05, 0xbf, 0x61, 0x6c, 0x8a, 0xea, 0x0d, 0xa5, 0xfe, 0xee, 0x54, 0xf5, 0x63, 0x80, 0x8f, 0x35,
+	0xe7, 0x48, 0x53, 0xdc, 0xb7, 0x8d, 0xf4, 0x1d, 0x2d, 0x7d, 0x55, 0x54, 0x42, 0x71, 0x50, 0xe4,
+	0x09, 0xfc, 0x0c, 0xad, 0xc5, 0xd0, 0xe2, 0x22, 0x81, 0x18, 0xfc, 0x46, 0x9f, 0x0d, 0xe5, 0xdf,
+	0xcf, 0x2a, 0xfd, 0xbd, 0xa9, 0xfa, 0xf4, 0x92, 0xf1, 0x54, 0x12, 0xdc, 0x1d, 0xa3, 0x5e, 0xd5,
+	0xea, 0x13, 0x01, 0x09, 0x5d, 0x8d, 0xc7, 0x29, 0x02, 0xff, 0x6a, 0xa1, 0x5a, 0x0e, 0xe8, 0x45,
+	0xa7, 0x61, 0x02, 0x71, 0x9f, 0xc5, 0xc9, 0x30, 0xb5, 0x31, 0xa7, 0x6c, 0xdc, 0x7f, 0x05, 0x1b,
+	0x47, 0x39, 0xb6, 0xb6, 0xb4, 0x6f, 0x2c, 0xed, 0x4e, 0x58, 0xba, 0x42, 0x89, 0xd0, 0xed, 0x78,
+	0x7a, 0x2c, 0x81, 0xbf, 0x41, 0xab, 0x41, 0x14, 0x3f, 0x63, 0xb1, 0xdf, 0x88, 0xa1, 0xcb, 0x86,
+	0x10, 0x8b, 0xea, 0xbc, 0x32, 0x67, 0x4f, 0x5f, 0x23, 0x4d, 0xa0, 0x1a, 0xff, 0x91, 0xef, 0xc7,
+	0x20, 0x84, 0x5b, 0x37, 0xb6, 0x36, 0xcd, 0x3a, 0x15, 0xa2, 0x12, 0xba, 0x12, 0x8c, 0xf1, 0x04,
+	0xfe, 0x1a, 0xad, 0xf6, 0x78, 0x98, 0x22, 0xf4, 0xee, 0x5c, 0x50, 0xda, 0xef, 0x4c, 0xd5, 0x7e,
+	0xc2, 0x43, 0xc3, 0x3f, 0x06, 0x28, 0x6a, 0x16, 0xa3, 0x11, 0xba, 0xdc, 0xcb, 0xe3, 0x05, 0x6e,
+	0xa3, 0x9b, 0x29, 0x40, 0x24, 0x2c, 0x11, 0xd5, 0x92, 0xd2, 0xdb, 0xbd, 0x66, 0x21, 0x14, 0x5a,
+	0x56, 0x93, 0x70, 0xb7, 0x8d, 0xdc, 0x7a, 0x9a, 0xf9, 0x5c, 0x24, 0x42, 0x97, 0xe2, 0x1c, 0x16,
+	0xff, 0x60, 0x21, 0xdc, 0x66, 0xa1, 0x2f, 0xda, 0xac, 0x03, 0x8d, 0xa6, 0xcc, 0x3c, 0x07, 0x51,
+	0x2d, 0xbf, 0x64, 0xe1, 0xb3, 0xea, 0xfb, 0x24, 0x25, 0xbb, 0x86, 0xeb, 0xde, 0x33, 0xf2, 0xb7,
+	0xb5, 0xfc, 0x64, 0x74, 0x42, 0xd7, 0xda, 0x45, 0x16, 0x19, 0xa0, 0xb5, 0x89, 0xa2, 0xc2, 0xef,
+	0xa1, 0x52, 0x3f, 0x8a, 0x93, 0x06, 0xf7, 0xab, 0xd6, 0x8e, 0xb5, 0xb7, 0xe8, 0xe2, 0x8b, 0x51,
+	0x7d, 0x59, 0xc7, 0x35, 0x1f, 0x08, 0x5d, 0x90, 0xa3, 0x13, 0x1f, 0xdf, 0x47, 0xc8, 0x54, 0x9a,
+	0xc4, 0xdf, 0x50, 0xf8, 0x5b, 0x17, 0xa3, 0xfa, 0x9a, 0xc6, 0x67, 0xdf, 0x08, 0x5d, 0x34, 0x93,
+	0x13, 0x9f, 0xfc, 0x61, 0xa1, 0x95, 0x42, 0x35, 0x15, 0x22, 0x59, 0xaf, 0x16, 0x09, 0x57, 0x51,
+	0xc9, 0xa4, 0x56, 0x8b, 0xd3, 0x74, 0x8a, 0xd7, 0xd1, 0xbc, 0xda, 0xe6, 0xd5, 0x59, 0xf5, 0x5e,
+	0x4f, 0xf0, 0x23, 0xb4, 0x30, 0x56, 0x66, 0xd3, 0x77, 0xd3, 0x97, 0xc0, 0x5b, 0xed, 0x24, 0xad,
+	0xf5, 0x39, 0x99, 0x5f, 0x6a, 0xb8, 0xe4, 0x77, 0x0b, 0xdd, 0xb9, 0xa6, 0x0c, 0xff, 0xf7, 0x7f,
+	0xf9, 0x14, 0xe1, 0xc9, 0xfa, 0xd5, 0x3f, 0xe6, 0xde, 0xcd, 0x56, 0x7d, 0x12, 0x43, 0xe8, 0x9a,
+	0x57, 0x74, 0x47, 0x7e, 0xb4, 0xd0, 0xad, 0x2b, 0xeb, 0x54, 0x3a, 0x60, 0x7a, 0xa8, 0x4d, 0xd3,
+	0x74, 0x8a, 0xbf, 0x40, 0x8b, 0x7d, 0x75, 0xe4, 0xa7, 0xcb, 0x5c, 0x39, 0xbc, 0xab, 0x52, 0x27,
+	0x2f, 0x1d, 0x3b, 0xbd, 0x69, 0x06, 0x07, 0xb6, 0xbe, 0x18, 0x4e, 0x7c, 0xb7, 0x6a, 0x76, 0xe4,
+	0xaa, 0xd9, 0x39, 0x29, 0x9b, 0xd0, 0x72, 0xdf, 0x60, 0xc8, 0x9f, 0x16, 0xba, 0x39, 0x56, 0xb5,
+	0x6f, 0x60, 0xf3, 0xe1, 0x27, 0xa8, 0x24, 0x0f, 0x83, 0xc0, 0x64, 0xb0, 0x72, 0xb8, 0x7d, 0xdd,
+	0x8d, 0xe3, 0x6e, 0x98, 0xff, 0x58, 0xce, 0xce, 0x91, 0x40, 0x26, 0x76, 0xa1, 0xc7, 0xc3, 0x63,
+	0x00, 0xf2, 0xcb, 0x3c, 0x5a, 0xca, 0x9f, 0x04, 0xf9, 0x65, 0xb4, 0xc6, 0x97, 0xf1, 0xbf, 0xf9,
+	0xfd, 0x16, 0x2d, 0xc6, 0xe0, 0x0d, 0x1a, 0x41, 0x76, 0x47, 0xdd, 0xb6, 0x75, 0x23, 0x61, 0xcb,
+	0x46, 0xc2, 0x36, 0x8d, 0x84, 0x7d, 0x14, 0xf1, 0xd0, 0x7d, 0x34, 0x9e, 0xf6, 0x4b, 0x26, 0xf9,
+	0xed, 0xef, 0xfa, 0x5e, 0x8b, 0x27, 0xed, 0xd3, 0xa6, 0xed, 0x45, 0x3d, 0xc7, 0x74, 0x22, 0xfa,
+	0xb1, 0x2f, 0xfc, 0x8e, 0x93, 0x0c, 0xfb, 0x20, 0x54, 0x10, 0x41, 0xcb, 0x92, 0xa7, 0x4e, 0xc5,
+	0x21, 0x2a, 0x33, 0xaf, 0xd3, 0x08, 0xb2, 0x92, 0xb9, 0x46, 0xfc, 0xc8, 0x88, 0xaf, 0x68, 0xf1,
+	0x94, 0xf8, 0x7a, 0xda, 0x25, 0xe6, 0x75, 0x94, 0xf4, 0xf7, 0x16, 0x5a, 0x4a, 0x78, 0x0f, 0xa2,
+	0xd3, 0x44, 0xeb, 0xcf, 0xbf, 0x4c, 0xff, 0xb1, 0xd1, 0x7f, 0x4b, 0xeb, 0xe7, 0xc9, 0xaf, 0xe7,
+	0xa1, 0x62, 0xa8, 0xca, 0xc7, 0x43, 0xb4, 0xa4, 0xd2, 0xa8, 0xb7, 0xad, 0xbc, 0x87, 0xac, 0xbd,
+	0x39, 0x77, 0x33, 0xd3, 0xc9, 0x7f, 0x25, 0xb4, 0x22, 0xa7, 0xba, 0x0c, 0x04, 0xfe, 0x00, 0x55,
+	0x64, 0x16, 0x52, 0x6a, 0x49, 0x51, 0x37, 0x2e, 0x46, 0x75, 0x9c, 0xa5, 0xe8, 0x92, 0x89, 0x98,
+	0xd7, 0x49, 0x89, 0x47, 0x68, 0x25, 0xb5, 0x9f, 0x92, 0xcb, 0x8a, 0xbc, 0x95, 0xb5, 0x5a, 0x05,
+	0x00, 0xa1, 0xcb, 0xe6, 0x8d, 0x09, 0xe2, 0x7e, 0xf6, 0xfc, 0xac, 0x66, 0xbd, 0x38, 0xab, 0x59,
+	0xff, 0x9c, 0xd5, 0xac, 0x9f, 0xce, 0x6b, 0x33, 0x2f, 0xce, 0x6b, 0x33, 0x7f, 0x9d, 0xd7, 0x66,
+	0xbe, 0x7a, 0x30, 0x99, 0x0a, 0xde, 0xf4, 0xf6, 0x5b, 0x91, 0x33, 0x78, 0xe0, 0xf4, 0x22, 0xff,
+	0xb4, 0x0b, 0x42, 0xf6, 0x9c, 0xc2, 0x39, 0xfc, 0x70, 0x5f, 0xb6, 0x9b, 0x2a, 0x3b, 0xcd, 0x05,
+	0xd5, 0x4b, 0xbe, 0xff, 0xef, 0x00, 0x5f, 0xe9, 0x65, 0xee, 0x09, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -422,6 +660,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HandshakeBounties) > 0 {
+		for iNdEx := len(m.HandshakeBounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeBounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MinRelayerFees) > 0 {
+		for iNdEx := len(m.MinRelayerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinRelayerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -552,6 +832,20 @@ func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payees) > 0 {
+		for iNdEx := len(m.Payees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Payee) > 0 {
 		i -= len(m.Payee)
 		copy(dAtA[i:], m.Payee)
@@ -660,67 +954,226 @@ func (m *ForwardRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MinRelayerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MinRelayerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinRelayerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IdentifiedFees) > 0 {
-		for _, e := range m.IdentifiedFees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.FeeEnabledChannels) > 0 {
-		for _, e := range m.FeeEnabledChannels {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.RegisteredPayees) > 0 {
-		for _, e := range m.RegisteredPayees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.MinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.RegisteredCounterpartyPayees) > 0 {
-		for _, e := range m.RegisteredCounterpartyPayees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ForwardRelayers) > 0 {
-		for _, e := range m.ForwardRelayers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FeeEnabledChannel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TimeoutPackets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPackets))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.ChannelId)
+	if m.AckPackets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AckPackets))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RecvPackets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecvPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TimeoutFees) > 0 {
+		for iNdEx := len(m.TimeoutFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AckFees) > 0 {
+		for iNdEx := len(m.AckFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecvFees) > 0 {
+		for iNdEx := len(m.RecvFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IdentifiedFees) > 0 {
+		for _, e := range m.IdentifiedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeEnabledChannels) > 0 {
+		for _, e := range m.FeeEnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredPayees) > 0 {
+		for _, e := range m.RegisteredPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredCounterpartyPayees) > 0 {
+		for _, e := range m.RegisteredCounterpartyPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardRelayers) > 0 {
+		for _, e := range m.ForwardRelayers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinRelayerFees) > 0 {
+		for _, e := range m.MinRelayerFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HandshakeBounties) > 0 {
+		for _, e := range m.HandshakeBounties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEnabledChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
@@ -745,6 +1198,12 @@ func (m *RegisteredPayee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Payees) > 0 {
+		for _, e := range m.Payees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -784,6 +1243,69 @@ func (m *ForwardRelayerAddress) Size() (n int) {
 	return n
 }
 
+func (m *MinRelayerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RecvFees) > 0 {
+		for _, e := range m.RecvFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AckFees) > 0 {
+		for _, e := range m.AckFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimeoutFees) > 0 {
+		for _, e := range m.TimeoutFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RecvPackets != 0 {
+		n += 1 + sovGenesis(uint64(m.RecvPackets))
+	}
+	if m.AckPackets != 0 {
+		n += 1 + sovGenesis(uint64(m.AckPackets))
+	}
+	if m.TimeoutPackets != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutPackets))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -882,16 +1404,564 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
+			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredCounterpartyPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
+			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
+			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRelayerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinRelayerFees = append(m.MinRelayerFees, MinRelayerFee{})
+			if err := m.MinRelayerFees[len(m.MinRelayerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeBounties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandshakeBounties = append(m.HandshakeBounties, IdentifiedHandshakeBounties{})
+			if err := m.HandshakeBounties[len(m.HandshakeBounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payees = append(m.Payees, WeightedPayee{})
+			if err := m.Payees[len(m.Payees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -901,31 +1971,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
-			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredCounterpartyPayees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -935,31 +2003,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
-			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -969,25 +2035,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
-			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1010,7 +2074,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1033,15 +2097,15 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardRelayerAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardRelayerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1069,13 +2133,13 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1085,23 +2149,24 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1124,7 +2189,7 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+func (m *MinRelayerFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1147,15 +2212,15 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinRelayerFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinRelayerFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,11 +2248,11 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1215,13 +2280,13 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1231,23 +2296,24 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1270,7 +2336,7 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,15 +2359,15 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,11 +2395,11 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1361,13 +2427,13 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1377,79 +2443,31 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			m.RecvFees = append(m.RecvFees, types1.Coin{})
+			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardRelayerAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardRelayerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1459,27 +2477,29 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.AckFees = append(m.AckFees, types1.Coin{})
+			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,10 +2526,68 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
+			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPackets", wireType)
+			}
+			m.RecvPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPackets", wireType)
+			}
+			m.AckPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPackets", wireType)
+			}
+			m.TimeoutPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
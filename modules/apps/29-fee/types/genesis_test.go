@@ -93,6 +93,32 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid registered payee: weighted payees",
+			func() {
+				genState.RegisteredPayees[0].Payee = ""
+				genState.RegisteredPayees[0].Payees = []types.WeightedPayee{
+					types.NewWeightedPayee(defaultAccAddress, 7500),
+					types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 2500),
+				}
+			},
+			true,
+		},
+		{
+			"invalid registered payee: payee and weighted payees both set",
+			func() {
+				genState.RegisteredPayees[0].Payees = []types.WeightedPayee{types.NewWeightedPayee(defaultAccAddress, types.TotalPayeeWeight)}
+			},
+			false,
+		},
+		{
+			"invalid registered payee: invalid weighted payees",
+			func() {
+				genState.RegisteredPayees[0].Payee = ""
+				genState.RegisteredPayees[0].Payees = []types.WeightedPayee{types.NewWeightedPayee(defaultAccAddress, 0)}
+			},
+			false,
+		},
 		{
 			"invalid registered payee: invalid channel ID",
 			func() {
@@ -128,6 +154,69 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid min relayer fee: invalid port ID",
+			func() {
+				genState.MinRelayerFees[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid min relayer fee: invalid channel ID",
+			func() {
+				genState.MinRelayerFees[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid min relayer fee: all fees are zero",
+			func() {
+				genState.MinRelayerFees[0].MinFee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid relayer address",
+			func() {
+				genState.RelayerStats[0].Relayer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid channel ID",
+			func() {
+				genState.RelayerStats[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid fees",
+			func() {
+				genState.RelayerStats[0].AckFees = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid handshake bounties: invalid port ID",
+			func() {
+				genState.HandshakeBounties[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid handshake bounties: invalid channel ID",
+			func() {
+				genState.HandshakeBounties[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid handshake bounties: missing expiry",
+			func() {
+				genState.HandshakeBounties[0].HandshakeBounties[0].ExpiryHeight = 0
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -164,6 +253,26 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			MinRelayerFees: []types.MinRelayerFee{
+				{
+					PortId:    ibctesting.MockFeePort,
+					ChannelId: ibctesting.FirstChannelID,
+					MinFee:    types.NewFee(defaultRecvFee, nil, nil),
+				},
+			},
+			RelayerStats: []types.RelayerStats{
+				{
+					Relayer:     defaultAccAddress,
+					ChannelId:   ibctesting.FirstChannelID,
+					RecvFees:    defaultRecvFee,
+					RecvPackets: 1,
+				},
+			},
+			HandshakeBounties: []types.IdentifiedHandshakeBounties{
+				types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID, []types.HandshakeBounty{
+					types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress, 100, 0),
+				}),
+			},
 		}
 
 		tc.malleate()
@@ -31,6 +31,9 @@ const (
 	// PayeeKeyPrefix is the key prefix for the fee payee address stored in state
 	PayeeKeyPrefix = "payee"
 
+	// WeightedPayeeKeyPrefix is the key prefix for the weighted payees between which relayer fees are split
+	WeightedPayeeKeyPrefix = "weightedPayee"
+
 	// CounterpartyPayeeKeyPrefix is the key prefix for the counterparty payee address mapping
 	CounterpartyPayeeKeyPrefix = "counterpartyPayee"
 
@@ -39,6 +42,27 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// MinRelayerFeeKeyPrefix is the key prefix for the minimum relayer fees required on fee enabled channels
+	MinRelayerFeeKeyPrefix = "minRelayerFee"
+
+	// RelayerStatsKeyPrefix is the key prefix for the accumulated relayer earnings stored in state
+	RelayerStatsKeyPrefix = "relayerStats"
+
+	// ExpiryHeightKeyPrefix is the key prefix for the index of packet fees expiring at a block height
+	ExpiryHeightKeyPrefix = "expiryHeight"
+
+	// ExpiryTimestampKeyPrefix is the key prefix for the index of packet fees expiring at a block time
+	ExpiryTimestampKeyPrefix = "expiryTimestamp"
+
+	// HandshakeBountyKeyPrefix is the key prefix for handshake bounties escrowed for channels in the INIT state
+	HandshakeBountyKeyPrefix = "handshakeBounty"
+
+	// BountyExpiryHeightKeyPrefix is the key prefix for the index of handshake bounties expiring at a block height
+	BountyExpiryHeightKeyPrefix = "bountyExpiryHeight"
+
+	// BountyExpiryTimestampKeyPrefix is the key prefix for the index of handshake bounties expiring at a block time
+	BountyExpiryTimestampKeyPrefix = "bountyExpiryTimestamp"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -73,6 +97,38 @@ func ParseKeyFeeEnabled(key string) (portID, channelID string, err error) {
 	return portID, channelID, nil
 }
 
+// KeyMinRelayerFee returns the key that stores the minimum relayer fee required for packets sent on the given
+// port and channel identifiers.
+func KeyMinRelayerFee(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", MinRelayerFeeKeyPrefix, portID, channelID))
+}
+
+// ParseKeyMinRelayerFee parses the key used to store the minimum relayer fee and returns the port and channel identifiers
+func ParseKeyMinRelayerFee(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != MinRelayerFeeKeyPrefix {
+		return "", "", sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", MinRelayerFeeKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyRelayerStats returns the key that stores the accumulated earnings of a relayer on the given channel
+func KeyRelayerStats(relayerAddr, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RelayerStatsKeyPrefix, relayerAddr, channelID))
+}
+
+// KeyRelayerStatsPrefix returns the key prefix under which the accumulated earnings of a relayer are stored for each channel
+func KeyRelayerStatsPrefix(relayerAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RelayerStatsKeyPrefix, relayerAddr))
+}
+
 // KeyPayee returns the key for relayer address -> payee address mapping
 func KeyPayee(relayerAddr, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", PayeeKeyPrefix, relayerAddr, channelID))
@@ -90,6 +146,23 @@ func ParseKeyPayeeAddress(key string) (relayerAddr, channelID string, err error)
 	return keySplit[1], keySplit[2], nil
 }
 
+// KeyWeightedPayees returns the key for relayer address -> weighted payees mapping
+func KeyWeightedPayees(relayerAddr, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", WeightedPayeeKeyPrefix, relayerAddr, channelID))
+}
+
+// ParseKeyWeightedPayees returns the registered relayer address and channelID used to store the weighted payees
+func ParseKeyWeightedPayees(key string) (relayerAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
 // KeyCounterpartyPayee returns the key for relayer address -> counterparty payee address mapping
 func KeyCounterpartyPayee(address, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", CounterpartyPayeeKeyPrefix, address, channelID))
@@ -157,3 +230,100 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyExpiryHeight returns the key used to index packet fees on the given packet which expire at the provided block height.
+// The expiry is zero padded so that the index is iterated in ascending order of expiry.
+func KeyExpiryHeight(expiryHeight uint64, packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%s/%s/%d", ExpiryHeightKeyPrefix, expiryHeight, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
+
+// KeyExpiryTimestamp returns the key used to index packet fees on the given packet which expire at the provided block time.
+// The expiry is zero padded so that the index is iterated in ascending order of expiry.
+func KeyExpiryTimestamp(expiryTimestamp uint64, packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%s/%s/%d", ExpiryTimestampKeyPrefix, expiryTimestamp, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
+
+// ParseKeyPacketFeeExpiry parses a key of the packet fee expiry height or timestamp index and returns the expiry and packet id
+func ParseKeyPacketFeeExpiry(key string) (uint64, channeltypes.PacketId, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 5 {
+		return 0, channeltypes.PacketId{}, sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 5, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != ExpiryHeightKeyPrefix && keySplit[0] != ExpiryTimestampKeyPrefix {
+		return 0, channeltypes.PacketId{}, sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key prefix is incorrect: expected %s or %s, got %s", ExpiryHeightKeyPrefix, ExpiryTimestampKeyPrefix, keySplit[0],
+		)
+	}
+
+	expiry, err := strconv.ParseUint(keySplit[1], 10, 64)
+	if err != nil {
+		return 0, channeltypes.PacketId{}, err
+	}
+
+	seq, err := strconv.ParseUint(keySplit[4], 10, 64)
+	if err != nil {
+		return 0, channeltypes.PacketId{}, err
+	}
+
+	return expiry, channeltypes.NewPacketID(keySplit[2], keySplit[3], seq), nil
+}
+
+// KeyHandshakeBounties returns the key that stores the handshake bounties escrowed for the given port and channel identifiers
+func KeyHandshakeBounties(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", HandshakeBountyKeyPrefix, portID, channelID))
+}
+
+// ParseKeyHandshakeBounties parses the key used to store handshake bounties and returns the port and channel identifiers
+func ParseKeyHandshakeBounties(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != HandshakeBountyKeyPrefix {
+		return "", "", sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", HandshakeBountyKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyBountyExpiryHeight returns the key used to index handshake bounties on the given channel which expire at the provided
+// block height. The expiry is zero padded so that the index is iterated in ascending order of expiry.
+func KeyBountyExpiryHeight(expiryHeight uint64, portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%s/%s", BountyExpiryHeightKeyPrefix, expiryHeight, portID, channelID))
+}
+
+// KeyBountyExpiryTimestamp returns the key used to index handshake bounties on the given channel which expire at the provided
+// block time. The expiry is zero padded so that the index is iterated in ascending order of expiry.
+func KeyBountyExpiryTimestamp(expiryTimestamp uint64, portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%s/%s", BountyExpiryTimestampKeyPrefix, expiryTimestamp, portID, channelID))
+}
+
+// ParseKeyBountyExpiry parses a key of the handshake bounty expiry height or timestamp index and returns the expiry
+// and the port and channel identifiers
+func ParseKeyBountyExpiry(key string) (expiry uint64, portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 4 {
+		return 0, "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != BountyExpiryHeightKeyPrefix && keySplit[0] != BountyExpiryTimestampKeyPrefix {
+		return 0, "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key prefix is incorrect: expected %s or %s, got %s", BountyExpiryHeightKeyPrefix, BountyExpiryTimestampKeyPrefix, keySplit[0],
+		)
+	}
+
+	expiry, err = strconv.ParseUint(keySplit[1], 10, 64)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	return expiry, keySplit[2], keySplit[3], nil
+}
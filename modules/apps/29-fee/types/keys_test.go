@@ -49,6 +49,37 @@ func TestParseKeyPayee(t *testing.T) {
 	}
 }
 
+func TestParseKeyWeightedPayees(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyWeightedPayees("relayer-address", ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			"weightedPayee/relayer_address/transfer/channel-0",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		address, channelID, err := types.ParseKeyWeightedPayees(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, "relayer-address", address)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
 func TestKeyCounterpartyPayee(t *testing.T) {
 	var (
 		relayerAddress = "relayer_address"
@@ -102,6 +133,57 @@ func TestParseKeyFeeEnabled(t *testing.T) {
 	}
 }
 
+func TestKeyMinRelayerFee(t *testing.T) {
+	key := types.KeyMinRelayerFee(ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s", types.MinRelayerFeeKeyPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID))
+}
+
+func TestKeyRelayerStats(t *testing.T) {
+	key := types.KeyRelayerStats("relayer-address", ibctesting.FirstChannelID)
+	require.Equal(t, fmt.Sprintf("%s/%s/%s", types.RelayerStatsKeyPrefix, "relayer-address", ibctesting.FirstChannelID), string(key))
+
+	prefix := types.KeyRelayerStatsPrefix("relayer-address")
+	require.Equal(t, fmt.Sprintf("%s/%s/", types.RelayerStatsKeyPrefix, "relayer-address"), string(prefix))
+}
+
+func TestParseKeyMinRelayerFee(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyMinRelayerFee(ibctesting.MockPort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyFeeEnabled(ibctesting.MockPort, ibctesting.FirstChannelID)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		portID, channelID, err := types.ParseKeyMinRelayerFee(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockPort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+		}
+	}
+}
+
 func TestParseKeyFeesInEscrow(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -204,3 +286,128 @@ func TestParseKeyCounterpartyPayee(t *testing.T) {
 		}
 	}
 }
+
+func TestKeyPacketFeeExpiry(t *testing.T) {
+	key := types.KeyExpiryHeight(100, validPacketID)
+	require.Equal(t, fmt.Sprintf("%s/%020d/%s/%s/%d", types.ExpiryHeightKeyPrefix, 100, validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence), string(key))
+
+	key = types.KeyExpiryTimestamp(100, validPacketID)
+	require.Equal(t, fmt.Sprintf("%s/%020d/%s/%s/%d", types.ExpiryTimestampKeyPrefix, 100, validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence), string(key))
+
+	// keys must be ordered by expiry
+	require.Less(t, string(types.KeyExpiryHeight(9, validPacketID)), string(types.KeyExpiryHeight(10, validPacketID)))
+}
+
+func TestParseKeyPacketFeeExpiry(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success: expiry height",
+			string(types.KeyExpiryHeight(100, validPacketID)),
+			true,
+		},
+		{
+			"success: expiry timestamp",
+			string(types.KeyExpiryTimestamp(100, validPacketID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%d/%s/%s/%d", types.FeesInEscrowPrefix, 100, validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence),
+			false,
+		},
+		{
+			"incorrect key - expiry cannot be parsed",
+			fmt.Sprintf("%s/%s/%s/%s/%d", types.ExpiryHeightKeyPrefix, "expiry", validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence),
+			false,
+		},
+		{
+			"incorrect key - sequence cannot be parsed",
+			fmt.Sprintf("%s/%d/%s/%s/%s", types.ExpiryHeightKeyPrefix, 100, validPacketID.PortId, validPacketID.ChannelId, "sequence"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		expiry, packetID, err := types.ParseKeyPacketFeeExpiry(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, uint64(100), expiry, tc.name)
+			require.Equal(t, validPacketID, packetID, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestKeyHandshakeBounties(t *testing.T) {
+	key := types.KeyHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.Equal(t, fmt.Sprintf("%s/%s/%s", types.HandshakeBountyKeyPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID), string(key))
+
+	portID, channelID, err := types.ParseKeyHandshakeBounties(string(key))
+	require.NoError(t, err)
+	require.Equal(t, ibctesting.MockFeePort, portID)
+	require.Equal(t, ibctesting.FirstChannelID, channelID)
+
+	_, _, err = types.ParseKeyHandshakeBounties(string(types.KeyFeeEnabled(ibctesting.MockFeePort, ibctesting.FirstChannelID)))
+	require.Error(t, err)
+
+	_, _, err = types.ParseKeyHandshakeBounties(string(types.KeyFeesInEscrow(validPacketID)))
+	require.Error(t, err)
+}
+
+func TestParseKeyBountyExpiry(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success: expiry height",
+			string(types.KeyBountyExpiryHeight(100, ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"success: expiry timestamp",
+			string(types.KeyBountyExpiryTimestamp(100, ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			false,
+		},
+		{
+			"incorrect key - expiry cannot be parsed",
+			fmt.Sprintf("%s/%s/%s/%s", types.BountyExpiryHeightKeyPrefix, "expiry", ibctesting.MockFeePort, ibctesting.FirstChannelID),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		expiry, portID, channelID, err := types.ParseKeyBountyExpiry(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, uint64(100), expiry, tc.name)
+			require.Equal(t, ibctesting.MockFeePort, portID, tc.name)
+			require.Equal(t, ibctesting.FirstChannelID, channelID, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
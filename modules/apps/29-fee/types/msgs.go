@@ -12,8 +12,9 @@ import (
 
 // msg types
 const (
-	TypeMsgPayPacketFee      = "payPacketFee"
-	TypeMsgPayPacketFeeAsync = "payPacketFeeAsync"
+	TypeMsgPayPacketFee       = "payPacketFee"
+	TypeMsgPayPacketFeeAsync  = "payPacketFeeAsync"
+	TypeMsgPayHandshakeBounty = "payHandshakeBounty"
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -26,6 +27,16 @@ func NewMsgRegisterPayee(portID, channelID, relayerAddr, payeeAddr string) *MsgR
 	}
 }
 
+// NewMsgRegisterWeightedPayees creates a new instance of MsgRegisterPayee which splits fees between the provided weighted payees
+func NewMsgRegisterWeightedPayees(portID, channelID, relayerAddr string, payees []WeightedPayee) *MsgRegisterPayee {
+	return &MsgRegisterPayee{
+		PortId:    portID,
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+		Payees:    payees,
+	}
+}
+
 // ValidateBasic implements sdk.Msg and performs basic stateless validation
 func (msg MsgRegisterPayee) ValidateBasic() error {
 	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
@@ -36,15 +47,23 @@ func (msg MsgRegisterPayee) ValidateBasic() error {
 		return err
 	}
 
-	if msg.Relayer == msg.Payee {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relayer address and payee must not be equal")
-	}
-
 	_, err := sdk.AccAddressFromBech32(msg.Relayer)
 	if err != nil {
 		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from relayer address")
 	}
 
+	if len(msg.Payees) != 0 {
+		if msg.Payee != "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "payee and weighted payees must not both be set")
+		}
+
+		return ValidateWeightedPayees(msg.Payees)
+	}
+
+	if msg.Relayer == msg.Payee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relayer address and payee must not be equal")
+	}
+
 	_, err = sdk.AccAddressFromBech32(msg.Payee)
 	if err != nil {
 		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from payee address")
@@ -214,3 +233,120 @@ func (msg MsgPayPacketFeeAsync) Type() string {
 func (msg MsgPayPacketFeeAsync) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgUpdateMinRelayerFee creates a new instance of MsgUpdateMinRelayerFee
+func NewMsgUpdateMinRelayerFee(authority, portID, channelID string, minFee Fee) *MsgUpdateMinRelayerFee {
+	return &MsgUpdateMinRelayerFee{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+		MinFee:    minFee,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateMinRelayerFee fields
+func (msg MsgUpdateMinRelayerFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Authority into sdk.AccAddress")
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	return msg.MinFee.Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateMinRelayerFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgRemoveMinRelayerFee creates a new instance of MsgRemoveMinRelayerFee
+func NewMsgRemoveMinRelayerFee(authority, portID, channelID string) *MsgRemoveMinRelayerFee {
+	return &MsgRemoveMinRelayerFee{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRemoveMinRelayerFee fields
+func (msg MsgRemoveMinRelayerFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Authority into sdk.AccAddress")
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(msg.ChannelId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveMinRelayerFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgPayHandshakeBounty creates a new instance of MsgPayHandshakeBounty
+func NewMsgPayHandshakeBounty(portID, channelID string, handshakeBounty HandshakeBounty) *MsgPayHandshakeBounty {
+	return &MsgPayHandshakeBounty{
+		PortId:          portID,
+		ChannelId:       channelID,
+		HandshakeBounty: handshakeBounty,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgPayHandshakeBounty fields
+func (msg MsgPayHandshakeBounty) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	return msg.HandshakeBounty.Validate()
+}
+
+// GetSigners implements sdk.Msg
+// The signer of the handshake bounty message must be the refund address
+func (msg MsgPayHandshakeBounty) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.HandshakeBounty.RefundAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgPayHandshakeBounty) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgPayHandshakeBounty) Type() string {
+	return TypeMsgPayHandshakeBounty
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgPayHandshakeBounty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
@@ -61,6 +61,32 @@ func TestMsgRegisterPayeeValidation(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"success: weighted payees",
+			func() {
+				msg.Payee = ""
+				msg.Payees = []types.WeightedPayee{
+					types.NewWeightedPayee(defaultAccAddress, 6000),
+					types.NewWeightedPayee(msg.Relayer, 4000),
+				}
+			},
+			true,
+		},
+		{
+			"both payee and weighted payees are set",
+			func() {
+				msg.Payees = []types.WeightedPayee{types.NewWeightedPayee(defaultAccAddress, types.TotalPayeeWeight)}
+			},
+			false,
+		},
+		{
+			"invalid weighted payees",
+			func() {
+				msg.Payee = ""
+				msg.Payees = []types.WeightedPayee{types.NewWeightedPayee(defaultAccAddress, 5000)}
+			},
+			false,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -407,3 +433,227 @@ func TestMsgPayPacketFeeAsyncGetSignBytes(t *testing.T) {
 		_ = msg.GetSignBytes()
 	})
 }
+
+func TestMsgUpdateMinRelayerFeeValidation(t *testing.T) {
+	var msg *types.MsgUpdateMinRelayerFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: only recv fee required",
+			func() {
+				msg.MinFee = types.NewFee(defaultRecvFee, nil, nil)
+			},
+			true,
+		},
+		{
+			"invalid authority address",
+			func() {
+				msg.Authority = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid fee: all fees are zero",
+			func() {
+				msg.MinFee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			false,
+		},
+		{
+			"invalid fee: invalid recv fee",
+			func() {
+				msg.MinFee.RecvFee = invalidFee
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgUpdateMinRelayerFee(defaultAccAddress, ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestUpdateMinRelayerFeeGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateMinRelayerFee(accAddress.String(), ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, nil, nil))
+	require.Equal(t, []sdk.AccAddress{accAddress}, msg.GetSigners())
+}
+
+func TestMsgRemoveMinRelayerFeeValidation(t *testing.T) {
+	var msg *types.MsgRemoveMinRelayerFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority address",
+			func() {
+				msg.Authority = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgRemoveMinRelayerFee(defaultAccAddress, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestRemoveMinRelayerFeeGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRemoveMinRelayerFee(accAddress.String(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.Equal(t, []sdk.AccAddress{accAddress}, msg.GetSigners())
+}
+
+func TestMsgPayHandshakeBountyValidation(t *testing.T) {
+	var msg *types.MsgPayHandshakeBounty
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: expiry timestamp only",
+			func() {
+				msg.HandshakeBounty.ExpiryHeight = 0
+				msg.HandshakeBounty.ExpiryTimestamp = 1000
+			},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.HandshakeBounty.RefundAddress = "invalid-address"
+			},
+			false,
+		},
+		{
+			"empty bounty",
+			func() {
+				msg.HandshakeBounty.Bounty = sdk.Coins{}
+			},
+			false,
+		},
+		{
+			"invalid bounty",
+			func() {
+				msg.HandshakeBounty.Bounty = invalidFee
+			},
+			false,
+		},
+		{
+			"expiry height and expiry timestamp are both empty",
+			func() {
+				msg.HandshakeBounty.ExpiryHeight = 0
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		handshakeBounty := types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress, 100, 0)
+		msg = types.NewMsgPayHandshakeBounty(ibctesting.MockFeePort, ibctesting.FirstChannelID, handshakeBounty)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestPayHandshakeBountyGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	handshakeBounty := types.NewHandshakeBounty(defaultRecvFee, refundAddr.String(), 100, 0)
+	msg := types.NewMsgPayHandshakeBounty(ibctesting.MockFeePort, ibctesting.FirstChannelID, handshakeBounty)
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// TotalPayeeWeight is the sum of weights, in basis points, that a list of weighted payees must add up to
+	TotalPayeeWeight uint64 = 10000
+
+	// MaxWeightedPayees is the maximum number of weighted payees which may be registered for a relayer on a channel
+	MaxWeightedPayees = 10
+)
+
+// NewWeightedPayee creates and returns a new WeightedPayee struct
+func NewWeightedPayee(address string, weight uint64) WeightedPayee {
+	return WeightedPayee{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+// ValidateWeightedPayees performs basic stateless validation of a list of weighted payees.
+// The list must not be empty or exceed MaxWeightedPayees, each address must be valid and unique,
+// each weight must be non-zero and the weights must sum to TotalPayeeWeight.
+func ValidateWeightedPayees(payees []WeightedPayee) error {
+	if len(payees) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPayeeWeights, "weighted payees must not be empty")
+	}
+
+	if len(payees) > MaxWeightedPayees {
+		return sdkerrors.Wrapf(ErrInvalidPayeeWeights, "number of weighted payees (%d) exceeds maximum (%d)", len(payees), MaxWeightedPayees)
+	}
+
+	var totalWeight uint64
+	seen := make(map[string]bool)
+	for _, payee := range payees {
+		if _, err := sdk.AccAddressFromBech32(payee.Address); err != nil {
+			return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from payee address")
+		}
+
+		if seen[payee.Address] {
+			return sdkerrors.Wrapf(ErrInvalidPayeeWeights, "duplicate payee address: %s", payee.Address)
+		}
+		seen[payee.Address] = true
+
+		if payee.Weight == 0 || payee.Weight > TotalPayeeWeight {
+			return sdkerrors.Wrapf(ErrInvalidPayeeWeights, "payee weight must be between 1 and %d, got %d", TotalPayeeWeight, payee.Weight)
+		}
+
+		totalWeight += payee.Weight
+	}
+
+	if totalWeight != TotalPayeeWeight {
+		return sdkerrors.Wrapf(ErrInvalidPayeeWeights, "payee weights must sum to %d, got %d", TotalPayeeWeight, totalWeight)
+	}
+
+	return nil
+}
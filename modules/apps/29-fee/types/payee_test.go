@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+)
+
+func TestValidateWeightedPayees(t *testing.T) {
+	var payees []types.WeightedPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: single payee",
+			func() {
+				payees = []types.WeightedPayee{types.NewWeightedPayee(defaultAccAddress, types.TotalPayeeWeight)}
+			},
+			true,
+		},
+		{
+			"empty payees",
+			func() {
+				payees = nil
+			},
+			false,
+		},
+		{
+			"too many payees",
+			func() {
+				payees = nil
+				for i := 0; i < types.MaxWeightedPayees+1; i++ {
+					payees = append(payees, types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 1))
+				}
+			},
+			false,
+		},
+		{
+			"invalid payee address",
+			func() {
+				payees[0].Address = "invalid-address"
+			},
+			false,
+		},
+		{
+			"duplicate payee address",
+			func() {
+				payees[1].Address = payees[0].Address
+			},
+			false,
+		},
+		{
+			"zero weight",
+			func() {
+				payees[0].Weight = 0
+				payees[1].Weight = types.TotalPayeeWeight
+			},
+			false,
+		},
+		{
+			"weights sum to less than total",
+			func() {
+				payees[0].Weight = 1000
+			},
+			false,
+		},
+		{
+			"weights sum to more than total",
+			func() {
+				payees[0].Weight = 9000
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		payees = []types.WeightedPayee{
+			types.NewWeightedPayee(defaultAccAddress, 5000),
+			types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 3000),
+			types.NewWeightedPayee(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 2000),
+		}
+
+		tc.malleate()
+
+		err := types.ValidateWeightedPayees(payees)
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
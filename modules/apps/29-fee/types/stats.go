@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// NewRelayerStats creates and returns a new RelayerStats struct with no accumulated earnings
+func NewRelayerStats(relayerAddr, channelID string) RelayerStats {
+	return RelayerStats{
+		Relayer:   relayerAddr,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic stateless validation of the associated RelayerStats
+func (rs RelayerStats) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rs.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+	}
+
+	if err := host.ChannelIdentifierValidator(rs.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel identifier: %s", rs.ChannelId)
+	}
+
+	if !rs.RecvFees.IsValid() || !rs.AckFees.IsValid() || !rs.TimeoutFees.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "relayer stats contain invalid fees")
+	}
+
+	return nil
+}
@@ -39,6 +39,8 @@ type MsgRegisterPayee struct {
 	Relayer string `protobuf:"bytes,3,opt,name=relayer,proto3" json:"relayer,omitempty"`
 	// the payee address
 	Payee string `protobuf:"bytes,4,opt,name=payee,proto3" json:"payee,omitempty"`
+	// the weighted payees between which fees are split, set instead of payee
+	Payees []WeightedPayee `protobuf:"bytes,5,rep,name=payees,proto3" json:"payees"`
 }
 
 func (m *MsgRegisterPayee) Reset()         { *m = MsgRegisterPayee{} }
@@ -358,6 +360,248 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgUpdateMinRelayerFee defines the request type for the UpdateMinRelayerFee rpc
+type MsgUpdateMinRelayerFee struct {
+	// the address of the module authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// unique port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the minimum fee required to be escrowed for each packet
+	MinFee Fee `protobuf:"bytes,4,opt,name=min_fee,json=minFee,proto3" json:"min_fee" yaml:"min_fee"`
+}
+
+func (m *MsgUpdateMinRelayerFee) Reset()         { *m = MsgUpdateMinRelayerFee{} }
+func (m *MsgUpdateMinRelayerFee) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinRelayerFee) ProtoMessage()    {}
+func (*MsgUpdateMinRelayerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgUpdateMinRelayerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinRelayerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinRelayerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMinRelayerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinRelayerFee.Merge(m, src)
+}
+func (m *MsgUpdateMinRelayerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinRelayerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinRelayerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMinRelayerFee proto.InternalMessageInfo
+
+// MsgUpdateMinRelayerFeeResponse defines the response type for the UpdateMinRelayerFee rpc
+type MsgUpdateMinRelayerFeeResponse struct {
+}
+
+func (m *MsgUpdateMinRelayerFeeResponse) Reset()         { *m = MsgUpdateMinRelayerFeeResponse{} }
+func (m *MsgUpdateMinRelayerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinRelayerFeeResponse) ProtoMessage()    {}
+func (*MsgUpdateMinRelayerFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgUpdateMinRelayerFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinRelayerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinRelayerFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMinRelayerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinRelayerFeeResponse.Merge(m, src)
+}
+func (m *MsgUpdateMinRelayerFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinRelayerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinRelayerFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMinRelayerFeeResponse proto.InternalMessageInfo
+
+// MsgRemoveMinRelayerFee defines the request type for the RemoveMinRelayerFee rpc
+type MsgRemoveMinRelayerFee struct {
+	// the address of the module authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// unique port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *MsgRemoveMinRelayerFee) Reset()         { *m = MsgRemoveMinRelayerFee{} }
+func (m *MsgRemoveMinRelayerFee) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMinRelayerFee) ProtoMessage()    {}
+func (*MsgRemoveMinRelayerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *MsgRemoveMinRelayerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMinRelayerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMinRelayerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMinRelayerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMinRelayerFee.Merge(m, src)
+}
+func (m *MsgRemoveMinRelayerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMinRelayerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMinRelayerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMinRelayerFee proto.InternalMessageInfo
+
+// MsgRemoveMinRelayerFeeResponse defines the response type for the RemoveMinRelayerFee rpc
+type MsgRemoveMinRelayerFeeResponse struct {
+}
+
+func (m *MsgRemoveMinRelayerFeeResponse) Reset()         { *m = MsgRemoveMinRelayerFeeResponse{} }
+func (m *MsgRemoveMinRelayerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMinRelayerFeeResponse) ProtoMessage()    {}
+func (*MsgRemoveMinRelayerFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
+}
+func (m *MsgRemoveMinRelayerFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMinRelayerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMinRelayerFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMinRelayerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMinRelayerFeeResponse.Merge(m, src)
+}
+func (m *MsgRemoveMinRelayerFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMinRelayerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMinRelayerFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMinRelayerFeeResponse proto.InternalMessageInfo
+
+// MsgPayHandshakeBounty defines the request type for the PayHandshakeBounty rpc
+type MsgPayHandshakeBounty struct {
+	// unique port identifier of the channel on the initializing chain
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier of the channel on the initializing chain
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the handshake bounty to be escrowed
+	HandshakeBounty HandshakeBounty `protobuf:"bytes,3,opt,name=handshake_bounty,json=handshakeBounty,proto3" json:"handshake_bounty" yaml:"handshake_bounty"`
+}
+
+func (m *MsgPayHandshakeBounty) Reset()         { *m = MsgPayHandshakeBounty{} }
+func (m *MsgPayHandshakeBounty) String() string { return proto.CompactTextString(m) }
+func (*MsgPayHandshakeBounty) ProtoMessage()    {}
+func (*MsgPayHandshakeBounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgPayHandshakeBounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayHandshakeBounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayHandshakeBounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayHandshakeBounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayHandshakeBounty.Merge(m, src)
+}
+func (m *MsgPayHandshakeBounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayHandshakeBounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayHandshakeBounty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayHandshakeBounty proto.InternalMessageInfo
+
+// MsgPayHandshakeBountyResponse defines the response type for the PayHandshakeBounty rpc
+type MsgPayHandshakeBountyResponse struct {
+}
+
+func (m *MsgPayHandshakeBountyResponse) Reset()         { *m = MsgPayHandshakeBountyResponse{} }
+func (m *MsgPayHandshakeBountyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayHandshakeBountyResponse) ProtoMessage()    {}
+func (*MsgPayHandshakeBountyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgPayHandshakeBountyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayHandshakeBountyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayHandshakeBountyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayHandshakeBountyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayHandshakeBountyResponse.Merge(m, src)
+}
+func (m *MsgPayHandshakeBountyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayHandshakeBountyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayHandshakeBountyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayHandshakeBountyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -367,56 +611,77 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgUpdateMinRelayerFee)(nil), "ibc.applications.fee.v1.MsgUpdateMinRelayerFee")
+	proto.RegisterType((*MsgUpdateMinRelayerFeeResponse)(nil), "ibc.applications.fee.v1.MsgUpdateMinRelayerFeeResponse")
+	proto.RegisterType((*MsgRemoveMinRelayerFee)(nil), "ibc.applications.fee.v1.MsgRemoveMinRelayerFee")
+	proto.RegisterType((*MsgRemoveMinRelayerFeeResponse)(nil), "ibc.applications.fee.v1.MsgRemoveMinRelayerFeeResponse")
+	proto.RegisterType((*MsgPayHandshakeBounty)(nil), "ibc.applications.fee.v1.MsgPayHandshakeBounty")
+	proto.RegisterType((*MsgPayHandshakeBountyResponse)(nil), "ibc.applications.fee.v1.MsgPayHandshakeBountyResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xfe, 0x65, 0x5a, 0xda, 0x66, 0xd5, 0x52, 0xc7, 0x6a, 0xe3, 0x62, 0x21, 0x54,
-	0x84, 0x6a, 0x93, 0xd0, 0x1c, 0xa8, 0x84, 0x10, 0xa9, 0x54, 0x51, 0x89, 0x8a, 0xc8, 0xe2, 0x84,
-	0x90, 0x2a, 0xc7, 0xd9, 0xb8, 0x86, 0xc4, 0x6b, 0x79, 0x9d, 0x08, 0xbf, 0x01, 0xc7, 0xf2, 0x06,
-	0x7d, 0x03, 0x5e, 0xa3, 0xc7, 0x1e, 0x38, 0x70, 0xb2, 0x50, 0x7b, 0xe1, 0x86, 0x14, 0x5e, 0x00,
-	0xad, 0xbd, 0x36, 0x4e, 0xa2, 0x54, 0x81, 0x13, 0xb7, 0x9d, 0x99, 0x6f, 0xbe, 0x9d, 0xf9, 0x3c,
-	0xe3, 0x85, 0x1d, 0xbb, 0x69, 0x6a, 0x86, 0xeb, 0x76, 0x6c, 0xd3, 0xf0, 0x6d, 0xe2, 0x50, 0xad,
-	0x8d, 0xb1, 0xd6, 0xaf, 0x68, 0xfe, 0x47, 0xd5, 0xf5, 0x88, 0x4f, 0xd0, 0xa6, 0xdd, 0x34, 0xd5,
-	0x2c, 0x42, 0x6d, 0x63, 0xac, 0xf6, 0x2b, 0xd2, 0xba, 0x45, 0x2c, 0x12, 0x61, 0x34, 0x76, 0x8a,
-	0xe1, 0xd2, 0xbd, 0x49, 0x84, 0x2c, 0x2b, 0x03, 0x31, 0x89, 0x87, 0x35, 0xf3, 0xcc, 0x70, 0x1c,
-	0xdc, 0x61, 0x61, 0x7e, 0x8c, 0x21, 0xca, 0x17, 0x01, 0xd6, 0x4e, 0xa8, 0xa5, 0x63, 0xcb, 0xa6,
-	0x3e, 0xf6, 0x1a, 0x46, 0x80, 0x31, 0x7a, 0x04, 0x0b, 0x2e, 0xf1, 0xfc, 0x53, 0xbb, 0x25, 0x0a,
-	0x3b, 0xc2, 0x6e, 0xa1, 0x8e, 0x06, 0xa1, 0xbc, 0x12, 0x18, 0xdd, 0xce, 0x81, 0xc2, 0x03, 0x8a,
-	0x3e, 0xcf, 0x4e, 0xc7, 0x2d, 0xb4, 0x0f, 0xc0, 0x29, 0x19, 0x7e, 0x26, 0xc2, 0x6f, 0x0c, 0x42,
-	0xb9, 0x18, 0xe3, 0xff, 0xc4, 0x14, 0xbd, 0xc0, 0x8d, 0xe3, 0x16, 0x12, 0x61, 0xc1, 0xc3, 0x1d,
-	0x23, 0xc0, 0x9e, 0x98, 0x67, 0x29, 0x7a, 0x62, 0xa2, 0x75, 0x98, 0x73, 0x59, 0x15, 0xe2, 0x6c,
-	0xe4, 0x8f, 0x8d, 0x83, 0xc5, 0x4f, 0x17, 0x72, 0xee, 0xc7, 0x85, 0x9c, 0x53, 0x24, 0x10, 0x47,
-	0x0b, 0xd6, 0x31, 0x75, 0x89, 0x43, 0xb1, 0xf2, 0x4b, 0x80, 0xad, 0x4c, 0xf0, 0x90, 0xf4, 0x1c,
-	0x1f, 0x7b, 0xae, 0xe1, 0xf9, 0xc1, 0x7f, 0xd0, 0xd9, 0x2b, 0x40, 0x66, 0xa6, 0xa2, 0xd3, 0x4c,
-	0x9b, 0xf5, 0xed, 0x41, 0x28, 0x97, 0x38, 0xef, 0x18, 0x46, 0xd1, 0x8b, 0xe6, 0x68, 0x2b, 0x19,
-	0x45, 0x1e, 0xc0, 0xfd, 0xdb, 0x9a, 0x4e, 0xd5, 0x39, 0x9f, 0x81, 0xd5, 0x13, 0x6a, 0x35, 0x8c,
-	0xa0, 0x61, 0x98, 0x1f, 0xb0, 0x7f, 0x84, 0x31, 0xda, 0x87, 0x7c, 0x1b, 0xe3, 0x48, 0x8c, 0xa5,
-	0xea, 0x96, 0x3a, 0x61, 0x04, 0xd5, 0x23, 0x8c, 0xeb, 0xb3, 0x97, 0xa1, 0x9c, 0xd3, 0x19, 0x1c,
-	0x3d, 0x87, 0x15, 0x4a, 0x7a, 0x9e, 0x89, 0x4f, 0x13, 0x35, 0x63, 0x75, 0x4a, 0x83, 0x50, 0xde,
-	0x88, 0xbb, 0x18, 0x8e, 0x2b, 0xfa, 0x72, 0xec, 0x68, 0xc4, 0xd2, 0xbe, 0x84, 0x22, 0x07, 0x64,
-	0x14, 0x8e, 0xe4, 0xaa, 0x6f, 0x0d, 0x42, 0x59, 0x1c, 0xe2, 0xc8, 0x0a, 0xbd, 0x1a, 0xfb, 0x0e,
-	0x53, 0xb9, 0xef, 0xc2, 0x3c, 0xb5, 0x2d, 0x07, 0x7b, 0x7c, 0x5e, 0xb8, 0x85, 0x24, 0x58, 0xe4,
-	0xba, 0x53, 0x71, 0x6e, 0x27, 0xbf, 0x5b, 0xd0, 0x53, 0x3b, 0x23, 0x5d, 0x09, 0x36, 0x47, 0x14,
-	0x49, 0xd5, 0xfa, 0x2a, 0xc0, 0xfa, 0x48, 0xec, 0x05, 0x0d, 0x1c, 0x13, 0xbd, 0x81, 0x82, 0x1b,
-	0x79, 0x92, 0x29, 0x5a, 0xaa, 0x6e, 0x47, 0xc2, 0xb1, 0x4d, 0x53, 0x93, 0xf5, 0xea, 0x57, 0xd4,
-	0x38, 0xef, 0xb8, 0x55, 0x17, 0x99, 0x72, 0x83, 0x50, 0x5e, 0xe3, 0x83, 0x96, 0x64, 0x2b, 0xfa,
-	0xa2, 0xcb, 0x31, 0xe8, 0x1d, 0x00, 0xf7, 0xb3, 0xef, 0x31, 0x13, 0xd1, 0x2a, 0x13, 0xbf, 0x47,
-	0x5a, 0x52, 0xbd, 0xc4, 0xb9, 0x8b, 0x43, 0xdc, 0x6d, 0x36, 0x34, 0xbc, 0xcc, 0xa3, 0xa1, 0x61,
-	0x29, 0x47, 0x1b, 0x32, 0xd6, 0x55, 0xd2, 0x76, 0xf5, 0x67, 0x1e, 0xf2, 0x27, 0xd4, 0x42, 0x5d,
-	0xb8, 0x33, 0xfc, 0x53, 0x78, 0x38, 0xb1, 0x98, 0xd1, 0x75, 0x94, 0x2a, 0x53, 0x43, 0x93, 0x6b,
-	0xd1, 0x67, 0x01, 0x4a, 0x93, 0xd7, 0xb6, 0x36, 0x0d, 0xe1, 0x58, 0x9a, 0xf4, 0xec, 0x9f, 0xd2,
-	0xd2, 0x9a, 0xde, 0xc3, 0xf2, 0xd0, 0xae, 0xec, 0xde, 0x46, 0x97, 0x45, 0x4a, 0x8f, 0xa7, 0x45,
-	0xa6, 0x77, 0x05, 0x50, 0x1c, 0x9f, 0xb4, 0xbd, 0x69, 0x69, 0x22, 0xb8, 0x54, 0xfb, 0x2b, 0x78,
-	0x72, 0x75, 0xfd, 0xf5, 0xe5, 0x75, 0x59, 0xb8, 0xba, 0x2e, 0x0b, 0xdf, 0xaf, 0xcb, 0xc2, 0xf9,
-	0x4d, 0x39, 0x77, 0x75, 0x53, 0xce, 0x7d, 0xbb, 0x29, 0xe7, 0xde, 0xd6, 0x2c, 0xdb, 0x3f, 0xeb,
-	0x35, 0x55, 0x93, 0x74, 0x35, 0x93, 0xd0, 0x2e, 0xa1, 0x9a, 0xdd, 0x34, 0xf7, 0x2c, 0xa2, 0xf5,
-	0x6b, 0x5a, 0x97, 0xb4, 0x7a, 0x1d, 0x4c, 0xd9, 0x13, 0x44, 0xb5, 0xea, 0xd3, 0x3d, 0xf6, 0xfa,
-	0xf8, 0x81, 0x8b, 0x69, 0x73, 0x3e, 0x7a, 0x5a, 0x9e, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x2a,
-	0x83, 0xa4, 0x70, 0xf3, 0x06, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0xa6, 0x9b, 0x36, 0xaf, 0xcb, 0xb6, 0x19, 0xfa, 0x23, 0xb1, 0xd2, 0xb8, 0x58,
+	0x68, 0x55, 0x84, 0x6a, 0xd3, 0xb0, 0x65, 0xc5, 0x4a, 0x08, 0xe1, 0x45, 0xd5, 0x56, 0x22, 0xa2,
+	0xb2, 0x40, 0x48, 0x08, 0xa9, 0x72, 0x9c, 0xa9, 0x63, 0x36, 0xf1, 0x58, 0x1e, 0x27, 0xc2, 0x12,
+	0x12, 0x57, 0x8e, 0xcb, 0x7f, 0xb0, 0x12, 0xff, 0xcc, 0x1e, 0xf7, 0xc0, 0x81, 0x53, 0x84, 0xda,
+	0x0b, 0x37, 0xa4, 0x20, 0x2e, 0x9c, 0xd0, 0x78, 0xc6, 0x5e, 0x27, 0x71, 0x42, 0xc2, 0x01, 0xed,
+	0xcd, 0x33, 0xf3, 0x7d, 0x6f, 0xde, 0xfb, 0xbc, 0x37, 0x33, 0x86, 0x23, 0xb7, 0x6d, 0xeb, 0x96,
+	0xef, 0xf7, 0x5c, 0xdb, 0x0a, 0x5d, 0xe2, 0x51, 0xfd, 0x1a, 0x63, 0x7d, 0x78, 0xaa, 0x87, 0xdf,
+	0x69, 0x7e, 0x40, 0x42, 0x82, 0x0e, 0xdc, 0xb6, 0xad, 0x65, 0x15, 0xda, 0x35, 0xc6, 0xda, 0xf0,
+	0x54, 0xde, 0x75, 0x88, 0x43, 0x62, 0x8d, 0xce, 0xbe, 0xb8, 0x5c, 0x7e, 0x6b, 0x9e, 0x43, 0x66,
+	0x95, 0x91, 0xd8, 0x24, 0xc0, 0xba, 0xdd, 0xb5, 0x3c, 0x0f, 0xf7, 0xd8, 0xb2, 0xf8, 0xe4, 0x12,
+	0xf5, 0x2f, 0x09, 0x76, 0x5a, 0xd4, 0x31, 0xb1, 0xe3, 0xd2, 0x10, 0x07, 0x97, 0x56, 0x84, 0x31,
+	0x7a, 0x17, 0x36, 0x7c, 0x12, 0x84, 0x57, 0x6e, 0xa7, 0x2a, 0x1d, 0x49, 0xc7, 0x65, 0x03, 0x8d,
+	0x47, 0xca, 0xbd, 0xc8, 0xea, 0xf7, 0x1e, 0xa9, 0x62, 0x41, 0x35, 0x4b, 0xec, 0xeb, 0xa2, 0x83,
+	0x1e, 0x00, 0x08, 0x97, 0x4c, 0xbf, 0x16, 0xeb, 0xf7, 0xc6, 0x23, 0xa5, 0xc2, 0xf5, 0xaf, 0xd6,
+	0x54, 0xb3, 0x2c, 0x06, 0x17, 0x1d, 0x54, 0x85, 0x8d, 0x00, 0xf7, 0xac, 0x08, 0x07, 0xd5, 0x22,
+	0x33, 0x31, 0x93, 0x21, 0xda, 0x85, 0x3b, 0x3e, 0x8b, 0xa2, 0xba, 0x1e, 0xcf, 0xf3, 0x01, 0xfa,
+	0x14, 0x4a, 0xf1, 0x07, 0xad, 0xde, 0x39, 0x2a, 0x1e, 0x6f, 0x35, 0xef, 0x6b, 0x73, 0x68, 0x69,
+	0x5f, 0x61, 0xd7, 0xe9, 0x86, 0xb8, 0x13, 0xa7, 0x62, 0xac, 0xbf, 0x18, 0x29, 0x05, 0x53, 0xd8,
+	0x3e, 0xda, 0xfc, 0xf1, 0xb9, 0x52, 0xf8, 0xfd, 0xb9, 0x52, 0x50, 0x65, 0xa8, 0x4e, 0xa7, 0x6d,
+	0x62, 0xea, 0x13, 0x8f, 0x62, 0xf5, 0x4f, 0x09, 0xea, 0x99, 0xc5, 0xc7, 0x64, 0xe0, 0x85, 0x38,
+	0xf0, 0xad, 0x20, 0x8c, 0x5e, 0x03, 0x3e, 0x9f, 0x01, 0xb2, 0x33, 0x11, 0x5d, 0x65, 0x60, 0x19,
+	0x87, 0xe3, 0x91, 0x52, 0x13, 0x7e, 0x67, 0x34, 0xaa, 0x59, 0xb1, 0xa7, 0x53, 0xc9, 0x10, 0xb9,
+	0x0f, 0x6f, 0x2f, 0x4a, 0x3a, 0xa5, 0xf3, 0x6c, 0x0d, 0xb6, 0x5b, 0xd4, 0xb9, 0xb4, 0xa2, 0x4b,
+	0xcb, 0x7e, 0x8a, 0xc3, 0x73, 0x8c, 0xd1, 0x03, 0x28, 0x5e, 0x63, 0x1c, 0xc3, 0xd8, 0x6a, 0xd6,
+	0xe7, 0x96, 0xe6, 0x3c, 0x2d, 0x08, 0x93, 0xa3, 0x8f, 0xe1, 0x1e, 0x25, 0x83, 0xc0, 0xc6, 0x57,
+	0x09, 0x4d, 0x4e, 0xa7, 0x36, 0x1e, 0x29, 0x7b, 0x3c, 0x8b, 0xc9, 0x75, 0xd5, 0xbc, 0xcb, 0x27,
+	0x2e, 0x39, 0xda, 0x27, 0x50, 0x11, 0x82, 0x0c, 0xe1, 0x18, 0x97, 0x51, 0x1f, 0x8f, 0x94, 0xea,
+	0x84, 0x8f, 0x2c, 0xe8, 0x6d, 0x3e, 0xf7, 0x38, 0xc5, 0xbd, 0x0f, 0x25, 0xea, 0x3a, 0x1e, 0x0e,
+	0x44, 0xd7, 0x89, 0x11, 0x92, 0x61, 0x53, 0x70, 0xe7, 0x8d, 0x57, 0x36, 0xd3, 0x71, 0x06, 0x5d,
+	0x0d, 0x0e, 0xa6, 0x88, 0xa4, 0xb4, 0x7e, 0x91, 0x60, 0x77, 0x6a, 0xed, 0x13, 0x1a, 0x79, 0x36,
+	0xfa, 0x02, 0xca, 0x7e, 0x3c, 0x93, 0x74, 0xd1, 0x56, 0xf3, 0x30, 0x06, 0xc7, 0xce, 0xab, 0x96,
+	0x1c, 0xd2, 0xe1, 0xa9, 0xc6, 0xed, 0x2e, 0x3a, 0x46, 0x95, 0x91, 0x1b, 0x8f, 0x94, 0x1d, 0xd1,
+	0x68, 0x89, 0xb5, 0x6a, 0x6e, 0xfa, 0x42, 0x83, 0xbe, 0x01, 0x10, 0xf3, 0xac, 0x1e, 0x6b, 0xb1,
+	0x5b, 0x75, 0x6e, 0x3d, 0xd2, 0x90, 0x8c, 0x9a, 0xf0, 0x5d, 0x99, 0xf0, 0x7d, 0xcd, 0x9a, 0x46,
+	0x84, 0x79, 0x3e, 0xd1, 0x2c, 0x0d, 0xa8, 0xe7, 0x65, 0x95, 0xa6, 0x3d, 0x96, 0x60, 0xbf, 0x45,
+	0x9d, 0x2f, 0xfd, 0x8e, 0x15, 0xe2, 0x96, 0xeb, 0x99, 0x1c, 0x1a, 0xeb, 0x95, 0x3a, 0x94, 0xad,
+	0x41, 0xd8, 0x25, 0x81, 0x1b, 0x46, 0xfc, 0xf8, 0x98, 0xaf, 0x26, 0xb2, 0x47, 0x6b, 0x6d, 0xc5,
+	0xa3, 0x55, 0x5c, 0xf2, 0x68, 0xb5, 0x60, 0xa3, 0xef, 0x7a, 0x31, 0xa0, 0xf5, 0x25, 0x1a, 0x76,
+	0x5f, 0xa0, 0x11, 0x41, 0x08, 0x53, 0xd5, 0x2c, 0xf5, 0x5d, 0x6f, 0x12, 0xca, 0x11, 0x34, 0xf2,
+	0x73, 0x4e, 0xb1, 0xfc, 0xcc, 0xb1, 0x98, 0xb8, 0x4f, 0x86, 0xaf, 0x19, 0x96, 0x99, 0x3c, 0x72,
+	0x82, 0x4c, 0xf3, 0xf8, 0x5b, 0x82, 0x3d, 0x5e, 0xff, 0x27, 0x96, 0xd7, 0xa1, 0x5d, 0xeb, 0x29,
+	0x36, 0xd8, 0x85, 0x11, 0xfd, 0x1f, 0x57, 0x63, 0x08, 0x3b, 0xdd, 0x64, 0xd7, 0xab, 0x76, 0xbc,
+	0x6d, 0x9c, 0xe4, 0x56, 0xf3, 0x78, 0x6e, 0x21, 0xa7, 0xc2, 0x34, 0x14, 0x51, 0xd4, 0x03, 0xbe,
+	0xd3, 0xb4, 0x3f, 0xd5, 0xdc, 0xee, 0x4e, 0x5a, 0x64, 0xf0, 0x28, 0x70, 0x98, 0x9b, 0x7b, 0x42,
+	0xa7, 0xf9, 0x47, 0x09, 0x8a, 0x2d, 0xea, 0xa0, 0x3e, 0xbc, 0x31, 0xf9, 0xae, 0xbe, 0x33, 0x37,
+	0xbe, 0xe9, 0xb7, 0x48, 0x3e, 0x5d, 0x5a, 0x9a, 0x6c, 0x8b, 0x7e, 0x92, 0xa0, 0x36, 0xff, 0xcd,
+	0x3a, 0x5b, 0xc6, 0xe1, 0x8c, 0x99, 0xfc, 0xd1, 0x7f, 0x32, 0x4b, 0x63, 0xfa, 0x16, 0xee, 0x4e,
+	0x3c, 0x14, 0xc7, 0x8b, 0xdc, 0x65, 0x95, 0xf2, 0x7b, 0xcb, 0x2a, 0xd3, 0xbd, 0x22, 0xa8, 0xcc,
+	0x5e, 0xb3, 0x27, 0xcb, 0xba, 0x89, 0xe5, 0xf2, 0xd9, 0x4a, 0xf2, 0x74, 0xeb, 0x1f, 0xe0, 0xcd,
+	0xbc, 0xab, 0x4e, 0x5f, 0xe4, 0x2d, 0xc7, 0x40, 0x7e, 0xb8, 0xa2, 0x41, 0x36, 0x80, 0xbc, 0x4b,
+	0x45, 0x5f, 0x5c, 0xbd, 0x19, 0x03, 0xf9, 0xe1, 0x8a, 0x06, 0x69, 0x00, 0xdf, 0x03, 0xca, 0xb9,
+	0x0d, 0xb4, 0x7f, 0xc1, 0x39, 0xa5, 0x97, 0x3f, 0x58, 0x4d, 0x9f, 0xec, 0x6e, 0x7c, 0xfe, 0xe2,
+	0xa6, 0x21, 0xbd, 0xbc, 0x69, 0x48, 0xbf, 0xdd, 0x34, 0xa4, 0x67, 0xb7, 0x8d, 0xc2, 0xcb, 0xdb,
+	0x46, 0xe1, 0xd7, 0xdb, 0x46, 0xe1, 0xeb, 0x33, 0xc7, 0x0d, 0xbb, 0x83, 0xb6, 0x66, 0x93, 0xbe,
+	0x6e, 0x13, 0xda, 0x27, 0x54, 0x77, 0xdb, 0xf6, 0x89, 0x43, 0xf4, 0xe1, 0x99, 0xde, 0x27, 0x9d,
+	0x41, 0x0f, 0x53, 0xf6, 0x17, 0x4d, 0xf5, 0xe6, 0x87, 0x27, 0xec, 0x07, 0x3a, 0x8c, 0x7c, 0x4c,
+	0xdb, 0xa5, 0xf8, 0xef, 0xf8, 0xfd, 0x7f, 0x06, 0x00, 0x9c, 0x9b, 0x6a, 0xbe, 0xb6, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -453,6 +718,19 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// UpdateMinRelayerFee defines a rpc handler method for MsgUpdateMinRelayerFee
+	// UpdateMinRelayerFee sets the minimum fee which must be escrowed for each packet sent on a fee enabled channel.
+	// This method may only be executed by the module authority, typically the x/gov module account.
+	UpdateMinRelayerFee(ctx context.Context, in *MsgUpdateMinRelayerFee, opts ...grpc.CallOption) (*MsgUpdateMinRelayerFeeResponse, error)
+	// RemoveMinRelayerFee defines a rpc handler method for MsgRemoveMinRelayerFee
+	// RemoveMinRelayerFee removes the minimum fee requirement of a fee enabled channel.
+	// This method may only be executed by the module authority, typically the x/gov module account.
+	RemoveMinRelayerFee(ctx context.Context, in *MsgRemoveMinRelayerFee, opts ...grpc.CallOption) (*MsgRemoveMinRelayerFeeResponse, error)
+	// PayHandshakeBounty defines a rpc handler method for MsgPayHandshakeBounty
+	// PayHandshakeBounty is an open callback that may be called by any module/user that wishes to escrow funds in order to
+	// incentivize relayers to complete the opening handshake of a channel in the INIT state. The bounty is paid out to the
+	// relayer which submits the channel open acknowledgement, or refunded once it expires.
+	PayHandshakeBounty(ctx context.Context, in *MsgPayHandshakeBounty, opts ...grpc.CallOption) (*MsgPayHandshakeBountyResponse, error)
 }
 
 type msgClient struct {
@@ -499,6 +777,33 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) UpdateMinRelayerFee(ctx context.Context, in *MsgUpdateMinRelayerFee, opts ...grpc.CallOption) (*MsgUpdateMinRelayerFeeResponse, error) {
+	out := new(MsgUpdateMinRelayerFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateMinRelayerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinRelayerFee(ctx context.Context, in *MsgRemoveMinRelayerFee, opts ...grpc.CallOption) (*MsgRemoveMinRelayerFeeResponse, error) {
+	out := new(MsgRemoveMinRelayerFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RemoveMinRelayerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PayHandshakeBounty(ctx context.Context, in *MsgPayHandshakeBounty, opts ...grpc.CallOption) (*MsgPayHandshakeBountyResponse, error) {
+	out := new(MsgPayHandshakeBountyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayHandshakeBounty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -523,6 +828,19 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// UpdateMinRelayerFee defines a rpc handler method for MsgUpdateMinRelayerFee
+	// UpdateMinRelayerFee sets the minimum fee which must be escrowed for each packet sent on a fee enabled channel.
+	// This method may only be executed by the module authority, typically the x/gov module account.
+	UpdateMinRelayerFee(context.Context, *MsgUpdateMinRelayerFee) (*MsgUpdateMinRelayerFeeResponse, error)
+	// RemoveMinRelayerFee defines a rpc handler method for MsgRemoveMinRelayerFee
+	// RemoveMinRelayerFee removes the minimum fee requirement of a fee enabled channel.
+	// This method may only be executed by the module authority, typically the x/gov module account.
+	RemoveMinRelayerFee(context.Context, *MsgRemoveMinRelayerFee) (*MsgRemoveMinRelayerFeeResponse, error)
+	// PayHandshakeBounty defines a rpc handler method for MsgPayHandshakeBounty
+	// PayHandshakeBounty is an open callback that may be called by any module/user that wishes to escrow funds in order to
+	// incentivize relayers to complete the opening handshake of a channel in the INIT state. The bounty is paid out to the
+	// relayer which submits the channel open acknowledgement, or refunded once it expires.
+	PayHandshakeBounty(context.Context, *MsgPayHandshakeBounty) (*MsgPayHandshakeBountyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -541,6 +859,15 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) UpdateMinRelayerFee(ctx context.Context, req *MsgUpdateMinRelayerFee) (*MsgUpdateMinRelayerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinRelayerFee not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMinRelayerFee(ctx context.Context, req *MsgRemoveMinRelayerFee) (*MsgRemoveMinRelayerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinRelayerFee not implemented")
+}
+func (*UnimplementedMsgServer) PayHandshakeBounty(ctx context.Context, req *MsgPayHandshakeBounty) (*MsgPayHandshakeBountyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayHandshakeBounty not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -618,6 +945,60 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateMinRelayerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinRelayerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinRelayerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateMinRelayerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinRelayerFee(ctx, req.(*MsgUpdateMinRelayerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinRelayerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinRelayerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinRelayerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RemoveMinRelayerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinRelayerFee(ctx, req.(*MsgRemoveMinRelayerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayHandshakeBounty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayHandshakeBounty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayHandshakeBounty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/PayHandshakeBounty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayHandshakeBounty(ctx, req.(*MsgPayHandshakeBounty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.fee.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -638,6 +1019,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayPacketFeeAsync",
 			Handler:    _Msg_PayPacketFeeAsync_Handler,
 		},
+		{
+			MethodName: "UpdateMinRelayerFee",
+			Handler:    _Msg_UpdateMinRelayerFee_Handler,
+		},
+		{
+			MethodName: "RemoveMinRelayerFee",
+			Handler:    _Msg_RemoveMinRelayerFee_Handler,
+		},
+		{
+			MethodName: "PayHandshakeBounty",
+			Handler:    _Msg_PayHandshakeBounty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/tx.proto",
@@ -663,6 +1056,20 @@ func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payees) > 0 {
+		for iNdEx := len(m.Payees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Payee) > 0 {
 		i -= len(m.Payee)
 		copy(dAtA[i:], m.Payee)
@@ -943,53 +1350,273 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateMinRelayerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgUpdateMinRelayerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateMinRelayerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
-	if m == nil {
+	{
+		size, err := m.MinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinRelayerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinRelayerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinRelayerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMinRelayerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMinRelayerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMinRelayerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMinRelayerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMinRelayerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMinRelayerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayHandshakeBounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayHandshakeBounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayHandshakeBounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HandshakeBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayHandshakeBountyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayHandshakeBountyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayHandshakeBountyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Payees) > 0 {
+		for _, e := range m.Payees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1082,22 +1709,112 @@ func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateMinRelayerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateMinRelayerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
+
+func (m *MsgRemoveMinRelayerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMinRelayerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayHandshakeBounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HandshakeBounty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayHandshakeBountyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1168,24 +1885,711 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payees = append(m.Payees, WeightedPayee{})
+			if err := m.Payees[len(m.Payees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1195,29 +2599,30 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1227,23 +2632,24 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1266,7 +2672,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1289,10 +2695,10 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1316,7 +2722,7 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMinRelayerFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,15 +2745,15 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMinRelayerFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMinRelayerFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,11 +2781,11 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,11 +2813,11 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1439,13 +2845,13 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1455,23 +2861,24 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1494,7 +2901,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMinRelayerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,10 +2924,10 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMinRelayerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMinRelayerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1544,7 +2951,7 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMinRelayerFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1567,80 +2974,15 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMinRelayerFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMinRelayerFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourcePortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1668,11 +3010,11 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1700,11 +3042,11 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1732,7 +3074,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1755,7 +3097,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMinRelayerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1778,10 +3120,10 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMinRelayerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMinRelayerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1805,7 +3147,7 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+func (m *MsgPayHandshakeBounty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,17 +3170,17 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayHandshakeBounty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayHandshakeBounty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1848,28 +3190,59 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeBounty", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1896,7 +3269,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HandshakeBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1921,7 +3294,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayHandshakeBountyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1944,10 +3317,10 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayHandshakeBountyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayHandshakeBountyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
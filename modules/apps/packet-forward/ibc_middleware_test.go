@@ -0,0 +1,319 @@
+package packetforward_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+var (
+	amount = sdk.NewInt(100)
+
+	successAck = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+)
+
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	// pathAToB connects chainA to chainB and pathBToC connects chainB to chainC
+	pathAToB *ibctesting.Path
+	pathBToC *ibctesting.Path
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAToB = newTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.pathAToB)
+
+	suite.pathBToC = newTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathBToC)
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// forwardMemo returns a memo forwarding the tokens received on chainB to the receiver on chainC
+func (suite *PacketForwardTestSuite) forwardMemo(receiver string, extraFields string) string {
+	return fmt.Sprintf(`{"forward":{"receiver":"%s","channel":"%s"%s}}`, receiver, suite.pathBToC.EndpointA.ChannelID, extraFields)
+}
+
+// transfer sends the coin from the source endpoint to the sender account of the destination chain
+// with the provided memo and returns the sent packet.
+func (suite *PacketForwardTestSuite) transfer(endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver, memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		endpoint.Chain.SenderAccount.GetAddress().String(), receiver,
+		clienttypes.NewHeight(1, 110), 0, memo,
+	)
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvAndForward receives the packet on chainB and returns the packet forwarded to chainC
+func (suite *PacketForwardTestSuite) recvAndForward(packet channeltypes.Packet) channeltypes.Packet {
+	err := suite.pathAToB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written asynchronously
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+	)
+	suite.Require().False(found)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return forwardPacket
+}
+
+// relayForwardPacket relays the forwarded packet to chainC and its acknowledgement back to chainB.
+// The acknowledgement written on chainC is returned.
+func (suite *PacketForwardTestSuite) relayForwardPacket(forwardPacket channeltypes.Packet) []byte {
+	err := suite.pathBToC.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := suite.pathBToC.EndpointB.RecvPacketWithResult(forwardPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = suite.pathBToC.EndpointA.AcknowledgePacket(forwardPacket, ack)
+	suite.Require().NoError(err)
+
+	return ack
+}
+
+// timeoutForwardPacket times out the forwarded packet on chainB and returns the result of the transaction
+func (suite *PacketForwardTestSuite) timeoutForwardPacket(forwardPacket channeltypes.Packet) *sdk.Result {
+	// advance the block time of chainC past the timeout of the forwarded packet
+	suite.coordinator.CommitBlock(suite.chainC)
+	err := suite.pathBToC.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketReceiptKey(forwardPacket.GetDestPort(), forwardPacket.GetDestChannel(), forwardPacket.GetSequence())
+	proof, proofHeight := suite.chainC.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgTimeout(forwardPacket, 1, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// acknowledgeOnChainA relays the acknowledgement written on chainB for the packet back to chainA
+func (suite *PacketForwardTestSuite) acknowledgeOnChainA(packet channeltypes.Packet, ack []byte) {
+	err := suite.pathAToB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointA.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+}
+
+func (suite *PacketForwardTestSuite) requireNoInFlightPackets() {
+	suite.Require().Empty(suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+}
+
+// requireIntermediateEmpty asserts the intermediate address of the sender on chainB holds no tokens
+func (suite *PacketForwardTestSuite) requireIntermediateEmpty() {
+	intermediate := types.GetReceiver(suite.pathAToB.EndpointB.ChannelID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), intermediate).IsZero())
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacket() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainC.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	packet := suite.transfer(suite.pathAToB.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.forwardMemo(receiver.String(), ""))
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	forwardPacket := suite.recvAndForward(packet)
+	suite.Require().Equal(suite.pathBToC.EndpointA.ChannelID, forwardPacket.GetSourceChannel())
+
+	inFlightPackets := suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext())
+	suite.Require().Len(inFlightPackets, 1)
+	suite.Require().Equal(packet, inFlightPackets[0].Packet)
+	suite.Require().Equal(uint32(types.DefaultForwardRetries), inFlightPackets[0].RetriesRemaining)
+
+	ack := suite.relayForwardPacket(forwardPacket)
+	suite.Require().Equal(successAck.Acknowledgement(), ack)
+
+	suite.requireNoInFlightPackets()
+	suite.acknowledgeOnChainA(packet, ack)
+
+	// the tokens are received on chainC with the denomination traced through chainB
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.pathBToC.EndpointB.ChannelConfig.PortID, suite.pathBToC.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	))
+	balance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, denomTrace.IBCDenom())
+	suite.Require().Equal(amount, balance.Amount)
+
+	// the sender on chainA is not refunded
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	suite.requireIntermediateEmpty()
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketErrorAck() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the receiver on chainC is not a valid address, so the forwarded packet is acknowledged with an error
+	packet := suite.transfer(suite.pathAToB.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.forwardMemo("invalid", ""))
+	forwardPacket := suite.recvAndForward(packet)
+
+	ack := suite.relayForwardPacket(forwardPacket)
+	suite.Require().NotEqual(successAck.Acknowledgement(), ack)
+
+	suite.requireNoInFlightPackets()
+	suite.acknowledgeOnChainA(packet, ack)
+
+	// the vouchers minted on chainB are burned and the sender on chainA is refunded
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).IsZero())
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	suite.requireIntermediateEmpty()
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketUnwindErrorAck() {
+	// send native tokens of chainB to chainA such that they are escrowed on chainB
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	packet := suite.transfer(suite.pathAToB.EndpointB, coin, suite.chainA.SenderAccount.GetAddress().String(), "")
+	err := suite.pathAToB.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	escrowAddress := transfertypes.GetEscrowAddress(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+	escrowBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+	suite.Require().Equal(amount, escrowBalance.Amount)
+
+	// send the vouchers back to chainB and forward the native tokens to an invalid receiver on chainC
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointA.ChannelConfig.PortID, suite.pathAToB.EndpointA.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	packet = suite.transfer(suite.pathAToB.EndpointA, sdk.NewCoin(voucherDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), suite.forwardMemo("invalid", ""))
+	forwardPacket := suite.recvAndForward(packet)
+
+	// the native tokens are unescrowed on chainB
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom).IsZero())
+
+	ack := suite.relayForwardPacket(forwardPacket)
+	suite.Require().NotEqual(successAck.Acknowledgement(), ack)
+	suite.acknowledgeOnChainA(packet, ack)
+
+	// the native tokens are escrowed again on chainB and the vouchers are refunded on chainA
+	suite.Require().Equal(escrowBalance, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+	suite.Require().Equal(totalEscrow, suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom))
+	suite.Require().Equal(amount, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucherDenom).Amount)
+	suite.requireIntermediateEmpty()
+}
+
+func (suite *PacketForwardTestSuite) TestForwardPacketTimeout() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainC.SenderAccount.GetAddress().String()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the forwarded packet times out one nanosecond after it is sent and is resent once
+	packet := suite.transfer(suite.pathAToB.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.forwardMemo(receiver, `,"timeout":1,"retries":1`))
+	forwardPacket := suite.recvAndForward(packet)
+
+	res := suite.timeoutForwardPacket(forwardPacket)
+
+	retryPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(forwardPacket.GetSequence()+1, retryPacket.GetSequence())
+
+	inFlightPackets := suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext())
+	suite.Require().Len(inFlightPackets, 1)
+	suite.Require().Equal(retryPacket.GetSequence(), inFlightPackets[0].ForwardPacketId.Sequence)
+	suite.Require().Zero(inFlightPackets[0].RetriesRemaining)
+
+	// no retries remain, so an error acknowledgement is written
+	suite.timeoutForwardPacket(retryPacket)
+	suite.requireNoInFlightPackets()
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardTimeout)
+	suite.acknowledgeOnChainA(packet, ack.Acknowledgement())
+
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	suite.requireIntermediateEmpty()
+}
+
+func (suite *PacketForwardTestSuite) TestOnRecvPacketInvalidMetadata() {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	packet := suite.transfer(suite.pathAToB.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String(), `{"forward":{"receiver":"","channel":"channel-1"}}`)
+
+	err := suite.pathAToB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrInvalidForwardMetadata).Acknowledgement(), ack)
+
+	suite.requireNoInFlightPackets()
+}
+
+func (suite *PacketForwardTestSuite) TestOnRecvPacketWithoutForward() {
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	packet := suite.transfer(suite.pathAToB.EndpointA, coin, receiver.String(), `{"wasm":{}}`)
+	err := suite.pathAToB.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// the tokens are received by the receiver of the packet
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	suite.Require().Equal(amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+
+	suite.requireNoInFlightPackets()
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// EmitForwardPacketEvent emits an event of the given type for a packet whose tokens have been forwarded
+func EmitForwardPacketEvent(ctx sdk.Context, eventType string, packet channeltypes.Packet, forwardPacketID channeltypes.PacketId, receiver string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, forwardPacketID.PortId),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, forwardPacketID.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", forwardPacketID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitForwardPacketCompleteEvent emits an event signalling the acknowledgement of an in flight packet has been written
+func EmitForwardPacketCompleteEvent(ctx sdk.Context, inFlightPacket types.InFlightPacket, success bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForwardComplete,
+			sdk.NewAttribute(types.AttributeKeySrcPort, inFlightPacket.Packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, inFlightPacket.Packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", inFlightPacket.Packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, inFlightPacket.ForwardPacketId.PortId),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardPacketId.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", inFlightPacket.ForwardPacketId.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", success)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
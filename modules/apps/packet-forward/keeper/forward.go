@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// GetPacketData decodes the ICS-20 packet data of a packet received on this chain according to
+// the application version of the destination channel. The application version is returned alongside
+// the packet data.
+func (k Keeper) GetPacketData(ctx sdk.Context, packet channeltypes.Packet) (transfertypes.FungibleTokenPacketDataV2, string, error) {
+	version, found := k.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, "", sdkerrors.Wrapf(
+			transfertypes.ErrInvalidVersion, "application version not found for port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel(),
+		)
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return transfertypes.FungibleTokenPacketDataV2{}, "", err
+	}
+
+	return data, version, nil
+}
+
+// ForwardPacket sends the tokens received in the packet on the next hop described by the forward
+// metadata. The tokens are expected to have been received by the address returned by types.GetReceiver.
+// The packet is stored as in flight until the forwarded packet is acknowledged or times out.
+func (k Keeper) ForwardPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketDataV2, metadata types.ForwardMetadata) error {
+	forwardPacketID, err := k.sendForwardTransfer(ctx, packet, data, metadata)
+	if err != nil {
+		return err
+	}
+
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(packet, forwardPacketID, uint32(metadata.GetRetries())))
+
+	k.Logger(ctx).Info("packet forwarded", "src-channel", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "forward-channel", forwardPacketID.ChannelId, "forward-sequence", forwardPacketID.Sequence)
+
+	EmitForwardPacketEvent(ctx, types.EventTypeForwardPacket, packet, forwardPacketID, metadata.Receiver)
+
+	return nil
+}
+
+// OnForwardedPacketAcknowledged writes the acknowledgement of the forwarded packet as the acknowledgement
+// of the in flight packet. If the acknowledgement is an error, the tokens refunded upon the acknowledgement
+// of the forwarded packet are returned to the previous hop.
+func (k Keeper) OnForwardedPacketAcknowledged(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	forwardPacketID := inFlightPacket.ForwardPacketId
+	k.DeleteInFlightPacket(ctx, forwardPacketID.PortId, forwardPacketID.ChannelId, forwardPacketID.Sequence)
+
+	if !ack.Success() {
+		if err := k.refundInFlightPacket(ctx, inFlightPacket); err != nil {
+			return err
+		}
+	}
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, ack)
+}
+
+// OnForwardedPacketTimedOut resends the tokens of a forwarded packet which timed out if any retries
+// remain. Otherwise, or if the tokens cannot be resent, the tokens refunded upon the timeout of the
+// forwarded packet are returned to the previous hop and an error acknowledgement is written for the
+// in flight packet.
+func (k Keeper) OnForwardedPacketTimedOut(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	forwardPacketID := inFlightPacket.ForwardPacketId
+	k.DeleteInFlightPacket(ctx, forwardPacketID.PortId, forwardPacketID.ChannelId, forwardPacketID.Sequence)
+
+	if inFlightPacket.RetriesRemaining > 0 {
+		// state changes made while resending the tokens are discarded if the retry fails
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := k.retryForward(cacheCtx, inFlightPacket)
+		if err == nil {
+			// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			writeFn()
+			return nil
+		}
+
+		k.Logger(ctx).Error("failed to retry forwarded packet", "forward-channel", forwardPacketID.ChannelId, "forward-sequence", forwardPacketID.Sequence, "error", err.Error())
+	}
+
+	if err := k.refundInFlightPacket(ctx, inFlightPacket); err != nil {
+		return err
+	}
+
+	ack := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrForwardTimeout, "forward packet ID %s", forwardPacketID))
+	return k.writeAcknowledgement(ctx, inFlightPacket, ack)
+}
+
+// retryForward resends the tokens of the in flight packet on the next hop and stores the in flight
+// packet under the identifier of the new forwarded packet.
+func (k Keeper) retryForward(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	data, _, err := k.GetPacketData(ctx, inFlightPacket.Packet)
+	if err != nil {
+		return err
+	}
+
+	metadata, found, err := types.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidForwardMetadata, "forward metadata not found in packet memo")
+	}
+
+	forwardPacketID, err := k.sendForwardTransfer(ctx, inFlightPacket.Packet, data, metadata)
+	if err != nil {
+		return err
+	}
+
+	inFlightPacket.ForwardPacketId = forwardPacketID
+	inFlightPacket.RetriesRemaining--
+	k.SetInFlightPacket(ctx, inFlightPacket)
+
+	k.Logger(ctx).Info("forwarded packet resent", "forward-channel", forwardPacketID.ChannelId, "forward-sequence", forwardPacketID.Sequence, "retries-remaining", inFlightPacket.RetriesRemaining)
+
+	EmitForwardPacketEvent(ctx, types.EventTypeRetryForward, inFlightPacket.Packet, forwardPacketID, metadata.Receiver)
+
+	return nil
+}
+
+// sendForwardTransfer sends the tokens received in the packet to the receiver of the forward metadata
+// and returns the identifier of the forwarded packet.
+func (k Keeper) sendForwardTransfer(
+	ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketDataV2, metadata types.ForwardMetadata,
+) (channeltypes.PacketId, error) {
+	tokens, err := getReceivedTokens(packet, data)
+	if err != nil {
+		return channeltypes.PacketId{}, err
+	}
+
+	portID, channelID := metadata.GetPort(), metadata.Channel
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return channeltypes.PacketId{}, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(metadata.GetTimeout()).UnixNano())
+	if err := k.transferKeeper.SendTransfer(
+		ctx, portID, channelID, tokens, types.GetReceiver(packet.GetDestChannel(), data.Sender),
+		metadata.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, metadata.GetNextMemo(),
+	); err != nil {
+		return channeltypes.PacketId{}, err
+	}
+
+	return channeltypes.NewPacketID(portID, channelID, sequence), nil
+}
+
+// refundInFlightPacket reverts the receipt of the tokens of the in flight packet on this chain, such that
+// the tokens can be refunded to the sender on the previous hop. The tokens are expected to have been
+// refunded to the address returned by types.GetReceiver upon the failure of the forwarded packet.
+// Tokens which were unescrowed upon receipt are escrowed again, while vouchers which were minted are burned.
+func (k Keeper) refundInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	packet := inFlightPacket.Packet
+	data, _, err := k.GetPacketData(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	receiver := types.GetReceiver(packet.GetDestChannel(), data.Sender)
+	for _, token := range data.Tokens {
+		coin, err := getReceivedToken(packet, token)
+		if err != nil {
+			return err
+		}
+
+		if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+			escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+			if err := k.bankKeeper.SendCoins(ctx, receiver, escrowAddress, sdk.NewCoins(coin)); err != nil {
+				return sdkerrors.Wrap(err, "failed to escrow refunded tokens")
+			}
+
+			// track the total amount in escrow keyed by denomination to allow for efficient iteration
+			currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, coin.GetDenom())
+			k.transferKeeper.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(coin))
+
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, receiver, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return sdkerrors.Wrap(err, "failed to burn refunded vouchers")
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			// NOTE: should not happen as the module account was
+			// retrieved on the step above and it has enough balace
+			// to burn.
+			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+		}
+	}
+
+	return nil
+}
+
+// writeAcknowledgement writes the acknowledgement of the in flight packet
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	packet := inFlightPacket.Packet
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve channel capability")
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	EmitForwardPacketCompleteEvent(ctx, inFlightPacket, ack.Success())
+
+	return nil
+}
+
+// getReceivedTokens returns the coins on this chain of all the tokens received in the packet
+func getReceivedTokens(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketDataV2) (sdk.Coins, error) {
+	tokens := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := getReceivedToken(packet, token)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, coin)
+	}
+
+	tokens = tokens.Sort()
+	if err := tokens.Validate(); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// getReceivedToken returns the coin on this chain of a token received in the packet, i.e. in the
+// native denomination if this chain is the source of the token, or in the ibc voucher denomination otherwise.
+func getReceivedToken(packet channeltypes.Packet, token transfertypes.Token) (sdk.Coin, error) {
+	amount, ok := sdk.NewIntFromString(token.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+	}
+
+	var denomTrace transfertypes.DenomTrace
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denomTrace = transfertypes.ParseDenomTrace(token.Denom[len(voucherPrefix):])
+	} else {
+		denomTrace = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), token.Denom))
+	}
+
+	return sdk.NewCoin(denomTrace.IBCDenom(), amount), nil
+}
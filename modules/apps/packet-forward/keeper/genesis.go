@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+)
+
+// InitGenesis initializes the packet forward middleware's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, inFlightPacket := range state.InFlightPackets {
+		k.SetInFlightPacket(ctx, inFlightPacket)
+	}
+}
+
+// ExportGenesis returns the packet forward middleware's exported genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		InFlightPackets: k.GetAllInFlightPackets(ctx),
+	}
+}
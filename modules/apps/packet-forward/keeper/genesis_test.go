@@ -0,0 +1,17 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState([]types.InFlightPacket{
+		newInFlightPacket("channel-1", 1),
+		newInFlightPacket("channel-2", 5),
+	})
+
+	suite.chainA.GetSimApp().PacketForwardKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	exported := suite.chainA.GetSimApp().PacketForwardKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesis, exported)
+}
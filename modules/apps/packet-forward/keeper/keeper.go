@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+)
+
+// Middleware must implement the types.ICS4Wrapper expected interface so that it can wrap
+// the IBC channel logic for the underlying application.
+var _ types.ICS4Wrapper = Keeper{}
+
+// Keeper defines the packet forward middleware keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper    types.ICS4Wrapper
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates a new packet forward middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, ics4Wrapper types.ICS4Wrapper,
+	transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		ics4Wrapper:    ics4Wrapper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// SendPacket wraps IBC ChannelKeeper's SendPacket function
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps IBC ICS4Wrapper WriteAcknowledgement function
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// GetInFlightPacket returns the in flight packet awaiting the completion of the forwarded packet
+// with the given port, channel and sequence
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyInFlightPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	return k.MustUnmarshalInFlightPacket(bz), true
+}
+
+// SetInFlightPacket stores the in flight packet keyed by the identifier of its forwarded packet
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	forwardPacketID := inFlightPacket.ForwardPacketId
+	store.Set(types.KeyInFlightPacket(forwardPacketID.PortId, forwardPacketID.ChannelId, forwardPacketID.Sequence), k.MustMarshalInFlightPacket(inFlightPacket))
+}
+
+// DeleteInFlightPacket deletes the in flight packet awaiting the completion of the forwarded packet
+// with the given port, channel and sequence
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyInFlightPacket(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns all the in flight packets stored in state
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.InFlightPacketKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var inFlightPackets []types.InFlightPacket
+	for ; iterator.Valid(); iterator.Next() {
+		inFlightPackets = append(inFlightPackets, k.MustUnmarshalInFlightPacket(iterator.Value()))
+	}
+
+	return inFlightPackets
+}
+
+// MustMarshalInFlightPacket attempts to encode an InFlightPacket object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalInFlightPacket(inFlightPacket types.InFlightPacket) []byte {
+	return k.cdc.MustMarshal(&inFlightPacket)
+}
+
+// MustUnmarshalInFlightPacket attempts to decode and return an InFlightPacket object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalInFlightPacket(bz []byte) types.InFlightPacket {
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+	return inFlightPacket
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newInFlightPacket returns an in flight packet forwarded on the provided channel and sequence
+func newInFlightPacket(channelID string, sequence uint64) types.InFlightPacket {
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, sequence, ibctesting.TransferPort, ibctesting.FirstChannelID,
+		ibctesting.TransferPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0,
+	)
+
+	return types.NewInFlightPacket(packet, channeltypes.NewPacketID(ibctesting.TransferPort, channelID, sequence), 1)
+}
+
+func (suite *KeeperTestSuite) TestInFlightPackets() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().PacketForwardKeeper
+
+	suite.Require().Empty(keeper.GetAllInFlightPackets(ctx))
+
+	expInFlightPackets := []types.InFlightPacket{
+		newInFlightPacket("channel-1", 1),
+		newInFlightPacket("channel-1", 2),
+		newInFlightPacket("channel-2", 1),
+	}
+
+	for _, inFlightPacket := range expInFlightPackets {
+		keeper.SetInFlightPacket(ctx, inFlightPacket)
+	}
+
+	inFlightPacket, found := keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(expInFlightPackets[1], inFlightPacket)
+	suite.Require().Equal(expInFlightPackets, keeper.GetAllInFlightPackets(ctx))
+
+	keeper.DeleteInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 2)
+
+	_, found = keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 2)
+	suite.Require().False(found)
+	suite.Require().Equal([]types.InFlightPacket{expInFlightPackets[0], expInFlightPackets[2]}, keeper.GetAllInFlightPackets(ctx))
+}
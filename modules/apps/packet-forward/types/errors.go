@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardTimeout         = sdkerrors.Register(ModuleName, 3, "forwarded packet timed out")
+	ErrInvalidInFlightPacket  = sdkerrors.Register(ModuleName, 4, "invalid in flight packet")
+)
@@ -0,0 +1,17 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeForwardPacket   = "forward_packet"
+	EventTypeRetryForward    = "retry_forward_packet"
+	EventTypeForwardComplete = "forward_packet_complete"
+
+	AttributeKeySrcPort         = "src_port"
+	AttributeKeySrcChannel      = "src_channel"
+	AttributeKeySequence        = "sequence"
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyAckSuccess      = "success"
+)
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const (
+	// ForwardMemoKey is the key of the forward metadata within the JSON object of a transfer memo
+	ForwardMemoKey = "forward"
+
+	// DefaultForwardTimeout is the relative timeout of a forwarded packet used when no timeout is
+	// provided in the forward metadata
+	DefaultForwardTimeout = 10 * time.Minute
+
+	// DefaultForwardRetries is the number of times a forwarded packet is resent upon timeout
+	// when no number of retries is provided in the forward metadata
+	DefaultForwardRetries uint8 = 3
+)
+
+// PacketMetadata defines the JSON object expected in the memo of a transfer packet whose tokens
+// must be forwarded to another chain.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines the instructions to forward the tokens received in a transfer packet.
+// The tokens are sent to the receiver over the provided port and channel, with the next field
+// used as the memo of the forwarded packet, allowing the tokens to be forwarded on multiple hops.
+type ForwardMetadata struct {
+	Receiver string `json:"receiver"`
+	Port     string `json:"port,omitempty"`
+	Channel  string `json:"channel"`
+	// relative timeout of the forwarded packet in nanoseconds
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// number of times the forwarded packet is resent upon timeout
+	Retries *uint8 `json:"retries,omitempty"`
+	// memo of the forwarded packet, which must be a JSON object
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata parses the forward metadata contained in a transfer memo. The returned boolean
+// is false if the memo does not contain forwarding instructions, in which case the packet is not
+// expected to be forwarded. An error is returned if the forwarding instructions are invalid.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	if strings.TrimSpace(memo) == "" {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var memoObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoObject); err != nil {
+		// memos which are not JSON objects are not intended for this middleware
+		return ForwardMetadata{}, false, nil
+	}
+
+	if _, found := memoObject[ForwardMemoKey]; !found {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var packetMetadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &packetMetadata); err != nil {
+		return ForwardMetadata{}, true, sdkerrors.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if packetMetadata.Forward == nil {
+		return ForwardMetadata{}, true, sdkerrors.Wrap(ErrInvalidForwardMetadata, "forward metadata cannot be null")
+	}
+
+	if err := packetMetadata.Forward.Validate(); err != nil {
+		return ForwardMetadata{}, true, err
+	}
+
+	return *packetMetadata.Forward, true, nil
+}
+
+// Validate performs a stateless validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be blank")
+	}
+
+	if err := host.PortIdentifierValidator(m.GetPort()); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+
+	if m.Timeout < 0 {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "timeout cannot be negative")
+	}
+
+	if len(m.Next) != 0 {
+		var next map[string]json.RawMessage
+		if err := json.Unmarshal(m.Next, &next); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "next must be a JSON object: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetPort returns the port on which the tokens are forwarded, defaulting to the transfer port
+func (m ForwardMetadata) GetPort() string {
+	if m.Port == "" {
+		return transfertypes.PortID
+	}
+
+	return m.Port
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+
+	return m.Timeout
+}
+
+// GetRetries returns the number of times the forwarded packet is resent upon timeout
+func (m ForwardMetadata) GetRetries() uint8 {
+	if m.Retries == nil {
+		return DefaultForwardRetries
+	}
+
+	return *m.Retries
+}
+
+// GetNextMemo returns the memo of the forwarded packet
+func (m ForwardMetadata) GetNextMemo() string {
+	if len(m.Next) == 0 {
+		return ""
+	}
+
+	return string(m.Next)
+}
+
+// GetReceiver returns the address which receives the tokens of a packet to be forwarded, before
+// they are sent on the next hop. The address is derived from the destination channel of the
+// received packet and the original sender, such that it is not controlled by any private key.
+func GetReceiver(channelID, originalSender string) sdk.AccAddress {
+	preImage := []byte(ModuleName)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, fmt.Sprintf("%s/%s", channelID, originalSender)...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
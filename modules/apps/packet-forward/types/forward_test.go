@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+const receiver = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+
+func TestParseForwardMetadata(t *testing.T) {
+	retries := uint8(1)
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expMetadata types.ForwardMetadata
+		expFound    bool
+		expPass     bool
+	}{
+		{"empty memo", "", types.ForwardMetadata{}, false, true},
+		{"memo is not JSON", "hello world", types.ForwardMetadata{}, false, true},
+		{"memo is a JSON array", `["forward"]`, types.ForwardMetadata{}, false, true},
+		{"memo without forward key", `{"wasm":{"contract":"cosmos1"}}`, types.ForwardMetadata{}, false, true},
+		{
+			"success",
+			`{"forward":{"receiver":"` + receiver + `","port":"transfer","channel":"channel-0"}}`,
+			types.ForwardMetadata{Receiver: receiver, Port: transfertypes.PortID, Channel: ibctesting.FirstChannelID},
+			true, true,
+		},
+		{
+			"success: all fields",
+			`{"forward":{"receiver":"` + receiver + `","channel":"channel-0","timeout":60000000000,"retries":1,"next":{"forward":{"receiver":"` + receiver + `","channel":"channel-1"}}}}`,
+			types.ForwardMetadata{
+				Receiver: receiver, Channel: ibctesting.FirstChannelID, Timeout: time.Minute, Retries: &retries,
+				Next: []byte(`{"forward":{"receiver":"` + receiver + `","channel":"channel-1"}}`),
+			},
+			true, true,
+		},
+		{"forward is null", `{"forward":null}`, types.ForwardMetadata{}, true, false},
+		{"forward is not an object", `{"forward":"channel-0"}`, types.ForwardMetadata{}, true, false},
+		{"blank receiver", `{"forward":{"receiver":" ","channel":"channel-0"}}`, types.ForwardMetadata{}, true, false},
+		{"invalid port", `{"forward":{"receiver":"` + receiver + `","port":"(invalid)","channel":"channel-0"}}`, types.ForwardMetadata{}, true, false},
+		{"missing channel", `{"forward":{"receiver":"` + receiver + `"}}`, types.ForwardMetadata{}, true, false},
+		{"negative timeout", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","timeout":-1}}`, types.ForwardMetadata{}, true, false},
+		{"retries out of range", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","retries":256}}`, types.ForwardMetadata{}, true, false},
+		{"next is not an object", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","next":"memo"}}`, types.ForwardMetadata{}, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := types.ParseForwardMetadata(tc.memo)
+			require.Equal(t, tc.expFound, found)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expMetadata, metadata)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidForwardMetadata)
+			}
+		})
+	}
+}
+
+func TestForwardMetadataDefaults(t *testing.T) {
+	metadata := types.ForwardMetadata{Receiver: receiver, Channel: ibctesting.FirstChannelID}
+
+	require.Equal(t, transfertypes.PortID, metadata.GetPort())
+	require.Equal(t, types.DefaultForwardTimeout, metadata.GetTimeout())
+	require.Equal(t, types.DefaultForwardRetries, metadata.GetRetries())
+	require.Empty(t, metadata.GetNextMemo())
+
+	retries := uint8(0)
+	metadata = types.ForwardMetadata{
+		Receiver: receiver, Port: "port", Channel: ibctesting.FirstChannelID, Timeout: time.Hour, Retries: &retries,
+		Next: []byte(`{"forward":{}}`),
+	}
+
+	require.Equal(t, "port", metadata.GetPort())
+	require.Equal(t, time.Hour, metadata.GetTimeout())
+	require.Equal(t, uint8(0), metadata.GetRetries())
+	require.Equal(t, `{"forward":{}}`, metadata.GetNextMemo())
+}
+
+func TestGetReceiver(t *testing.T) {
+	addr := types.GetReceiver(ibctesting.FirstChannelID, receiver)
+	require.Len(t, addr, 20)
+	require.Equal(t, addr, types.GetReceiver(ibctesting.FirstChannelID, receiver))
+
+	// the address is unique per channel and sender
+	require.NotEqual(t, addr, types.GetReceiver("channel-1", receiver))
+	require.NotEqual(t, addr, types.GetReceiver(ibctesting.FirstChannelID, "cosmos1"))
+}
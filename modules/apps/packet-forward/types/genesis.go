@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a packet forward middleware GenesisState instance.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the packet forward middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenForwardPackets := make(map[string]bool)
+	for _, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return err
+		}
+
+		forwardPacketID := inFlightPacket.ForwardPacketId
+		key := string(KeyInFlightPacket(forwardPacketID.PortId, forwardPacketID.ChannelId, forwardPacketID.Sequence))
+		if seenForwardPackets[key] {
+			return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "duplicate in flight packet for forward packet ID %s", forwardPacketID)
+		}
+		seenForwardPackets[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, 1, ibctesting.TransferPort, ibctesting.FirstChannelID,
+		ibctesting.TransferPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0,
+	)
+	forwardPacketID := channeltypes.NewPacketID(ibctesting.TransferPort, "channel-1", 1)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success - default genesis",
+			func() {
+				genState = types.DefaultGenesisState()
+			},
+			true,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: same packet forwarded on different sequences",
+			func() {
+				genState.InFlightPackets = append(genState.InFlightPackets, types.NewInFlightPacket(packet, channeltypes.NewPacketID(ibctesting.TransferPort, "channel-1", 2), 0))
+			},
+			true,
+		},
+		{
+			"invalid packet",
+			func() {
+				genState.InFlightPackets[0].Packet.Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid forward packet ID",
+			func() {
+				genState.InFlightPackets[0].ForwardPacketId.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"duplicate forward packet ID",
+			func() {
+				genState.InFlightPackets = append(genState.InFlightPackets, genState.InFlightPackets[0])
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genState = types.NewGenesisState([]types.InFlightPacket{types.NewInFlightPacket(packet, forwardPacketID, 1)})
+
+			tc.malleate()
+
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
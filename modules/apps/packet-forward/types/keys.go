@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the packet forward middleware name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the packet forward middleware
+	QuerierRoute = ModuleName
+
+	// InFlightPacketKeyPrefix is the key prefix for the packets awaiting the completion of their forwarded packet
+	InFlightPacketKeyPrefix = "inFlightPacket"
+)
+
+// KeyInFlightPacket returns the key under which an in flight packet is stored. The in flight packet
+// is keyed by the identifier of the forwarded packet, i.e. the port, channel and sequence on which
+// the tokens have been forwarded.
+func KeyInFlightPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", InFlightPacketKeyPrefix, portID, channelID, sequence))
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// NewInFlightPacket creates a new InFlightPacket instance
+func NewInFlightPacket(packet channeltypes.Packet, forwardPacketID channeltypes.PacketId, retriesRemaining uint32) InFlightPacket {
+	return InFlightPacket{
+		Packet:           packet,
+		ForwardPacketId:  forwardPacketID,
+		RetriesRemaining: retriesRemaining,
+	}
+}
+
+// Validate performs a stateless validation of the in flight packet
+func (p InFlightPacket) Validate() error {
+	if err := p.Packet.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "invalid packet: %s", err)
+	}
+
+	if err := p.ForwardPacketId.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "invalid forward packet ID: %s", err)
+	}
+
+	return nil
+}
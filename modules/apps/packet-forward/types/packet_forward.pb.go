@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/packet_forward/v1/packet_forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a packet received by the packet forward middleware whose tokens have been
+// forwarded on the next hop. The acknowledgement of the received packet is written once the forwarded
+// packet is acknowledged or times out.
+type InFlightPacket struct {
+	// the packet received on this chain
+	Packet types.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// unique identifier of the forwarded packet
+	ForwardPacketId types.PacketId `protobuf:"bytes,2,opt,name=forward_packet_id,json=forwardPacketId,proto3" json:"forward_packet_id" yaml:"forward_packet_id"`
+	// number of times the forwarded packet can still be resent upon timeout
+	RetriesRemaining uint32 `protobuf:"varint,3,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty" yaml:"retries_remaining"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d874023efc9137, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *InFlightPacket) GetForwardPacketId() types.PacketId {
+	if m != nil {
+		return m.ForwardPacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "ibc.applications.packet_forward.v1.InFlightPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/packet_forward/v1/packet_forward.proto", fileDescriptor_48d874023efc9137)
+}
+
+var fileDescriptor_48d874023efc9137 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3d, 0x4b, 0xc3, 0x40,
+	0x1c, 0xc6, 0x93, 0x2a, 0x1d, 0x22, 0xbe, 0x34, 0x38, 0x84, 0xaa, 0x69, 0xcd, 0xd4, 0xa5, 0x77,
+	0x54, 0x11, 0x51, 0x70, 0xc9, 0x20, 0x74, 0x93, 0x2c, 0x82, 0x4b, 0xb9, 0x5c, 0xce, 0xf4, 0x68,
+	0x92, 0x7f, 0xb8, 0x5c, 0x23, 0x9d, 0xfd, 0x02, 0x7e, 0xac, 0x8e, 0x1d, 0x9d, 0x8a, 0x34, 0xdf,
+	0xc0, 0x4f, 0x20, 0x97, 0x17, 0x94, 0x16, 0xdc, 0x8e, 0x87, 0xe7, 0xf9, 0xfd, 0x1f, 0xee, 0x31,
+	0x6e, 0xb9, 0x4f, 0x31, 0x49, 0xd3, 0x88, 0x53, 0x22, 0x39, 0x24, 0x19, 0x4e, 0x09, 0x9d, 0x31,
+	0x39, 0x79, 0x05, 0xf1, 0x46, 0x44, 0x80, 0xf3, 0xd1, 0x96, 0x82, 0x52, 0x01, 0x12, 0x4c, 0x87,
+	0xfb, 0x14, 0xfd, 0x0d, 0xa2, 0x2d, 0x5b, 0x3e, 0xea, 0x9e, 0x86, 0x10, 0x42, 0x69, 0xc7, 0xea,
+	0x55, 0x25, 0xbb, 0x97, 0xea, 0x24, 0x05, 0xc1, 0x30, 0x9d, 0x92, 0x24, 0x61, 0x91, 0xba, 0x51,
+	0x3f, 0x2b, 0x8b, 0xf3, 0xde, 0x32, 0x8e, 0xc6, 0xc9, 0x63, 0xc4, 0xc3, 0xa9, 0x7c, 0x2a, 0xb1,
+	0xe6, 0x9d, 0xd1, 0xae, 0x0e, 0x58, 0x7a, 0x5f, 0x1f, 0x1c, 0x5c, 0x9d, 0x21, 0x55, 0x40, 0x61,
+	0x50, 0x93, 0xcd, 0x47, 0xa8, 0x32, 0xbb, 0xfb, 0xcb, 0x75, 0x4f, 0xf3, 0xea, 0x80, 0x39, 0x33,
+	0x3a, 0x75, 0xa9, 0x49, 0xdd, 0x91, 0x07, 0x56, 0xab, 0xa4, 0x5c, 0xfc, 0x43, 0x19, 0x07, 0x6e,
+	0x5f, 0x71, 0xbe, 0xd7, 0x3d, 0x6b, 0x41, 0xe2, 0xe8, 0xde, 0xd9, 0xa1, 0x38, 0xde, 0x71, 0xad,
+	0x35, 0x11, 0x73, 0x6c, 0x74, 0x04, 0x93, 0x82, 0xb3, 0x6c, 0x22, 0x58, 0x4c, 0x78, 0xc2, 0x93,
+	0xd0, 0xda, 0xeb, 0xeb, 0x83, 0x43, 0xf7, 0xfc, 0x97, 0xb4, 0x63, 0x71, 0xbc, 0x93, 0x5a, 0xf3,
+	0x1a, 0xc9, 0x7d, 0x5e, 0x6e, 0x6c, 0x7d, 0xb5, 0xb1, 0xf5, 0xaf, 0x8d, 0xad, 0x7f, 0x14, 0xb6,
+	0xb6, 0x2a, 0x6c, 0xed, 0xb3, 0xb0, 0xb5, 0x97, 0x87, 0x90, 0xcb, 0xe9, 0xdc, 0x47, 0x14, 0x62,
+	0x4c, 0x21, 0x8b, 0x21, 0xc3, 0xdc, 0xa7, 0xc3, 0x10, 0x70, 0x7e, 0x83, 0x63, 0x08, 0xe6, 0x11,
+	0xcb, 0xd4, 0xaa, 0xcd, 0x9a, 0xc3, 0x66, 0x4d, 0xb9, 0x48, 0x59, 0xe6, 0xb7, 0xcb, 0x5f, 0xbe,
+	0xfe, 0x19, 0x00, 0x71, 0x09, 0x26, 0x6f, 0xfd, 0x01, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintPacketForward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ForwardPacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovPacketForward(uint64(l))
+	l = m.ForwardPacketId.Size()
+	n += 1 + l + sovPacketForward(uint64(l))
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovPacketForward(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func sovPacketForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketForward(x uint64) (n int) {
+	return sovPacketForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardPacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketForward = fmt.Errorf("proto: unexpected end of group")
+)
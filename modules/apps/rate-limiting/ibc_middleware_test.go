@@ -0,0 +1,227 @@
+package ratelimiting_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	ratelimiting "github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting"
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+var (
+	// defaultChannelValue and defaultQuota allow a net outflow of 100 and a net inflow of 200 within a window
+	defaultChannelValue = sdk.NewInt(1000)
+	defaultQuota        = types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)
+)
+
+type RateLimitingTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.path = path
+
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitingTestSuite))
+}
+
+// setRateLimit stores a rate limit for the provided denom on the channel of the endpoint using the default channel value
+func (suite *RateLimitingTestSuite) setRateLimit(endpoint *ibctesting.Endpoint, denom string, quota types.Quota) {
+	ctx := endpoint.Chain.GetContext()
+	rateLimit := types.NewRateLimit(
+		types.NewPath(denom, endpoint.ChannelID),
+		quota,
+		types.NewFlow(defaultChannelValue),
+		ctx.BlockTime().Add(quota.Duration()),
+	)
+
+	endpoint.Chain.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+}
+
+func (suite *RateLimitingTestSuite) getFlow(endpoint *ibctesting.Endpoint, denom string) types.Flow {
+	rateLimit, found := endpoint.Chain.GetSimApp().RateLimitingKeeper.GetRateLimit(endpoint.Chain.GetContext(), endpoint.ChannelID, denom)
+	suite.Require().True(found)
+
+	return rateLimit.Flow
+}
+
+func (suite *RateLimitingTestSuite) transfer(amount int64, timeoutHeight clienttypes.Height) (*sdk.Result, error) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, "",
+	)
+
+	return suite.chainA.SendMsgs(msg)
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacket() {
+	suite.setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, defaultQuota)
+
+	res, err := suite.transfer(100, clienttypes.NewHeight(1, 110))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow)
+
+	// the quota has been reached
+	err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())), suite.chainA.SenderAccount.GetAddress(),
+		suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// successful acknowledgement does not revert the outflow
+	err = suite.path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow)
+	suite.Require().False(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.GetSequence(), sdk.DefaultBondDenom))
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+}
+
+func (suite *RateLimitingTestSuite) TestOnRecvPacketQuotaExceeded() {
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	suite.setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(sdk.NewInt(50), sdk.NewInt(50), 24))
+	suite.setRateLimit(suite.path.EndpointB, voucherDenom, defaultQuota)
+
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	res, err := suite.transfer(201, clienttypes.NewHeight(1, 110))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewInt(201), suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow)
+
+	// the packet is acknowledged with an error on chainB
+	err = suite.path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.getFlow(suite.path.EndpointB, voucherDenom).Inflow.IsZero())
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().True(balance.IsZero())
+
+	// the tokens are refunded and the outflow is reverted on chainA
+	suite.Require().True(suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow.IsZero())
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(senderBalance, balance)
+}
+
+func (suite *RateLimitingTestSuite) TestOnAcknowledgementPacket() {
+	testCases := []struct {
+		name       string
+		ack        []byte
+		expOutflow sdk.Int
+		expPass    bool
+	}{
+		{
+			"success acknowledgement",
+			channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+			sdk.NewInt(100),
+			true,
+		},
+		{
+			"error acknowledgement",
+			channeltypes.NewErrorAcknowledgement(transfertypes.ErrInvalidAmount).Acknowledgement(),
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"acknowledgement cannot be decoded",
+			[]byte("invalid acknowledgement"),
+			sdk.NewInt(100),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, defaultQuota)
+
+			res, err := suite.transfer(100, clienttypes.NewHeight(1, 110))
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			module := ratelimiting.NewIBCMiddleware(transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper), suite.chainA.GetSimApp().RateLimitingKeeper)
+			err = module.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, tc.ack, suite.chainA.SenderAccount.GetAddress())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			suite.Require().Equal(tc.expOutflow, suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow)
+			suite.Require().False(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.GetSequence(), sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func (suite *RateLimitingTestSuite) TestOnTimeoutPacket() {
+	suite.setRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, defaultQuota)
+
+	res, err := suite.transfer(100, clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow)
+
+	// need to update chainA's client representing chainB to prove missing ack
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.getFlow(suite.path.EndpointA, sdk.DefaultBondDenom).Outflow.IsZero())
+	suite.Require().False(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.GetSequence(), sdk.DefaultBondDenom))
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker resets the flow of every rate limit whose window has ended. The channel value
+// of the new window is set to the current supply of the denomination.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if ctx.BlockTime().Before(rateLimit.WindowEnd) {
+			continue
+		}
+
+		channelValue := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+		k.startNewWindow(ctx, rateLimit.Path, rateLimit.Quota, channelValue)
+	}
+}
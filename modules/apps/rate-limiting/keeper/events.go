@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+)
+
+// EmitRateLimitEvent emits an event of the given type for the provided path and quota
+func EmitRateLimitEvent(ctx sdk.Context, eventType string, path types.Path, quota types.Quota) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, quota.MaxPercentSend.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, quota.MaxPercentRecv.String()),
+			sdk.NewAttribute(types.AttributeKeyDurationHours, fmt.Sprintf("%d", quota.DurationHours)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
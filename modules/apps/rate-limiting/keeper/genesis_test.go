@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	ctx := suite.chainA.GetContext()
+	rateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		defaultQuota,
+		types.NewFlow(defaultChannelValue),
+		ctx.BlockTime().Add(defaultQuota.Duration()).UTC(),
+	)
+	pendingPacket := types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+	genesisState := types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingSendPacket{pendingPacket})
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.InitGenesis(ctx, *genesisState)
+
+	storedRateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, storedRateLimit)
+	suite.Require().True(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	rateLimit := suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+	suite.chainA.GetSimApp().RateLimitingKeeper.SetPendingSendPacket(suite.chainA.GetContext(), ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+	genesisState := suite.chainA.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainA.GetContext())
+
+	suite.Require().Len(genesisState.RateLimits, 1)
+	suite.Require().Equal(rateLimit.Path, genesisState.RateLimits[0].Path)
+	suite.Require().Equal(rateLimit.Quota, genesisState.RateLimits[0].Quota)
+	suite.Require().Equal(rateLimit.Flow, genesisState.RateLimits[0].Flow)
+	suite.Require().True(rateLimit.WindowEnd.Equal(genesisState.RateLimits[0].WindowEnd))
+	suite.Require().Equal([]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)}, genesisState.PendingSendPackets)
+}
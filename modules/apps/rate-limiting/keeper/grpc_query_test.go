@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expRateLimits = []types.RateLimit{
+					suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+					suite.setRateLimit(sdk.DefaultBondDenom, "channel-1"),
+				}
+			},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				expRateLimits = []types.RateLimit{suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)}
+				suite.setRateLimit(sdk.DefaultBondDenom, "channel-1")
+
+				req.Pagination = &query.PageRequest{Limit: 1}
+			},
+			true,
+		},
+		{
+			"success: no rate limits",
+			func() {
+				expRateLimits = nil
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryRateLimitsRequest{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.RateLimits(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req          *types.QueryRateLimitRequest
+		expRateLimit types.RateLimit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: ibc denom",
+			func() {
+				req.Denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+				expRateLimit = suite.setRateLimit(req.Denom, req.ChannelId)
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req.ChannelId = "channel-1"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expRateLimit = suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+			req = &types.QueryRateLimitRequest{
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.RateLimit(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelRateLimits() {
+	var req *types.QueryChannelRateLimitsRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expRateLimits := []types.RateLimit{
+				suite.setRateLimit("atom", ibctesting.FirstChannelID),
+				suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+			}
+			suite.setRateLimit(sdk.DefaultBondDenom, "channel-1")
+
+			req = &types.QueryChannelRateLimitsRequest{ChannelId: ibctesting.FirstChannelID}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.ChannelRateLimits(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+)
+
+// Middleware must implement the types.ICS4Wrapper expected interface so that it can wrap
+// the IBC channel logic for the underlying application.
+var _ types.ICS4Wrapper = Keeper{}
+
+// Keeper defines the rate limiting middleware keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper   types.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	bankKeeper    types.BankKeeper
+
+	// the address capable of executing the rate limiting messages. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate limiting middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper, authority string,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetAuthority returns the address capable of executing the rate limiting messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SendPacket checks the outflow of the packet against the rate limits of the sending channel
+// before passing the packet to the ICS4Wrapper. An error is returned if any rate limit is exceeded.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.CheckAndUpdateSendFlow(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps IBC ICS4Wrapper WriteAcknowledgement function
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// GetRateLimit returns the rate limit for the given channel and denomination
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	return k.MustUnmarshalRateLimit(bz), true
+}
+
+// SetRateLimit stores the provided rate limit keyed by its channel and denomination
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRateLimit(rateLimit.Path.ChannelId, rateLimit.Path.Denom), k.MustMarshalRateLimit(rateLimit))
+}
+
+// DeleteRateLimit deletes the rate limit for the given channel and denomination
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(channelID, denom))
+}
+
+// GetAllRateLimits returns all the rate limits stored in state
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimitsWithPrefix(ctx, []byte(types.RateLimitKeyPrefix+"/"))
+}
+
+// GetRateLimitsForChannel returns all the rate limits for the given channel
+func (k Keeper) GetRateLimitsForChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	return k.getRateLimitsWithPrefix(ctx, types.KeyRateLimitChannelPrefix(channelID))
+}
+
+func (k Keeper) getRateLimitsWithPrefix(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		rateLimits = append(rateLimits, k.MustUnmarshalRateLimit(iterator.Value()))
+	}
+
+	return rateLimits
+}
+
+// SetPendingSendPacket stores a flag indicating the packet with the given sequence was sent within
+// the current window of the rate limit for the given channel and denomination
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingSendPacket(channelID, sequence, denom), []byte{1})
+}
+
+// HasPendingSendPacket returns true if the packet with the given sequence was sent within the
+// current window of the rate limit for the given channel and denomination
+func (k Keeper) HasPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPendingSendPacket(channelID, sequence, denom))
+}
+
+// DeletePendingSendPacket deletes the pending send packet flag for the given channel, sequence and denomination
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(channelID, sequence, denom))
+}
+
+// DeletePendingSendPacketsForPath deletes all the pending send packets for the given channel and denomination
+func (k Keeper) DeletePendingSendPacketsForPath(ctx sdk.Context, channelID, denom string) {
+	for _, pendingPacket := range k.getPendingSendPacketsWithPrefix(ctx, types.KeyPendingSendPacketChannelPrefix(channelID)) {
+		if pendingPacket.Denom == denom {
+			k.DeletePendingSendPacket(ctx, pendingPacket.ChannelId, pendingPacket.Sequence, pendingPacket.Denom)
+		}
+	}
+}
+
+// GetAllPendingSendPackets returns all the pending send packets stored in state
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	return k.getPendingSendPacketsWithPrefix(ctx, []byte(types.PendingSendPacketKeyPrefix+"/"))
+}
+
+func (k Keeper) getPendingSendPacketsWithPrefix(ctx sdk.Context, keyPrefix []byte) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	var pendingPackets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		channelID, sequence, denom, err := types.ParseKeyPendingSendPacket(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		pendingPackets = append(pendingPackets, types.NewPendingSendPacket(channelID, sequence, denom))
+	}
+
+	return pendingPackets
+}
+
+// MustMarshalRateLimit attempts to encode a RateLimit object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalRateLimit(rateLimit types.RateLimit) []byte {
+	return k.cdc.MustMarshal(&rateLimit)
+}
+
+// MustUnmarshalRateLimit attempts to decode and return a RateLimit object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalRateLimit(bz []byte) types.RateLimit {
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit
+}
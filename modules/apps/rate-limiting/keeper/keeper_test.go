@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+var (
+	defaultQuota        = types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)
+	defaultChannelValue = sdk.NewInt(1000)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.path = path
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RateLimitingKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// setRateLimit stores a rate limit on chainA for the provided denom and channel using the
+// default quota and channel value, with a window ending one day after the current block time.
+func (suite *KeeperTestSuite) setRateLimit(denom, channelID string) types.RateLimit {
+	ctx := suite.chainA.GetContext()
+	rateLimit := types.NewRateLimit(
+		types.NewPath(denom, channelID),
+		defaultQuota,
+		types.NewFlow(defaultChannelValue),
+		ctx.BlockTime().Add(defaultQuota.Duration()),
+	)
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+
+	return rateLimit
+}
+
+func (suite *KeeperTestSuite) TestGetAuthority() {
+	authority := suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority()
+	suite.Require().Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), authority)
+}
+
+func (suite *KeeperTestSuite) TestRateLimits() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	_, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	expRateLimits := []types.RateLimit{
+		suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		suite.setRateLimit("transfer/channel-10/uatom", ibctesting.FirstChannelID),
+		suite.setRateLimit(sdk.DefaultBondDenom, "channel-1"),
+	}
+
+	rateLimit, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(expRateLimits[0], rateLimit)
+
+	suite.Require().ElementsMatch(expRateLimits, keeper.GetAllRateLimits(ctx))
+	suite.Require().ElementsMatch(expRateLimits[:2], keeper.GetRateLimitsForChannel(ctx, ibctesting.FirstChannelID))
+	suite.Require().Empty(keeper.GetRateLimitsForChannel(ctx, "channel-2"))
+
+	keeper.DeleteRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+
+	_, found = keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+	suite.Require().ElementsMatch(expRateLimits[1:], keeper.GetAllRateLimits(ctx))
+}
+
+func (suite *KeeperTestSuite) TestPendingSendPackets() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	expPendingPackets := []types.PendingSendPacket{
+		types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom),
+		types.NewPendingSendPacket(ibctesting.FirstChannelID, 2, sdk.DefaultBondDenom),
+		types.NewPendingSendPacket(ibctesting.FirstChannelID, 2, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"),
+		types.NewPendingSendPacket("channel-1", 1, sdk.DefaultBondDenom),
+	}
+
+	for _, pendingPacket := range expPendingPackets {
+		keeper.SetPendingSendPacket(ctx, pendingPacket.ChannelId, pendingPacket.Sequence, pendingPacket.Denom)
+		suite.Require().True(keeper.HasPendingSendPacket(ctx, pendingPacket.ChannelId, pendingPacket.Sequence, pendingPacket.Denom))
+	}
+
+	suite.Require().ElementsMatch(expPendingPackets, keeper.GetAllPendingSendPackets(ctx))
+
+	keeper.DeletePendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+	suite.Require().False(keeper.HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom))
+
+	// only the pending packets of the provided channel and denom are deleted
+	keeper.DeletePendingSendPacketsForPath(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().ElementsMatch(expPendingPackets[2:], keeper.GetAllPendingSendPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+	rateLimit := suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+	rateLimit.Flow.Inflow = sdk.NewInt(10)
+	rateLimit.Flow.Outflow = sdk.NewInt(20)
+	keeper.SetRateLimit(ctx, rateLimit)
+	keeper.SetPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+	// window has not ended yet
+	keeper.BeginBlocker(ctx.WithBlockTime(rateLimit.WindowEnd.Add(-time.Second)))
+
+	storedRateLimit, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, storedRateLimit)
+	suite.Require().True(keeper.HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom))
+
+	// window has ended, flow is reset using the current supply
+	ctx = ctx.WithBlockTime(rateLimit.WindowEnd)
+	keeper.BeginBlocker(ctx)
+
+	storedRateLimit, found = keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewFlow(supply), storedRateLimit.Flow)
+	suite.Require().Equal(rateLimit.Quota, storedRateLimit.Quota)
+	suite.Require().Equal(ctx.BlockTime().Add(defaultQuota.Duration()), storedRateLimit.WindowEnd)
+	suite.Require().False(keeper.HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom))
+}
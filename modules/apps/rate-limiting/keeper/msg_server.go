@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// AddRateLimit defines a rpc handler method for MsgAddRateLimit
+// AddRateLimit creates a rate limit for a denomination on an existing transfer channel.
+func (k Keeper) AddRateLimit(goCtx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// only add rate limits for existing transfer channels
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, msg.ChannelId); !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", transfertypes.PortID, msg.ChannelId)
+	}
+
+	path := types.NewPath(msg.Denom, msg.ChannelId)
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.addRateLimit(ctx, path, quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit added", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	EmitRateLimitEvent(ctx, types.EventTypeAddRateLimit, path, quota)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit defines a rpc handler method for MsgUpdateRateLimit
+// UpdateRateLimit updates the quota of an existing rate limit and resets its flow.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	path := types.NewPath(msg.Denom, msg.ChannelId)
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.updateRateLimit(ctx, path, quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit updated", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	EmitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, path, quota)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines a rpc handler method for MsgRemoveRateLimit
+// RemoveRateLimit deletes an existing rate limit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom (%s) channel ID (%s)", msg.Denom, msg.ChannelId)
+	}
+
+	k.removeRateLimit(ctx, rateLimit.Path)
+
+	k.Logger(ctx).Info("rate limit removed", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	EmitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, rateLimit.Path, rateLimit.Quota)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit defines a rpc handler method for MsgResetRateLimit
+// ResetRateLimit resets the flow of an existing rate limit and starts a new window.
+func (k Keeper) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom (%s) channel ID (%s)", msg.Denom, msg.ChannelId)
+	}
+
+	if err := k.setRateLimitWithNewWindow(ctx, rateLimit.Path, rateLimit.Quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit reset", "denom", msg.Denom, "channel", msg.ChannelId)
+
+	EmitRateLimitEvent(ctx, types.EventTypeResetRateLimit, rateLimit.Path, rateLimit.Quota)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// validateAuthority returns an error if the provided address is not the authority of the module
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
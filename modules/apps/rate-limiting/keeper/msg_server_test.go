@@ -0,0 +1,275 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"rate limit already exists",
+			func() {
+				suite.setRateLimit(msg.Denom, msg.ChannelId)
+			},
+			false,
+		},
+		{
+			"zero channel value",
+			func() {
+				msg.Denom = "nonexistent"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			msg = types.NewMsgAddRateLimit(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID,
+				defaultQuota.MaxPercentSend, defaultQuota.MaxPercentRecv, defaultQuota.DurationHours,
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.AddRateLimit(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(defaultQuota, rateLimit.Quota)
+				suite.Require().Equal(types.NewFlow(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, msg.Denom).Amount), rateLimit.Flow)
+				suite.Require().Equal(ctx.BlockTime().Add(defaultQuota.Duration()), rateLimit.WindowEnd)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimit() {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimit := suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+			rateLimit.Flow.Outflow = sdk.NewInt(50)
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+			msg = types.NewMsgUpdateRateLimit(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID,
+				sdk.NewInt(50), sdk.NewInt(50), 48,
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.UpdateRateLimit(sdk.WrapSDKContext(ctx), msg)
+
+			storedRateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NewQuota(sdk.NewInt(50), sdk.NewInt(50), 48), storedRateLimit.Quota)
+				suite.Require().Equal(types.NewFlow(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount), storedRateLimit.Flow)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(rateLimit, storedRateLimit)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				msg.Denom = "atom"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetPendingSendPacket(suite.chainA.GetContext(), ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+			msg = types.NewMsgRemoveRateLimit(authtypes.NewModuleAddress(govtypes.ModuleName).String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.RemoveRateLimit(sdk.WrapSDKContext(ctx), msg)
+
+			_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+			hasPendingPacket := suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+				suite.Require().False(hasPendingPacket)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(found)
+				suite.Require().True(hasPendingPacket)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResetRateLimit() {
+	var msg *types.MsgResetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimit := suite.setRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+			rateLimit.Flow.Outflow = sdk.NewInt(50)
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetPendingSendPacket(suite.chainA.GetContext(), ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+			msg = types.NewMsgResetRateLimit(authtypes.NewModuleAddress(govtypes.ModuleName).String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.ResetRateLimit(sdk.WrapSDKContext(ctx), msg)
+
+			storedRateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			hasPendingPacket := suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(rateLimit.Quota, storedRateLimit.Quota)
+				suite.Require().True(storedRateLimit.Flow.Outflow.IsZero())
+				suite.Require().False(hasPendingPacket)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(rateLimit, storedRateLimit)
+				suite.Require().True(hasPendingPacket)
+			}
+		})
+	}
+}
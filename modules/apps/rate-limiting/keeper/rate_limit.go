@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+)
+
+// addRateLimit creates a new rate limit for the given path and quota. The flow of the
+// rate limit is initialized using the current supply of the denomination.
+func (k Keeper) addRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); found {
+		return sdkerrors.Wrapf(types.ErrRateLimitAlreadyExists, "denom (%s) channel ID (%s)", path.Denom, path.ChannelId)
+	}
+
+	return k.setRateLimitWithNewWindow(ctx, path, quota)
+}
+
+// updateRateLimit updates the quota of an existing rate limit. The flow of the rate limit
+// is reset and a new window is started.
+func (k Keeper) updateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom (%s) channel ID (%s)", path.Denom, path.ChannelId)
+	}
+
+	return k.setRateLimitWithNewWindow(ctx, path, quota)
+}
+
+// removeRateLimit deletes the rate limit for the given path along with its pending send packets.
+func (k Keeper) removeRateLimit(ctx sdk.Context, path types.Path) {
+	k.DeleteRateLimit(ctx, path.ChannelId, path.Denom)
+	k.DeletePendingSendPacketsForPath(ctx, path.ChannelId, path.Denom)
+}
+
+// setRateLimitWithNewWindow stores the rate limit for the given path and quota with a flow
+// starting at the current block time. It returns an error if the supply of the denomination is zero.
+func (k Keeper) setRateLimitWithNewWindow(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	channelValue := k.bankKeeper.GetSupply(ctx, path.Denom).Amount
+	if channelValue.IsZero() {
+		return sdkerrors.Wrapf(types.ErrZeroChannelValue, "supply of denom (%s) is zero", path.Denom)
+	}
+
+	k.startNewWindow(ctx, path, quota, channelValue)
+	return nil
+}
+
+// startNewWindow stores the rate limit for the given path and quota with an empty flow for the provided
+// channel value. Packets sent within the previous window can no longer revert the outflow of the new window.
+func (k Keeper) startNewWindow(ctx sdk.Context, path types.Path, quota types.Quota, channelValue sdk.Int) {
+	windowEnd := ctx.BlockTime().Add(quota.Duration())
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota, types.NewFlow(channelValue), windowEnd))
+	k.DeletePendingSendPacketsForPath(ctx, path.ChannelId, path.Denom)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+)
+
+// CheckAndUpdateSendFlow adds the amount of every token sent in the packet to the outflow of the
+// rate limit for the token denomination and the source channel, if any. An error is returned if any
+// rate limit is exceeded. Packets which cannot be decoded as ICS-20 packet data are not rate limited.
+func (k Keeper) CheckAndUpdateSendFlow(ctx sdk.Context, packet ibcexported.PacketI) error {
+	data, ok := k.getPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if !ok {
+		return nil
+	}
+
+	for _, token := range data.Tokens {
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			continue
+		}
+
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetSourceChannel(), denom)
+		if !found {
+			continue
+		}
+
+		if err := rateLimit.Flow.AddOutflow(amount, rateLimit.Quota); err != nil {
+			return err
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+		k.SetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom)
+	}
+
+	return nil
+}
+
+// CheckAndUpdateRecvFlow adds the amount of every token received in the packet to the inflow of the
+// rate limit for the token denomination on this chain and the destination channel, if any. An error
+// is returned if any rate limit is exceeded. Packets which cannot be decoded as ICS-20 packet data are
+// not rate limited.
+func (k Keeper) CheckAndUpdateRecvFlow(ctx sdk.Context, packet ibcexported.PacketI) error {
+	data, ok := k.getPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if !ok {
+		return nil
+	}
+
+	for _, token := range data.Tokens {
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			continue
+		}
+
+		denom := getReceivedDenom(packet, token.Denom)
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetDestChannel(), denom)
+		if !found {
+			continue
+		}
+
+		if err := rateLimit.Flow.AddInflow(amount, rateLimit.Quota); err != nil {
+			return err
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// RevertSendFlow subtracts the amount of every token sent in the packet from the outflow of the rate limit
+// for the token denomination and the source channel. This is expected to be called when the packet times out
+// or is acknowledged with an error, since the tokens are refunded to the sender. The outflow is only reverted
+// if the packet was sent within the current window of the rate limit.
+func (k Keeper) RevertSendFlow(ctx sdk.Context, packet ibcexported.PacketI) {
+	k.completeSendPacket(ctx, packet, true)
+}
+
+// CompleteSendFlow removes the pending send packet entries of a packet which has been successfully acknowledged.
+func (k Keeper) CompleteSendFlow(ctx sdk.Context, packet ibcexported.PacketI) {
+	k.completeSendPacket(ctx, packet, false)
+}
+
+func (k Keeper) completeSendPacket(ctx sdk.Context, packet ibcexported.PacketI, revert bool) {
+	data, ok := k.getPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if !ok {
+		return
+	}
+
+	for _, token := range data.Tokens {
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+		if !k.HasPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom) {
+			continue
+		}
+
+		k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom)
+
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !revert || !ok {
+			continue
+		}
+
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetSourceChannel(), denom)
+		if !found {
+			continue
+		}
+
+		rateLimit.Flow.RevertOutflow(amount)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// getPacketData decodes the ICS-20 packet data according to the application version of the channel.
+func (k Keeper) getPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (transfertypes.FungibleTokenPacketDataV2, bool) {
+	version, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, false
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(bz, version)
+	if err != nil {
+		return transfertypes.FungibleTokenPacketDataV2{}, false
+	}
+
+	return data, true
+}
+
+// getReceivedDenom returns the denomination on this chain of a token received in the packet,
+// i.e. the native denomination if this chain is the source of the token, or the ibc voucher
+// denomination otherwise.
+func getReceivedDenom(packet ibcexported.PacketI, fullDenomPath string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), fullDenomPath) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(fullDenomPath[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), fullDenomPath)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// newSendPacket returns a transfer packet sent from chainA to chainB over the suite path
+func (suite *KeeperTestSuite) newSendPacket(sequence uint64, denom string, amount int64) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(
+		denom, sdk.NewInt(amount).String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+	)
+
+	return channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+}
+
+// newRecvPacket returns a transfer packet sent from chainB to chainA over the suite path
+func (suite *KeeperTestSuite) newRecvPacket(sequence uint64, denom string, amount int64) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(
+		denom, sdk.NewInt(amount).String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "",
+	)
+
+	return channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+}
+
+func (suite *KeeperTestSuite) TestCheckAndUpdateSendFlow() {
+	var packet channeltypes.Packet
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expOutflow sdk.Int
+		expPass    bool
+	}{
+		{
+			"success",
+			func() {},
+			sdk.NewInt(60),
+			true,
+		},
+		{
+			"success: net outflow at quota",
+			func() {
+				packet = suite.newSendPacket(1, sdk.DefaultBondDenom, 100)
+			},
+			sdk.NewInt(100),
+			true,
+		},
+		{
+			"success: inflow offsets outflow",
+			func() {
+				rateLimit, _ := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				rateLimit.Flow.Inflow = sdk.NewInt(50)
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+				packet = suite.newSendPacket(1, sdk.DefaultBondDenom, 150)
+			},
+			sdk.NewInt(150),
+			true,
+		},
+		{
+			"success: no rate limit for denom",
+			func() {
+				packet = suite.newSendPacket(1, "atom", 2000)
+			},
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"success: packet data is not ICS-20 packet data",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"quota exceeded",
+			func() {
+				packet = suite.newSendPacket(1, sdk.DefaultBondDenom, 101)
+			},
+			sdk.ZeroInt(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+
+			suite.setRateLimit(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			packet = suite.newSendPacket(1, sdk.DefaultBondDenom, 60)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().RateLimitingKeeper.CheckAndUpdateSendFlow(ctx, packet)
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+
+			hasPendingPacket := suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1, sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(!tc.expOutflow.IsZero(), hasPendingPacket)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+				suite.Require().False(hasPendingPacket)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCheckAndUpdateRecvFlow() {
+	var (
+		packet     channeltypes.Packet
+		localDenom string
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expInflow sdk.Int
+		expPass   bool
+	}{
+		{
+			"success: receiver chain is not the source of the token",
+			func() {},
+			sdk.NewInt(150),
+			true,
+		},
+		{
+			"success: receiver chain is the source of the token",
+			func() {
+				localDenom = sdk.DefaultBondDenom
+				suite.setRateLimit(localDenom, suite.path.EndpointA.ChannelID)
+
+				denomPath := transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+				packet = suite.newRecvPacket(1, denomPath, 150)
+			},
+			sdk.NewInt(150),
+			true,
+		},
+		{
+			"success: packet data is not ICS-20 packet data",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"quota exceeded",
+			func() {
+				packet = suite.newRecvPacket(1, sdk.DefaultBondDenom, 201)
+			},
+			sdk.ZeroInt(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+
+			// the voucher denom of the native token of chainB received on chainA
+			voucherDenom := transfertypes.ParseDenomTrace(
+				transfertypes.GetPrefixedDenom(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom),
+			).IBCDenom()
+
+			localDenom = voucherDenom
+			suite.setRateLimit(voucherDenom, suite.path.EndpointA.ChannelID)
+			packet = suite.newRecvPacket(1, sdk.DefaultBondDenom, 150)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().RateLimitingKeeper.CheckAndUpdateRecvFlow(ctx, packet)
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, localDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expInflow, rateLimit.Flow.Inflow)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevertSendFlow() {
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expOutflow sdk.Int
+	}{
+		{
+			"success: outflow is reverted",
+			func() {},
+			sdk.NewInt(20),
+		},
+		{
+			"packet was sent within a previous window",
+			func() {
+				suite.chainA.GetSimApp().RateLimitingKeeper.DeletePendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 1, sdk.DefaultBondDenom)
+			},
+			sdk.NewInt(80),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+
+			suite.setRateLimit(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+			packet := suite.newSendPacket(1, sdk.DefaultBondDenom, 60)
+			err := suite.chainA.GetSimApp().RateLimitingKeeper.CheckAndUpdateSendFlow(suite.chainA.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			err = suite.chainA.GetSimApp().RateLimitingKeeper.CheckAndUpdateSendFlow(suite.chainA.GetContext(), suite.newSendPacket(2, sdk.DefaultBondDenom, 20))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().RateLimitingKeeper.RevertSendFlow(ctx, packet)
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+			suite.Require().False(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1, sdk.DefaultBondDenom))
+			suite.Require().True(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 2, sdk.DefaultBondDenom))
+
+			// reverting the same packet twice has no effect
+			suite.chainA.GetSimApp().RateLimitingKeeper.RevertSendFlow(ctx, packet)
+
+			rateLimit, _ = suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCompleteSendFlow() {
+	suite.coordinator.Setup(suite.path)
+	suite.setRateLimit(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+	ctx := suite.chainA.GetContext()
+	packet := suite.newSendPacket(1, sdk.DefaultBondDenom, 60)
+	err := suite.chainA.GetSimApp().RateLimitingKeeper.CheckAndUpdateSendFlow(ctx, packet)
+	suite.Require().NoError(err)
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.CompleteSendFlow(ctx, packet)
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(60), rateLimit.Flow.Outflow)
+	suite.Require().False(suite.chainA.GetSimApp().RateLimitingKeeper.HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1, sdk.DefaultBondDenom))
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rate limiting middleware sentinel errors
+var (
+	ErrRateLimitNotFound      = sdkerrors.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExists = sdkerrors.Register(ModuleName, 3, "rate limit already exists")
+	ErrInvalidQuota           = sdkerrors.Register(ModuleName, 4, "invalid rate limit quota")
+	ErrZeroChannelValue       = sdkerrors.Register(ModuleName, 5, "channel value is zero")
+	ErrQuotaExceeded          = sdkerrors.Register(ModuleName, 6, "rate limit quota exceeded")
+)
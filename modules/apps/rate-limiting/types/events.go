@@ -0,0 +1,15 @@
+package types
+
+// rate limiting middleware events
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+
+	AttributeKeyDenom          = "denom"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyMaxPercentSend = "max_percent_send"
+	AttributeKeyMaxPercentRecv = "max_percent_recv"
+	AttributeKeyDurationHours  = "duration_hours"
+)
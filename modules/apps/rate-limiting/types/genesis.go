@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// NewGenesisState creates a rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate limiting middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenPaths := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.Path.ChannelId, rateLimit.Path.Denom))
+		if seenPaths[key] {
+			return sdkerrors.Wrap(ErrRateLimitAlreadyExists, fmt.Sprintf("duplicate rate limit for denom %s on channel %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId))
+		}
+		seenPaths[key] = true
+	}
+
+	for _, pendingPacket := range gs.PendingSendPackets {
+		if err := host.ChannelIdentifierValidator(pendingPacket.ChannelId); err != nil {
+			return err
+		}
+
+		if pendingPacket.Sequence == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, "pending send packet sequence cannot be zero")
+		}
+
+		if !seenPaths[string(KeyRateLimit(pendingPacket.ChannelId, pendingPacket.Denom))] {
+			return sdkerrors.Wrapf(ErrRateLimitNotFound, "pending send packet for denom %s on channel %s", pendingPacket.Denom, pendingPacket.ChannelId)
+		}
+	}
+
+	return nil
+}
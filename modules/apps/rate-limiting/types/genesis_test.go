@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	rateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24),
+		types.NewFlow(sdk.NewInt(1000)),
+		time.Now(),
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success - default genesis",
+			func() {
+				genState = types.DefaultGenesisState()
+			},
+			true,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid rate limit",
+			func() {
+				invalidRateLimit := rateLimit
+				invalidRateLimit.Quota = types.NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 24)
+				genState.RateLimits = []types.RateLimit{invalidRateLimit}
+			},
+			false,
+		},
+		{
+			"duplicate rate limit",
+			func() {
+				genState.RateLimits = append(genState.RateLimits, rateLimit)
+			},
+			false,
+		},
+		{
+			"invalid pending send packet channel ID",
+			func() {
+				genState.PendingSendPackets[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"zero pending send packet sequence",
+			func() {
+				genState.PendingSendPackets[0].Sequence = 0
+			},
+			false,
+		},
+		{
+			"pending send packet without rate limit",
+			func() {
+				genState.PendingSendPackets[0].Denom = "atom"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genState = types.NewGenesisState(
+				[]types.RateLimit{rateLimit},
+				[]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom)},
+			)
+
+			tc.malleate()
+
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
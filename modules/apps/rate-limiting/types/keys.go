@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for the packets sent within the current window of a rate limit
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit for the given channel and denomination is stored.
+// The denomination is the last component of the key since it may contain slashes.
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyRateLimitChannelPrefix returns the key prefix for all the rate limits of the given channel
+func KeyRateLimitChannelPrefix(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RateLimitKeyPrefix, channelID))
+}
+
+// KeyPendingSendPacket returns the key under which a packet sent within the current window
+// of the rate limit for the given channel and denomination is stored.
+func KeyPendingSendPacket(channelID string, sequence uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/%s", PendingSendPacketKeyPrefix, channelID, sequence, denom))
+}
+
+// KeyPendingSendPacketChannelPrefix returns the key prefix for all the pending send packets of the given channel
+func KeyPendingSendPacketChannelPrefix(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", PendingSendPacketKeyPrefix, channelID))
+}
+
+// ParseKeyPendingSendPacket parses the key used to store a pending send packet and returns
+// the channel identifier, sequence and denomination.
+func ParseKeyPendingSendPacket(key string) (channelID string, sequence uint64, denom string, err error) {
+	keySplit := strings.SplitN(key, "/", 4)
+	if len(keySplit) != 4 || keySplit[0] != PendingSendPacketKeyPrefix {
+		return "", 0, "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	sequence, err = strconv.ParseUint(keySplit[2], 10, 64)
+	if err != nil {
+		return "", 0, "", err
+	}
+
+	return keySplit[1], sequence, keySplit[3], nil
+}
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func TestKeyRateLimit(t *testing.T) {
+	key := types.KeyRateLimit(ibctesting.FirstChannelID, "transfer/channel-1/uatom")
+	require.Equal(t, "rateLimit/channel-0/transfer/channel-1/uatom", string(key))
+}
+
+func TestParseKeyPendingSendPacket(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyPendingSendPacket(ibctesting.FirstChannelID, 1, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")),
+			true,
+		},
+		{
+			"incorrect key prefix",
+			fmt.Sprintf("%s/%s/%d/%s", types.RateLimitKeyPrefix, ibctesting.FirstChannelID, 1, "stake"),
+			false,
+		},
+		{
+			"incorrect key length",
+			fmt.Sprintf("%s/%s/%d", types.PendingSendPacketKeyPrefix, ibctesting.FirstChannelID, 1),
+			false,
+		},
+		{
+			"invalid sequence",
+			fmt.Sprintf("%s/%s/%s/%s", types.PendingSendPacketKeyPrefix, ibctesting.FirstChannelID, "sequence", "stake"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			channelID, sequence, denom, err := types.ParseKeyPendingSendPacket(tc.key)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.FirstChannelID, channelID)
+				require.Equal(t, uint64(1), sequence)
+				require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", denom)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
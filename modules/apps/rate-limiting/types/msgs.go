@@ -0,0 +1,136 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAddRateLimit{}
+	_ sdk.Msg = &MsgUpdateRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+// NewMsgAddRateLimit creates a new instance of MsgAddRateLimit
+func NewMsgAddRateLimit(authority, denom, channelID string, maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Authority:      authority,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgUpdateRateLimit creates a new instance of MsgUpdateRateLimit
+func NewMsgUpdateRateLimit(authority, denom, channelID string, maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Authority:      authority,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgRemoveRateLimit creates a new instance of MsgRemoveRateLimit
+func NewMsgRemoveRateLimit(authority, denom, channelID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelId).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgResetRateLimit creates a new instance of MsgResetRateLimit
+func NewMsgResetRateLimit(authority, denom, channelID string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Authority: authority,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelId).Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Authority)}
+}
+
+func validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from authority address")
+	}
+
+	return nil
+}
+
+func mustAccAddressFromBech32(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
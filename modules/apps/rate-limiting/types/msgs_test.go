@@ -0,0 +1,179 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+func TestMsgAddRateLimitValidation(t *testing.T) {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				msg.Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				msg.MaxPercentSend = sdk.NewInt(101)
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgAddRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdk.NewInt(10), sdk.NewInt(20), 24)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateRateLimitValidation(t *testing.T) {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = "invalid channel"
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				msg.DurationHours = 0
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgUpdateRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdk.NewInt(10), sdk.NewInt(20), 24)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveRateLimitValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRemoveRateLimit
+		expPass bool
+	}{
+		{"success", types.NewMsgRemoveRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID), true},
+		{"invalid authority", types.NewMsgRemoveRateLimit("invalid-address", sdk.DefaultBondDenom, ibctesting.FirstChannelID), false},
+		{"invalid denom", types.NewMsgRemoveRateLimit(authority.String(), "", ibctesting.FirstChannelID), false},
+		{"invalid channel ID", types.NewMsgRemoveRateLimit(authority.String(), sdk.DefaultBondDenom, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgResetRateLimitValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgResetRateLimit
+		expPass bool
+	}{
+		{"success", types.NewMsgResetRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID), true},
+		{"invalid authority", types.NewMsgResetRateLimit("", sdk.DefaultBondDenom, ibctesting.FirstChannelID), false},
+		{"invalid denom", types.NewMsgResetRateLimit(authority.String(), "", ibctesting.FirstChannelID), false},
+		{"invalid channel ID", types.NewMsgResetRateLimit(authority.String(), sdk.DefaultBondDenom, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgGetSigners(t *testing.T) {
+	msgs := []sdk.Msg{
+		types.NewMsgAddRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdk.NewInt(10), sdk.NewInt(20), 24),
+		types.NewMsgUpdateRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdk.NewInt(10), sdk.NewInt(20), 24),
+		types.NewMsgRemoveRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		types.NewMsgResetRateLimit(authority.String(), sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+	}
+
+	for _, msg := range msgs {
+		require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	}
+}
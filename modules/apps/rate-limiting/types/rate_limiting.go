@@ -0,0 +1,147 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// MaxPercent defines the maximum percentage of the channel value which can be set in a quota
+var MaxPercent = sdk.NewInt(100)
+
+// NewPath creates a new Path instance
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a stateless validation of the denomination and channel identifier
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(p.ChannelId)
+}
+
+// NewQuota creates a new Quota instance
+func NewQuota(maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs a stateless validation of the quota. Both percentages must be within [0, 100],
+// at least one of them must be non zero and the window duration must be non zero.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(MaxPercent) {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max percent send must be between 0 and %s", MaxPercent)
+	}
+
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(MaxPercent) {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max percent recv must be between 0 and %s", MaxPercent)
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidQuota, "max percent send and max percent recv cannot both be zero")
+	}
+
+	if q.DurationHours == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "duration hours cannot be zero")
+	}
+
+	return nil
+}
+
+// Duration returns the duration of a window
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// threshold returns the maximum net flow allowed for the provided percentage and channel value
+func threshold(maxPercent, channelValue sdk.Int) sdk.Int {
+	return channelValue.Mul(maxPercent).Quo(MaxPercent)
+}
+
+// NewFlow creates a new Flow instance with zero inflow and outflow for the provided channel value
+func NewFlow(channelValue sdk.Int) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// AddOutflow adds the provided amount to the outflow. An error is returned if the resulting
+// net outflow exceeds the quota, in which case the flow is left unmodified.
+func (f *Flow) AddOutflow(amount sdk.Int, quota Quota) error {
+	netOutflow := f.Outflow.Add(amount).Sub(f.Inflow)
+	if max := threshold(quota.MaxPercentSend, f.ChannelValue); netOutflow.GT(max) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net outflow (%s) exceeds the maximum allowed (%s) within the current window", netOutflow, max)
+	}
+
+	f.Outflow = f.Outflow.Add(amount)
+	return nil
+}
+
+// AddInflow adds the provided amount to the inflow. An error is returned if the resulting
+// net inflow exceeds the quota, in which case the flow is left unmodified.
+func (f *Flow) AddInflow(amount sdk.Int, quota Quota) error {
+	netInflow := f.Inflow.Add(amount).Sub(f.Outflow)
+	if max := threshold(quota.MaxPercentRecv, f.ChannelValue); netInflow.GT(max) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net inflow (%s) exceeds the maximum allowed (%s) within the current window", netInflow, max)
+	}
+
+	f.Inflow = f.Inflow.Add(amount)
+	return nil
+}
+
+// RevertOutflow subtracts the provided amount from the outflow. The outflow never becomes negative.
+func (f *Flow) RevertOutflow(amount sdk.Int) {
+	f.Outflow = sdk.MaxInt(f.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(path Path, quota Quota, flow Flow, windowEnd time.Time) RateLimit {
+	return RateLimit{
+		Path:      path,
+		Quota:     quota,
+		Flow:      flow,
+		WindowEnd: windowEnd,
+	}
+}
+
+// Validate performs a stateless validation of the rate limit
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	for _, amount := range []sdk.Int{rl.Flow.Inflow, rl.Flow.Outflow, rl.Flow.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "flow amounts cannot be nil or negative")
+		}
+	}
+
+	return nil
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(channelID string, sequence uint64, denom string) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Denom:     denom,
+	}
+}
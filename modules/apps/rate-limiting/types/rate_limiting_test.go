@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"success", types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24), true},
+		{"success: zero max percent send", types.NewQuota(sdk.ZeroInt(), sdk.NewInt(20), 24), true},
+		{"success: zero max percent recv", types.NewQuota(sdk.NewInt(10), sdk.ZeroInt(), 24), true},
+		{"success: max percent of 100", types.NewQuota(sdk.NewInt(100), sdk.NewInt(100), 1), true},
+		{"nil max percent send", types.NewQuota(sdk.Int{}, sdk.NewInt(20), 24), false},
+		{"nil max percent recv", types.NewQuota(sdk.NewInt(10), sdk.Int{}, 24), false},
+		{"negative max percent send", types.NewQuota(sdk.NewInt(-1), sdk.NewInt(20), 24), false},
+		{"max percent recv greater than 100", types.NewQuota(sdk.NewInt(10), sdk.NewInt(101), 24), false},
+		{"both max percents zero", types.NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 24), false},
+		{"zero duration", types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 0), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFlowAddOutflow(t *testing.T) {
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)
+
+	// channel value of 1000 allows a net outflow of 100
+	flow := types.NewFlow(sdk.NewInt(1000))
+
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(60), quota))
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(40), quota))
+	require.Equal(t, sdk.NewInt(100), flow.Outflow)
+
+	err := flow.AddOutflow(sdk.NewInt(1), quota)
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), flow.Outflow, "flow must be unmodified when the quota is exceeded")
+
+	// inflow offsets outflow
+	require.NoError(t, flow.AddInflow(sdk.NewInt(50), quota))
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(50), quota))
+	require.Equal(t, sdk.NewInt(150), flow.Outflow)
+}
+
+func TestFlowAddInflow(t *testing.T) {
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)
+
+	// channel value of 1000 allows a net inflow of 200
+	flow := types.NewFlow(sdk.NewInt(1000))
+
+	require.NoError(t, flow.AddInflow(sdk.NewInt(200), quota))
+
+	err := flow.AddInflow(sdk.NewInt(1), quota)
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(200), flow.Inflow, "flow must be unmodified when the quota is exceeded")
+
+	// a zero max percent disables the direction entirely
+	flow = types.NewFlow(sdk.NewInt(1000))
+	err = flow.AddInflow(sdk.NewInt(1), types.NewQuota(sdk.NewInt(10), sdk.ZeroInt(), 24))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+}
+
+func TestFlowRevertOutflow(t *testing.T) {
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)
+	flow := types.NewFlow(sdk.NewInt(1000))
+
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(100), quota))
+
+	flow.RevertOutflow(sdk.NewInt(40))
+	require.Equal(t, sdk.NewInt(60), flow.Outflow)
+
+	flow.RevertOutflow(sdk.NewInt(100))
+	require.Equal(t, sdk.ZeroInt(), flow.Outflow)
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	var rateLimit types.RateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				rateLimit.Path.Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				rateLimit.Path.ChannelId = "invalid channel"
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				rateLimit.Quota.DurationHours = 0
+			},
+			false,
+		},
+		{
+			"nil inflow",
+			func() {
+				rateLimit.Flow.Inflow = sdk.Int{}
+			},
+			false,
+		},
+		{
+			"negative outflow",
+			func() {
+				rateLimit.Flow.Outflow = sdk.NewInt(-1)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit = types.NewRateLimit(
+				types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+				types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24),
+				types.NewFlow(sdk.NewInt(1000)),
+				time.Now(),
+			)
+
+			tc.malleate()
+
+			err := rateLimit.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -17,9 +17,15 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
+		GetCmdQueryDenomTracesByBaseDenom(),
+		GetCmdQueryDenomTracesByChannel(),
+		GetCmdQueryDenomTracesByHops(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryChannelTransferEnabled(),
+		GetCmdQueryDenomTransferEnabled(),
 	)
 
 	return queryCmd
@@ -37,6 +43,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewGrantTransferAuthorizationCmd(),
 	)
 
 	return txCmd
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -82,6 +83,132 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomTracesByBaseDenom defines the command to query the denomination trace infos
+// with a given base denomination.
+func GetCmdQueryDenomTracesByBaseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-base-denom [base-denom]",
+		Short:   "Query the trace info for all token denominations with a base denomination",
+		Long:    "Query the trace info for all token denominations with a base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-base-denom uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByBaseDenomRequest{
+				BaseDenom:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByBaseDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by base denomination")
+
+	return cmd
+}
+
+// GetCmdQueryDenomTracesByChannel defines the command to query the denomination trace infos
+// whose path starts with a given port and channel.
+func GetCmdQueryDenomTracesByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-channel [port-id] [channel-id]",
+		Short:   "Query the trace info for all token denominations received over a channel",
+		Long:    "Query the trace info for all token denominations whose trace path starts with the port and channel identifiers",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-channel transfer channel-12", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by channel")
+
+	return cmd
+}
+
+// GetCmdQueryDenomTracesByHops defines the command to query the denomination trace infos
+// with a given number of hops.
+func GetCmdQueryDenomTracesByHops() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-hops [hops]",
+		Short:   "Query the trace info for all token denominations with a number of hops",
+		Long:    "Query the trace info for all token denominations whose trace path contains the number of port and channel identifier pairs",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-hops 2", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			hops, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByHopsRequest{
+				Hops:       hops,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByHops(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by hops")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -167,3 +294,99 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTotalEscrowForDenom defines the command to query the total amount of tokens in escrow for a denom.
+func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [denom]",
+		Short:   "Query the total amount of tokens in escrow for a denom",
+		Long:    "Query the total amount of tokens in escrow for a denom",
+		Example: fmt.Sprintf("%s query ibc-transfer total-escrow uosmo", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowForDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalEscrowForDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelTransferEnabled defines the command to query whether transfers over a channel are enabled.
+func GetCmdQueryChannelTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-transfer-enabled [channel-id]",
+		Short:   "Query whether fungible token transfers over a channel are enabled",
+		Long:    "Query whether fungible token transfers from and to this chain over a channel are enabled, taking into account the global send and receive enabled parameters",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-transfer-enabled channel-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelTransferEnabledRequest{
+				ChannelId: args[0],
+			}
+
+			res, err := queryClient.ChannelTransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomTransferEnabled defines the command to query whether transfers of a denomination are enabled.
+func GetCmdQueryDenomTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-transfer-enabled [denom]",
+		Short:   "Query whether fungible token transfers of a denomination are enabled",
+		Long:    "Query whether fungible token transfers of a denomination, as represented on this chain, from and to this chain are enabled, taking into account the global send and receive enabled parameters",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-transfer-enabled ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomTransferEnabledRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomTransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
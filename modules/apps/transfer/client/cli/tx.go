@@ -11,6 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
@@ -22,14 +25,18 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
+	flagAllowList              = "allow-list"
+	flagExpiration             = "expiration"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
-		Short: "Transfer a fungible token through IBC",
-		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified
+		Short: "Transfer fungible tokens through IBC",
+		Long: strings.TrimSpace(`Transfer fungible tokens through IBC. Multiple comma separated coins may be provided
+as the amount when the channel has negotiated the ics20-2 version. Timeouts can be specified
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
@@ -47,15 +54,18 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
+				}
 			}
+			coins = sdk.NewCoins(coins...)
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
 			if err != nil {
@@ -76,6 +86,11 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
 			// if the timeouts are not absolute, retrieve latest block height and block timestamp
 			// for the consensus state connected to the destination port/channel
 			if !absoluteTimeouts {
@@ -110,9 +125,18 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
-			)
+			var msg *types.MsgTransfer
+			if len(coins) == 1 {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+			} else {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, sdk.Coin{}, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+				msg.Tokens = coins
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -120,7 +144,167 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewGrantTransferAuthorizationCmd returns the command to create a MsgGrant transaction granting a
+// TransferAuthorization to the grantee
+func NewGrantTransferAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [src-port] [src-channel] [spend-limit] [[src-port] [src-channel] [spend-limit]...]",
+		Short: "Grant authorization to transfer fungible tokens through IBC on behalf of the granter",
+		Long: strings.TrimSpace(`Grant authorization to the grantee to transfer fungible tokens of the granter through IBC.
+A spend limit is set for each source port and channel pair, and is decremented by every transfer executed by the
+grantee. Multiple comma separated coins may be provided as the spend limit. The receivers of the transfers can be
+restricted on every channel using the "allow-list" flag. The authorization expires at the unix timestamp set using
+the "expiration" flag, if any.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer grant [grantee] transfer channel-0 1000stake,500uatom transfer channel-1 100stake --allow-list cosmos1...,cosmos1...", version.AppName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 4 || (len(args)-1)%3 != 0 {
+				return fmt.Errorf("expected a grantee followed by one or more [src-port] [src-channel] [spend-limit] triples, got %d arguments", len(args))
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowList, err := cmd.Flags().GetStringSlice(flagAllowList)
+			if err != nil {
+				return err
+			}
+
+			var allocations []types.Allocation
+			for i := 1; i < len(args); i += 3 {
+				spendLimit, err := sdk.ParseCoinsNormalized(args[i+2])
+				if err != nil {
+					return err
+				}
+
+				allocations = append(allocations, types.Allocation{
+					SourcePort:    args[i],
+					SourceChannel: args[i+1],
+					SpendLimit:    spendLimit,
+					AllowList:     allowList,
+				})
+			}
+
+			authorization := types.NewTransferAuthorization(allocations...)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if exp != 0 {
+				expirationTime := time.Unix(exp, 0)
+				expiration = &expirationTime
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAllowList, []string{}, "Comma separated list of receivers allowed on every channel. Any receiver is allowed when empty.")
+	cmd.Flags().Int64(flagExpiration, 0, "Expiration time of the authorization as a unix timestamp. The authorization does not expire when set to 0.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitEscrowRecoveryProposal implements a command handler for submitting an escrow recovery proposal transaction.
+func NewCmdSubmitEscrowRecoveryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-recovery [port-id] [channel-id] [recipient] [amount] [[recipient] [amount]...]",
+		Args:  cobra.MinimumNArgs(4),
+		Short: "Submit an escrow recovery proposal",
+		Long: strings.TrimSpace(`Submit a proposal to release the tokens held in the escrow account of a transfer channel along with an initial deposit.
+The channel must be CLOSED or its underlying client must be frozen or expired when the proposal is executed.
+The proposal fails while packets sent over the channel are still awaiting an acknowledgement or timeout.
+Please specify one or more recipients, each followed by the tokens released to it.`),
+		Example: fmt.Sprintf("%s tx gov submit-legacy-proposal escrow-recovery transfer channel-0 cosmos1... 100stake --title=\"Recover escrow\" --description=\"...\" --deposit=\"10stake\"", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck // need this till full govv1 conversion.
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck // need this till full govv1 conversion.
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+
+			recipientArgs := args[2:]
+			if len(recipientArgs)%2 != 0 {
+				return fmt.Errorf("each recipient must be followed by an amount, got %d arguments", len(recipientArgs))
+			}
+
+			var recipients []types.EscrowRecipient
+			for i := 0; i < len(recipientArgs); i += 2 {
+				amount, err := sdk.ParseCoinsNormalized(recipientArgs[i+1])
+				if err != nil {
+					return err
+				}
+
+				recipients = append(recipients, types.NewEscrowRecipient(recipientArgs[i], amount))
+			}
+
+			content := types.NewEscrowRecoveryProposal(title, description, portID, channelID, recipients)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/client/cli"
+)
+
+// EscrowRecoveryProposalHandler is the escrow recovery proposal handler of the gov CLI
+var EscrowRecoveryProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitEscrowRecoveryProposal)
@@ -30,8 +30,8 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// channel must be UNORDERED and use the correct port (by default 'transfer'). Only 2^32 channels
+// are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -77,11 +77,11 @@ func (im IBCModule) OnChanOpenInit(
 	}
 
 	if strings.TrimSpace(version) == "" {
-		version = types.Version
+		version = types.SupportedVersions[0]
 	}
 
-	if version != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, types.SupportedVersions)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -107,8 +107,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -116,7 +116,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	// the version proposed by the counterparty is accepted as it is supported
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -127,8 +128,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, types.SupportedVersions)
 	}
 	return nil
 }
@@ -171,9 +172,9 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.getPacketData(ctx, packet.GetData(), packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
 		ackErr = sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
 		ack = channeltypes.NewErrorAcknowledgement(ackErr)
 	}
@@ -192,10 +193,12 @@ func (im IBCModule) OnRecvPacket(
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
 	}
+	eventAttributes = append(eventAttributes, tokenAttributes(types.AttributeKeyDenom, types.AttributeKeyAmount, data.Tokens)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+	)
 
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
@@ -223,8 +226,8 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.getPacketData(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
@@ -232,15 +235,21 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(types.AttributeKeyDenom, types.AttributeKeyAmount, data.Tokens)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 	)
 
@@ -270,8 +279,8 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.getPacketData(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
@@ -279,15 +288,44 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount, data.Tokens)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
+			eventAttributes...,
 		),
 	)
 
 	return nil
 }
+
+// getPacketData decodes the packet data bytes according to the ICS20 version negotiated
+// on the provided channel.
+func (im IBCModule) getPacketData(ctx sdk.Context, bz []byte, portID, channelID string) (types.FungibleTokenPacketDataV2, error) {
+	version, found := im.keeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return types.FungibleTokenPacketDataV2{}, sdkerrors.Wrapf(types.ErrInvalidVersion, "application version not found for port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return types.UnmarshalPacketData(bz, version)
+}
+
+// tokenAttributes returns a denomination and amount event attribute for every token, using
+// the provided attribute keys.
+func tokenAttributes(denomKey, amountKey string, tokens []types.Token) []sdk.Attribute {
+	attributes := make([]sdk.Attribute, 0, 2*len(tokens))
+	for _, token := range tokens {
+		attributes = append(attributes,
+			sdk.NewAttribute(denomKey, token.Denom),
+			sdk.NewAttribute(amountKey, token.Amount),
+		)
+	}
+
+	return attributes
+}
@@ -28,6 +28,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: ics20-2 version", func() {
+				channel.Version = types.V2
+			}, true,
+		},
 		{
 			"empty version string", func() {
 				channel.Version = ""
@@ -91,8 +96,13 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 			)
 
 			if tc.expPass {
+				expVersion := channel.Version
+				if expVersion == "" {
+					expVersion = types.V1
+				}
+
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -118,6 +128,11 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: ics20-2 counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -183,7 +198,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -203,6 +218,11 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: ics20-2 counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
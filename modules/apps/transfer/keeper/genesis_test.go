@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 )
 
@@ -12,6 +14,14 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		traces types.Traces
 	)
 
+	totalEscrowed := sdk.NewCoins(
+		sdk.NewCoin("stake", sdk.NewInt(100)),
+		sdk.NewCoin("uatom", sdk.NewInt(50)),
+	)
+	for _, coin := range totalEscrowed {
+		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+	}
+
 	for i := 0; i < 5; i++ {
 		prefix := fmt.Sprintf("transfer/channelToChain%d", i)
 		if i == 0 {
@@ -32,8 +42,14 @@ func (suite *KeeperTestSuite) TestGenesis() {
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal(totalEscrowed, genesis.TotalEscrowed)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
 	})
+
+	for _, coin := range totalEscrowed {
+		totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Denom)
+		suite.Require().Equal(coin, totalEscrow)
+	}
 }
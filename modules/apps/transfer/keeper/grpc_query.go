@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -72,6 +73,75 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomTracesByBaseDenom implements the Query/DenomTracesByBaseDenom gRPC method
+func (q Keeper) DenomTracesByBaseDenom(c context.Context, req *types.QueryDenomTracesByBaseDenomRequest) (*types.QueryDenomTracesByBaseDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denomination cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces, pageRes, err := q.getDenomTracesByIndex(ctx, types.DenomTraceByBaseDenomPrefix(req.BaseDenom), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByBaseDenomResponse{
+		DenomTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DenomTracesByChannel implements the Query/DenomTracesByChannel gRPC method
+func (q Keeper) DenomTracesByChannel(c context.Context, req *types.QueryDenomTracesByChannelRequest) (*types.QueryDenomTracesByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces, pageRes, err := q.getDenomTracesByIndex(ctx, types.DenomTraceByChannelPrefix(req.PortId, req.ChannelId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByChannelResponse{
+		DenomTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DenomTracesByHops implements the Query/DenomTracesByHops gRPC method
+func (q Keeper) DenomTracesByHops(c context.Context, req *types.QueryDenomTracesByHopsRequest) (*types.QueryDenomTracesByHopsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces, pageRes, err := q.getDenomTracesByIndex(ctx, types.DenomTraceByHopsPrefix(req.Hops), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByHopsResponse{
+		DenomTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -121,3 +191,80 @@ func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRe
 		EscrowAddress: addr.String(),
 	}, nil
 }
+
+// TotalEscrowForDenom implements the TotalEscrowForDenom gRPC method.
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amount := q.GetTotalEscrowForDenom(ctx, req.Denom)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: amount,
+	}, nil
+}
+
+// ChannelTransferEnabled implements the ChannelTransferEnabled gRPC method.
+func (q Keeper) ChannelTransferEnabled(c context.Context, req *types.QueryChannelTransferEnabledRequest) (*types.QueryChannelTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryChannelTransferEnabledResponse{
+		SendEnabled:    params.SendEnabled && params.IsChannelSendEnabled(req.ChannelId),
+		ReceiveEnabled: params.ReceiveEnabled && params.IsChannelReceiveEnabled(req.ChannelId),
+	}, nil
+}
+
+// DenomTransferEnabled implements the DenomTransferEnabled gRPC method.
+func (q Keeper) DenomTransferEnabled(c context.Context, req *types.QueryDenomTransferEnabledRequest) (*types.QueryDenomTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryDenomTransferEnabledResponse{
+		SendEnabled:    params.SendEnabled && params.IsDenomSendEnabled(req.Denom),
+		ReceiveEnabled: params.ReceiveEnabled && params.IsDenomReceiveEnabled(req.Denom),
+	}, nil
+}
+
+// getDenomTracesByIndex returns the denomination traces referenced by the index entries with the given key prefix.
+func (q Keeper) getDenomTracesByIndex(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) (types.Traces, *query.PageResponse, error) {
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), indexPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		denomTrace, found := q.GetDenomTrace(ctx, key)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrTraceNotFound, "denomination trace hash %X is indexed but not stored", key)
+		}
+
+		traces = append(traces, denomTrace)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return traces.Sort(), pageRes, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -261,3 +262,405 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
+	var (
+		req             *types.QueryTotalEscrowForDenomRequest
+		expEscrowAmount sdk.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid native denom with escrow amount < 2^63",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+
+				expEscrowAmount = sdk.NewInt(100)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, expEscrowAmount))
+			},
+			true,
+		},
+		{
+			"valid ibc denom with escrow amount > 2^63",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      "transfer/channel-0",
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				expEscrowAmount, _ = sdk.NewIntFromString("100000000000000000000")
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denomTrace.IBCDenom(), expEscrowAmount))
+
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"valid denom with no escrow",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "uatom",
+				}
+
+				expEscrowAmount = sdk.ZeroInt()
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "??𓃠🐾??",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TotalEscrowForDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expEscrowAmount, res.Amount.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelTransferEnabled() {
+	var (
+		req                               *types.QueryChannelTransferEnabledRequest
+		expSendEnabled, expReceiveEnabled bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: channel without entry",
+			func() {
+				req = &types.QueryChannelTransferEnabledRequest{ChannelId: "channel-0"}
+				expSendEnabled, expReceiveEnabled = true, true
+			},
+			true,
+		},
+		{
+			"success: channel with entry",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ChannelEnabled = []types.ChannelEnabled{{ChannelId: "channel-0", SendEnabled: false, ReceiveEnabled: true}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryChannelTransferEnabledRequest{ChannelId: "channel-0"}
+				expSendEnabled, expReceiveEnabled = false, true
+			},
+			true,
+		},
+		{
+			"success: channel entry is overridden by global parameters",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ReceiveEnabled = false
+				params.ChannelEnabled = []types.ChannelEnabled{{ChannelId: "channel-0", SendEnabled: true, ReceiveEnabled: true}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryChannelTransferEnabledRequest{ChannelId: "channel-0"}
+				expSendEnabled, expReceiveEnabled = true, false
+			},
+			true,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryChannelTransferEnabledRequest{ChannelId: ""}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ChannelTransferEnabled(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSendEnabled, res.SendEnabled)
+				suite.Require().Equal(expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTransferEnabled() {
+	var (
+		req                               *types.QueryDenomTransferEnabledRequest
+		expSendEnabled, expReceiveEnabled bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: denom without entry",
+			func() {
+				req = &types.QueryDenomTransferEnabledRequest{Denom: sdk.DefaultBondDenom}
+				expSendEnabled, expReceiveEnabled = true, true
+			},
+			true,
+		},
+		{
+			"success: ibc denom with entry",
+			func() {
+				denom := types.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.DenomEnabled = []types.DenomEnabled{{Denom: denom, SendEnabled: true, ReceiveEnabled: false}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryDenomTransferEnabledRequest{Denom: denom}
+				expSendEnabled, expReceiveEnabled = true, false
+			},
+			true,
+		},
+		{
+			"success: denom entry is overridden by global parameters",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendEnabled = false
+				params.DenomEnabled = []types.DenomEnabled{{Denom: sdk.DefaultBondDenom, SendEnabled: true, ReceiveEnabled: true}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryDenomTransferEnabledRequest{Denom: sdk.DefaultBondDenom}
+				expSendEnabled, expReceiveEnabled = false, true
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryDenomTransferEnabledRequest{Denom: "??𓃠🐾??"}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTransferEnabled(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSendEnabled, res.SendEnabled)
+				suite.Require().Equal(expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTracesByIndex() {
+	var (
+		uatomChannel0   = types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-0"}
+		uatomChannel1   = types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"}
+		uatomMultiHop   = types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-0/transfer/channel-5"}
+		gammChannel0    = types.DenomTrace{BaseDenom: "gamm/pool/1", Path: "transfer/channel-0"}
+		uatomOtherPort  = types.DenomTrace{BaseDenom: "uatom", Path: "customtransfer/channel-0"}
+		uatomsChannel10 = types.DenomTrace{BaseDenom: "uatoms", Path: "transfer/channel-10"}
+		allDenomTraces  = types.Traces{uatomChannel0, uatomChannel1, uatomMultiHop, gammChannel0, uatomOtherPort, uatomsChannel10}
+		expDenomTraces  types.Traces
+		queryFn         func(ctx context.Context) (types.Traces, error)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"by base denom",
+			func() {
+				expDenomTraces = types.Traces{uatomOtherPort, uatomChannel0, uatomMultiHop, uatomChannel1}.Sort()
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uatom"})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+		{
+			"by base denom with slashes",
+			func() {
+				expDenomTraces = types.Traces{gammChannel0}
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "gamm/pool/1"})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+		{
+			"by base denom without traces",
+			func() {
+				expDenomTraces = types.Traces{}
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uosmo"})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+		{
+			"by blank base denom",
+			func() {
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: " "})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			false,
+		},
+		{
+			"by channel",
+			func() {
+				expDenomTraces = types.Traces{uatomChannel0, uatomMultiHop, gammChannel0}.Sort()
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByChannel(ctx, &types.QueryDenomTracesByChannelRequest{PortId: types.PortID, ChannelId: "channel-0"})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+		{
+			"by channel only matches the leading channel",
+			func() {
+				expDenomTraces = types.Traces{}
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByChannel(ctx, &types.QueryDenomTracesByChannelRequest{PortId: types.PortID, ChannelId: "channel-5"})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+		{
+			"by invalid channel",
+			func() {
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByChannel(ctx, &types.QueryDenomTracesByChannelRequest{PortId: types.PortID, ChannelId: ""})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			false,
+		},
+		{
+			"by hops",
+			func() {
+				expDenomTraces = types.Traces{uatomMultiHop}
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByHops(ctx, &types.QueryDenomTracesByHopsRequest{Hops: 2})
+					if err != nil {
+						return nil, err
+					}
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+		{
+			"by hops with pagination",
+			func() {
+				queryFn = func(ctx context.Context) (types.Traces, error) {
+					res, err := suite.queryClient.DenomTracesByHops(ctx, &types.QueryDenomTracesByHopsRequest{Hops: 1, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+					if err != nil {
+						return nil, err
+					}
+
+					suite.Require().Equal(uint64(5), res.Pagination.Total)
+					suite.Require().Len(res.DenomTraces, 2)
+					return res.DenomTraces, nil
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expDenomTraces = nil
+
+			for _, denomTrace := range allDenomTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			denomTraces, err := queryFn(ctx)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				if expDenomTraces != nil {
+					suite.Require().ElementsMatch(expDenomTraces, denomTraces)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
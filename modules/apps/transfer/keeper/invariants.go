@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom",
+		TotalEscrowPerDenomInvariants(k))
+}
+
+// TotalEscrowPerDenomInvariants checks that the total amount of tokens tracked
+// in escrow for each denomination is not greater than the sum of the balances
+// of the escrow accounts of all transfer channels.
+// NOTE: the escrow accounts may hold more than the tracked amount since anyone
+// can send tokens directly to an escrow address.
+func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrowBalances := k.getEscrowAccountBalances(ctx)
+
+		k.IterateTokensInEscrow(ctx, func(totalEscrow sdk.Coin) bool {
+			escrowBalance := escrowBalances.AmountOf(totalEscrow.Denom)
+			if escrowBalance.LT(totalEscrow.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\tdenom: %s, actual escrow balance (%s) is smaller than expected (%s)\n",
+					totalEscrow.Denom, escrowBalance, totalEscrow.Amount)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"total escrow per denom invariance",
+			fmt.Sprintf("found denominations with escrow balances smaller than the tracked total escrow\n%s", msg),
+		), broken
+	}
+}
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -22,6 +26,7 @@ type Keeper struct {
 
 	ics4Wrapper   types.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
 	portKeeper    types.PortKeeper
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
@@ -31,7 +36,7 @@ type Keeper struct {
 // NewKeeper creates a new IBC transfer Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
-	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper, portKeeper types.PortKeeper,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, scopedKeeper types.ScopedKeeper,
 ) Keeper {
 	// ensure ibc transfer module account is set
@@ -50,6 +55,7 @@ func NewKeeper(
 		paramSpace:    paramSpace,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
@@ -62,6 +68,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
 }
 
+// GetAppVersion returns the ICS20 application version negotiated on the given channel.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
 // IsBound checks if the transfer module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -105,11 +116,52 @@ func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes)
 	return store.Has(denomTraceHash)
 }
 
-// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store and updates the
+// base denomination, channel and hops indexes of the denomination trace.
 func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
+	// the index entries of a trace stored under the same hash with a different path and base
+	// denomination split must be removed, see MigrateTraces
+	if existingTrace, found := k.GetDenomTrace(ctx, denomTrace.Hash()); found {
+		k.deleteDenomTraceIndexes(ctx, existingTrace)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
 	bz := k.MustMarshalDenomTrace(denomTrace)
 	store.Set(denomTrace.Hash(), bz)
+
+	k.setDenomTraceIndexes(ctx, denomTrace)
+}
+
+// setDenomTraceIndexes sets the base denomination, channel and hops index entries of the denomination trace.
+func (k Keeper) setDenomTraceIndexes(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range denomTraceIndexKeys(denomTrace) {
+		store.Set(key, []byte{1})
+	}
+}
+
+// deleteDenomTraceIndexes deletes the base denomination, channel and hops index entries of the denomination trace.
+func (k Keeper) deleteDenomTraceIndexes(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range denomTraceIndexKeys(denomTrace) {
+		store.Delete(key)
+	}
+}
+
+// denomTraceIndexKeys returns the keys of the index entries of the denomination trace. Each key
+// is the index key prefix of the trace followed by the trace hash.
+func denomTraceIndexKeys(denomTrace types.DenomTrace) [][]byte {
+	hash := denomTrace.Hash()
+	keys := [][]byte{
+		append(types.DenomTraceByBaseDenomPrefix(denomTrace.BaseDenom), hash...),
+		append(types.DenomTraceByHopsPrefix(denomTrace.Hops()), hash...),
+	}
+
+	if portID, channelID, found := denomTrace.LeadingChannel(); found {
+		keys = append(keys, append(types.DenomTraceByChannelPrefix(portID, channelID), hash...))
+	}
+
+	return keys
 }
 
 // GetAllDenomTraces returns the trace information for all the denominations.
@@ -139,6 +191,110 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// setDenomMetadata sets the bank denomination metadata of the voucher of the denomination trace,
+// such that clients can display the voucher using its base denomination and trace path.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denomTrace.GetFullDenomPath()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denomTrace.IBCDenom(),
+				Exponent: 0,
+			},
+		},
+		// Setting base as IBC hash denom since bank keepers's SetDenomMetadata uses
+		// Base as key path and the IBC hash is what gives this token uniqueness
+		// on the executing chain. The base and display denominations must be listed
+		// in the denomination units for the metadata to pass bank validation.
+		Base:    denomTrace.IBCDenom(),
+		Display: denomTrace.IBCDenom(),
+		Name:    fmt.Sprintf("%s IBC token", denomTrace.GetFullDenomPath()),
+		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+}
+
+// GetTotalEscrowForDenom gets the total amount of source chain tokens that
+// are in escrow, keyed by the denomination.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowForDenomKey)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetTotalEscrowForDenom stores the total amount of source chain tokens that are in escrow.
+// The entry is deleted from the store if the amount is zero. It panics if the amount is negative.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	if coin.Amount.IsNegative() {
+		panic(fmt.Sprintf("amount cannot be negative: %s", coin.Amount))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowForDenomKey)
+	if coin.Amount.IsZero() {
+		store.Delete([]byte(coin.Denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set([]byte(coin.Denom), bz)
+}
+
+// GetAllTotalEscrowed returns the escrow information for all the denominations.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	escrows := sdk.Coins{}
+	k.IterateTokensInEscrow(ctx, func(coin sdk.Coin) bool {
+		escrows = append(escrows, coin)
+		return false
+	})
+
+	return escrows.Sort()
+}
+
+// IterateTokensInEscrow iterates over the denominations in escrow
+// and performs a callback function.
+func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, cb func(coin sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalEscrowForDenomKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.TotalEscrowForDenomKey):])
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		if cb(sdk.NewCoin(denom, amount.Int)) {
+			break
+		}
+	}
+}
+
+// getEscrowAccountBalances returns the sum of the balances held by the escrow
+// accounts of all channels bound to the transfer port.
+func (k Keeper) getEscrowAccountBalances(ctx sdk.Context) sdk.Coins {
+	portID := k.GetPort(ctx)
+
+	balances := sdk.NewCoins()
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return balances
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -44,6 +44,27 @@ func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	return path
 }
 
+func (suite *KeeperTestSuite) TestSetDenomTraceIndexes() {
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+
+	// traces with the same full denomination path are stored under the same hash
+	oldTrace := types.DenomTrace{BaseDenom: "channel-1/uatom", Path: "transfer"}
+	newTrace := types.ParseDenomTrace(oldTrace.GetFullDenomPath())
+	suite.Require().Equal(oldTrace.Hash(), newTrace.Hash())
+
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(ctx, oldTrace)
+	suite.Require().True(store.Has(append(types.DenomTraceByBaseDenomPrefix(oldTrace.BaseDenom), oldTrace.Hash()...)))
+
+	// the index entries of the replaced trace are removed
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(ctx, newTrace)
+	suite.Require().False(store.Has(append(types.DenomTraceByBaseDenomPrefix(oldTrace.BaseDenom), oldTrace.Hash()...)))
+	suite.Require().False(store.Has(append(types.DenomTraceByHopsPrefix(0), oldTrace.Hash()...)))
+	suite.Require().True(store.Has(append(types.DenomTraceByBaseDenomPrefix(newTrace.BaseDenom), newTrace.Hash()...)))
+	suite.Require().True(store.Has(append(types.DenomTraceByHopsPrefix(1), newTrace.Hash()...)))
+	suite.Require().True(store.Has(append(types.DenomTraceByChannelPrefix("transfer", "channel-1"), newTrace.Hash()...)))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
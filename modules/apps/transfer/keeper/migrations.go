@@ -53,6 +53,87 @@ func (m Migrator) MigrateTraces(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateTotalEscrowForDenom sets the total escrow tracked for each denomination
+// to the sum of the balances held by the escrow accounts of all transfer channels.
+func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
+	escrowBalances := m.keeper.getEscrowAccountBalances(ctx)
+	for _, escrowBalance := range escrowBalances {
+		m.keeper.SetTotalEscrowForDenom(ctx, escrowBalance)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set total escrow for denominations", "number of denominations", escrowBalances.Len())
+	return nil
+}
+
+// MigrateDenomMetadata sets the denom metadata enabled parameter to its default value if it is not set
+// and, if enabled, sets the bank denomination metadata of the vouchers of all existing denomination
+// traces which have no metadata.
+func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.KeyDenomMetadataEnabled) {
+		m.keeper.paramSpace.Set(ctx, types.KeyDenomMetadataEnabled, types.DefaultDenomMetadataEnabled)
+	}
+
+	if !m.keeper.GetDenomMetadataEnabled(ctx) {
+		return nil
+	}
+
+	var count int
+	m.keeper.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) (stop bool) {
+		// check if the metadata for the given denom trace does not already exist
+		if !m.keeper.bankKeeper.HasDenomMetaData(ctx, denomTrace.IBCDenom()) {
+			m.keeper.setDenomMetadata(ctx, denomTrace)
+			count++
+		}
+
+		return false
+	})
+
+	m.keeper.Logger(ctx).Info("successfully added metadata to IBC voucher denominations", "number of denominations", count)
+	return nil
+}
+
+// MigrateParams sets the per channel and per denomination send and receive enabled parameters
+// to their default, empty, values if they are not set. The existing parameters are left unchanged.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.KeyChannelEnabled) {
+		m.keeper.paramSpace.Set(ctx, types.KeyChannelEnabled, types.DefaultParams().ChannelEnabled)
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, types.KeyDenomEnabled) {
+		m.keeper.paramSpace.Set(ctx, types.KeyDenomEnabled, types.DefaultParams().DenomEnabled)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated transfer app parameters")
+	return nil
+}
+
+// MigrateDenomTraceIndexes rebuilds the base denomination, channel and hops indexes of all denomination traces.
+func (m Migrator) MigrateDenomTraceIndexes(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, indexKey := range [][]byte{types.DenomTraceByBaseDenomKey, types.DenomTraceByChannelKey, types.DenomTraceByHopsKey} {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, indexKey)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	var count int
+	m.keeper.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) (stop bool) {
+		m.keeper.setDenomTraceIndexes(ctx, denomTrace)
+		count++
+		return false
+	})
+
+	m.keeper.Logger(ctx).Info("successfully indexed denomination traces", "number of denomination traces", count)
+	return nil
+}
+
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
@@ -2,9 +2,17 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	transferkeeper "github.com/cosmos/ibc-go/v5/modules/apps/transfer/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/cosmos/ibc-go/v5/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
@@ -119,3 +127,219 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTracesCorruptionDetection() {
 		migrator.MigrateTraces(suite.chainA.GetContext())
 	})
 }
+
+func (suite *KeeperTestSuite) TestMigratorMigrateTotalEscrowForDenom() {
+	var (
+		path  *ibctesting.Path
+		denom string
+	)
+
+	testCases := []struct {
+		msg            string
+		malleate       func()
+		expectedEscrow sdk.Coin
+	}{
+		{
+			"success: one native denom escrowed in one channel",
+			func() {
+				denom = sdk.DefaultBondDenom
+				escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				coin := sdk.NewCoin(denom, sdk.NewInt(100))
+
+				// funds the escrow account to have balance
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(coin)))
+			},
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		},
+		{
+			"success: one native denom escrowed in two channels",
+			func() {
+				denom = sdk.DefaultBondDenom
+				extraPath := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(extraPath)
+
+				escrowAddress1 := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowAddress2 := transfertypes.GetEscrowAddress(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID)
+				coin1 := sdk.NewCoin(denom, sdk.NewInt(100))
+				coin2 := sdk.NewCoin(denom, sdk.NewInt(100))
+
+				// funds the escrow accounts to have balance
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress1, sdk.NewCoins(coin1)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress2, sdk.NewCoins(coin2)))
+			},
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)),
+		},
+		{
+			"success: no escrowed tokens",
+			func() {
+				denom = sdk.DefaultBondDenom
+			},
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate() // explicitly fund escrow accounts
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			err := migrator.MigrateTotalEscrowForDenom(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			// check that the migration set the expected amount for the denom
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), denom)
+			suite.Require().Equal(tc.expectedEscrow, totalEscrow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateDenomMetadata() {
+	var (
+		denomTraces []transfertypes.DenomTrace
+		expFound    bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: metadata is set for all traces",
+			func() {},
+		},
+		{
+			"success: existing metadata is not overwritten",
+			func() {
+				suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), banktypes.Metadata{
+					Base: denomTraces[0].IBCDenom(), Name: "custom", Symbol: "CUSTOM",
+				})
+			},
+		},
+		{
+			"success: metadata is not set when disabled",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), transfertypes.NewParams(true, true, false))
+				expFound = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			denomTraces = []transfertypes.DenomTrace{
+				{BaseDenom: "uatom", Path: "transfer/channel-0"},
+				{BaseDenom: "gamm/pool/1", Path: "transfer/channel-1/transfer/channel-2"},
+			}
+			expFound = true
+
+			for _, denomTrace := range denomTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(ctx, denomTrace)
+			}
+
+			tc.malleate()
+
+			existingMetadata, hasExisting := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(ctx, denomTraces[0].IBCDenom())
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			err := migrator.MigrateDenomMetadata(ctx)
+			suite.Require().NoError(err)
+
+			for i, denomTrace := range denomTraces {
+				metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(ctx, denomTrace.IBCDenom())
+
+				if i == 0 && hasExisting {
+					suite.Require().True(found)
+					suite.Require().Equal(existingMetadata, metadata)
+					continue
+				}
+
+				suite.Require().Equal(expFound, found)
+				if expFound {
+					suite.Require().Equal(denomTrace.IBCDenom(), metadata.Base)
+					suite.Require().Equal(denomTrace.IBCDenom(), metadata.Display)
+					suite.Require().Equal(strings.ToUpper(denomTrace.BaseDenom), metadata.Symbol)
+
+					// the metadata must pass bank validation for the bank genesis to remain valid
+					suite.Require().NoError(metadata.Validate())
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateDenomMetadataSetsParam() {
+	ctx := suite.chainA.GetContext()
+
+	// remove the parameter from the store to mimic a chain upgrading from a previous version
+	paramStore := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(transfertypes.ModuleName+"/"))
+	paramStore.Delete(transfertypes.KeyDenomMetadataEnabled)
+
+	denomTrace := transfertypes.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-0"}
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(ctx, denomTrace)
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	err := migrator.MigrateDenomMetadata(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(transfertypes.DefaultParams(), suite.chainA.GetSimApp().TransferKeeper.GetParams(ctx))
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.HasDenomMetaData(ctx, denomTrace.IBCDenom()))
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
+	ctx := suite.chainA.GetContext()
+
+	// remove the parameters from the store to mimic a chain upgrading from a previous version
+	paramStore := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(transfertypes.ModuleName+"/"))
+	paramStore.Delete(transfertypes.KeyChannelEnabled)
+	paramStore.Delete(transfertypes.KeyDenomEnabled)
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	err := migrator.MigrateParams(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(transfertypes.DefaultParams(), suite.chainA.GetSimApp().TransferKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateDenomTraceIndexes() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	denomTraces := transfertypes.Traces{
+		{BaseDenom: "uatom", Path: "transfer/channel-0"},
+		{BaseDenom: "uatom", Path: "transfer/channel-1/transfer/channel-2"},
+		{BaseDenom: "gamm/pool/1", Path: "transfer/channel-1"},
+	}
+
+	// store the traces without index entries to mimic a chain upgrading from a previous version
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey))
+	for _, denomTrace := range denomTraces {
+		prefix.NewStore(store, transfertypes.DenomTraceKey).Set(denomTrace.Hash(), transferKeeper.MustMarshalDenomTrace(denomTrace))
+	}
+
+	// stale index entry of a trace which is not stored
+	staleTrace := transfertypes.DenomTrace{BaseDenom: "uosmo", Path: "transfer/channel-0"}
+	store.Set(append(transfertypes.DenomTraceByBaseDenomPrefix(staleTrace.BaseDenom), staleTrace.Hash()...), []byte{1})
+
+	migrator := transferkeeper.NewMigrator(transferKeeper)
+	err := migrator.MigrateDenomTraceIndexes(ctx)
+	suite.Require().NoError(err)
+
+	for _, denomTrace := range denomTraces {
+		suite.Require().True(store.Has(append(transfertypes.DenomTraceByBaseDenomPrefix(denomTrace.BaseDenom), denomTrace.Hash()...)))
+		suite.Require().True(store.Has(append(transfertypes.DenomTraceByHopsPrefix(denomTrace.Hops()), denomTrace.Hash()...)))
+
+		portID, channelID, found := denomTrace.LeadingChannel()
+		suite.Require().True(found)
+		suite.Require().True(store.Has(append(transfertypes.DenomTraceByChannelPrefix(portID, channelID), denomTrace.Hash()...)))
+	}
+
+	suite.Require().False(store.Has(append(transfertypes.DenomTraceByBaseDenomPrefix(staleTrace.BaseDenom), staleTrace.Hash()...)))
+}
@@ -20,9 +20,34 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetDenomMetadataEnabled retrieves the denom metadata enabled boolean from the paramstore
+func (k Keeper) GetDenomMetadataEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyDenomMetadataEnabled, &res)
+	return res
+}
+
+// GetChannelEnabled retrieves the per channel send and receive enabled flags from the paramstore
+func (k Keeper) GetChannelEnabled(ctx sdk.Context) []types.ChannelEnabled {
+	var res []types.ChannelEnabled
+	k.paramSpace.Get(ctx, types.KeyChannelEnabled, &res)
+	return res
+}
+
+// GetDenomEnabled retrieves the per denomination send and receive enabled flags from the paramstore
+func (k Keeper) GetDenomEnabled(ctx sdk.Context) []types.DenomEnabled {
+	var res []types.DenomEnabled
+	k.paramSpace.Get(ctx, types.KeyDenomEnabled, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	params := types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetDenomMetadataEnabled(ctx))
+	params.ChannelEnabled = k.GetChannelEnabled(ctx)
+	params.DenomEnabled = k.GetDenomEnabled(ctx)
+
+	return params
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+)
+
+// EscrowRecoveryProposal releases the tokens held in the escrow account of the channel to the
+// recipients of the proposal. The channel must be CLOSED or the client underlying the channel
+// must be Frozen or Expired, such that the escrowed tokens can no longer be sent back through
+// the channel. The proposal is rejected while packets sent over the channel are still awaiting
+// an acknowledgement or timeout, as the escrowed tokens backing them may still be refunded. The
+// total escrow tracked for each released denomination is decreased by the released amount.
+func (k Keeper) EscrowRecoveryProposal(ctx sdk.Context, p *types.EscrowRecoveryProposal) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, p.PortId, p.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, p.PortId, p.ChannelId)
+	if err != nil {
+		return err
+	}
+
+	status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc)
+	if channel.State != channeltypes.CLOSED && status != ibcexported.Frozen && status != ibcexported.Expired {
+		return sdkerrors.Wrapf(types.ErrEscrowRecoveryNotAllowed, "channel is %s and client (%s) is %s", channel.State, clientID, status)
+	}
+
+	var hasPacketCommitments bool
+	k.channelKeeper.IteratePacketCommitmentAtChannel(ctx, p.PortId, p.ChannelId, func(_, _ string, _ uint64, _ []byte) bool {
+		hasPacketCommitments = true
+		return true
+	})
+
+	if hasPacketCommitments {
+		return sdkerrors.Wrapf(types.ErrEscrowRecoveryNotAllowed, "packets sent over port ID (%s) channel ID (%s) are still in flight", p.PortId, p.ChannelId)
+	}
+
+	escrowAddress := types.GetEscrowAddress(p.PortId, p.ChannelId)
+	for _, recipient := range p.Recipients {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+
+		if k.bankKeeper.BlockedAddr(recipientAddr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient.Address)
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, recipientAddr, recipient.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "failed to release tokens from escrow account (%s)", escrowAddress)
+		}
+
+		for _, coin := range recipient.Amount {
+			// the total escrow is tracked across all channels, releasing more than is tracked would
+			// decrease the total escrow backing the tokens sent over other channels
+			currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, coin.GetDenom())
+			if !currentTotalEscrow.IsGTE(coin) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "released amount %s exceeds the total escrow %s", coin, currentTotalEscrow)
+			}
+
+			k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Sub(coin))
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEscrowRecovery,
+				sdk.NewAttribute(types.AttributeKeyPortID, p.PortId),
+				sdk.NewAttribute(types.AttributeKeyChannelID, p.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyChannelState, channel.State.String()),
+				sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+				sdk.NewAttribute(types.AttributeKeyClientStatus, status.String()),
+				sdk.NewAttribute(types.AttributeKeyEscrowAddress, escrowAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, recipient.Address),
+				sdk.NewAttribute(types.AttributeKeyAmount, recipient.Amount.String()),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("escrowed tokens released after governance proposal passed", "port-id", p.PortId, "channel-id", p.ChannelId, "amount", p.GetTotalAmount())
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func (suite *KeeperTestSuite) TestEscrowRecoveryProposal() {
+	var (
+		path             *ibctesting.Path
+		proposal         *types.EscrowRecoveryProposal
+		recipientA       = sdk.AccAddress("recipientA")
+		recipientB       = sdk.AccAddress("recipientB")
+		expTotalEscrow   sdk.Int
+		expRecipientBalA sdk.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: channel closed",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"success: client frozen",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			true,
+		},
+		{
+			"success: client expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			true,
+		},
+		{
+			"success: multiple recipients",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				proposal.Recipients = []types.EscrowRecipient{
+					types.NewEscrowRecipient(recipientA.String(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40)))),
+					types.NewEscrowRecipient(recipientB.String(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20)))),
+				}
+
+				expRecipientBalA = sdk.NewInt(40)
+				expTotalEscrow = sdk.NewInt(40)
+			},
+			true,
+		},
+		{
+			"failure: channel open and client active",
+			func() {},
+			false,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				proposal.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"failure: insufficient escrowed tokens",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				proposal.Recipients[0].Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101)))
+			},
+			false,
+		},
+		{
+			"failure: released amount exceeds the total escrow",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				err = suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), escrowAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))))
+				suite.Require().NoError(err)
+
+				proposal.Recipients[0].Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)))
+			},
+			false,
+		},
+		{
+			"failure: packets in flight",
+			func() {
+				msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+				_, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"failure: recipient is blocked",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				proposal.Recipients[0].Address = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// escrow tokens in the escrow account of the channel on chainA
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			// complete the packet such that no packets are in flight
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			proposal = types.NewEscrowRecoveryProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []types.EscrowRecipient{
+				types.NewEscrowRecipient(recipientA.String(), sdk.NewCoins(amount)),
+			}).(*types.EscrowRecoveryProposal)
+			expRecipientBalA = amount.Amount
+			expTotalEscrow = sdk.ZeroInt()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err = suite.chainA.GetSimApp().TransferKeeper.EscrowRecoveryProposal(ctx, proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, recipientA, sdk.DefaultBondDenom)
+				suite.Require().Equal(expRecipientBalA, balance.Amount)
+
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom)
+				suite.Require().Equal(expTotalEscrow, totalEscrow.Amount)
+
+				var recoveryEvents int
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeEscrowRecovery {
+						recoveryEvents++
+					}
+				}
+				suite.Require().Equal(len(proposal.Recipients), recoveryEvents)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -49,21 +49,31 @@ import (
 // 5. C -> B : sender chain is sink zone. Denom upon receiving: 'B/denom'
 // 6. B -> A : sender chain is sink zone. Denom upon receiving: 'denom'
 //
+// Channels negotiated with the ics20-2 version allow multiple tokens to be transferred
+// within a single packet. Each token is escrowed or burned according to its own denomination
+// trace. Channels negotiated with the ics20-1 version only allow a single token per transfer.
+//
 // Note: An IBC Transfer must be initiated using a MsgTransfer via the Transfer rpc handler
 func (k Keeper) SendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token sdk.Coin,
+	tokens sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) error {
-	if !k.GetSendEnabled(ctx) {
+	params := k.GetParams(ctx)
+	if !params.SendEnabled {
 		return types.ErrSendDisabled
 	}
 
+	if !params.IsChannelSendEnabled(sourceChannel) {
+		return sdkerrors.Wrapf(types.ErrChannelSendDisabled, "channel ID (%s)", sourceChannel)
+	}
+
 	if k.bankKeeper.BlockedAddr(sender) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
@@ -73,6 +83,29 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
+	}
+
+	if !types.IsSupportedVersion(appVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "unsupported ICS20 version %s for source port: %s and source channel: %s", appVersion, sourcePort, sourceChannel)
+	}
+
+	if len(tokens) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidTokens, "no tokens provided for transfer")
+	}
+
+	for _, token := range tokens {
+		if !params.IsDenomSendEnabled(token.Denom) {
+			return sdkerrors.Wrapf(types.ErrDenomSendDisabled, "denom (%s)", token.Denom)
+		}
+	}
+
+	if appVersion == types.V1 && len(tokens) > 1 {
+		return sdkerrors.Wrapf(types.ErrInvalidTokens, "cannot transfer multiple tokens over channel with version %s", appVersion)
+	}
+
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
@@ -92,68 +125,38 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
-	fullDenomPath := token.Denom
-
-	var err error
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	}
 
-	// deconstruct the token denomination into the denomination trace info
-	// to determine if the sender is the source chain
-	if strings.HasPrefix(token.Denom, "ibc/") {
-		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
+	packetTokens := make([]types.Token, 0, len(tokens))
+	for _, token := range tokens {
+		fullDenomPath, err := k.sendToken(ctx, sourcePort, sourceChannel, token, sender)
 		if err != nil {
 			return err
 		}
-	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+		packetTokens = append(packetTokens, types.NewToken(fullDenomPath, token.Amount.String()))
 	}
 
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
-
-	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
-
-		// create the escrow address for the tokens
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-
-		// escrow source tokens. It fails if balance insufficient.
-		if err := k.bankKeeper.SendCoins(
-			ctx, sender, escrowAddress, sdk.NewCoins(token),
-		); err != nil {
-			return err
-		}
-
-	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
-
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return err
-		}
-
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balace
-			// to burn.
-			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
-		}
+	var packetDataBz []byte
+	switch appVersion {
+	case types.V1:
+		packetData := types.NewFungibleTokenPacketData(
+			packetTokens[0].Denom, packetTokens[0].Amount, sender.String(), receiver, memo,
+		)
+		packetDataBz = packetData.GetBytes()
+	default:
+		packetData := types.NewFungibleTokenPacketDataV2(packetTokens, sender.String(), receiver, memo)
+		packetDataBz = packetData.GetBytes()
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
-	)
-
 	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
+		packetDataBz,
 		sequence,
 		sourcePort,
 		sourceChannel,
@@ -168,49 +171,133 @@ func (k Keeper) SendTransfer(
 	}
 
 	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
+		for i, token := range tokens {
+			if token.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, packetTokens[i].Denom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				append(
+					labels, telemetry.NewLabel(coretypes.LabelSource, fmt.Sprintf("%t", types.SenderChainIsSource(sourcePort, sourceChannel, packetTokens[i].Denom))),
+				),
 			)
 		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
 	}()
 
 	return nil
 }
 
+// sendToken escrows the token if the sender chain is the source of the token, otherwise the
+// token is burned. The full denomination path of the token is returned.
+func (k Keeper) sendToken(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.Coin, sender sdk.AccAddress) (string, error) {
+	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
+	fullDenomPath := token.Denom
+
+	var err error
+
+	// deconstruct the token denomination into the denomination trace info
+	// to determine if the sender is the source chain
+	if strings.HasPrefix(token.Denom, "ibc/") {
+		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
+		// create the escrow address for the tokens
+		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+		// escrow source tokens. It fails if balance insufficient.
+		if err := k.bankKeeper.SendCoins(
+			ctx, sender, escrowAddress, sdk.NewCoins(token),
+		); err != nil {
+			return "", err
+		}
+
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		newTotalEscrow := currentTotalEscrow.Add(token)
+		k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
+
+		return fullDenomPath, nil
+	}
+
+	// transfer the coins to the module account and burn them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		return "", err
+	}
+
+	if err := k.bankKeeper.BurnCoins(
+		ctx, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	return fullDenomPath, nil
+}
+
 // OnRecvPacket processes a cross chain fungible token transfer. If the
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+//
+// All tokens within the packet are processed atomically: an error returned for
+// any token results in an error acknowledgement, in which case the state changes
+// made for the preceding tokens are discarded by core IBC.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return err
 	}
 
-	if !k.GetReceiveEnabled(ctx) {
+	params := k.GetParams(ctx)
+	if !params.ReceiveEnabled {
 		return types.ErrReceiveDisabled
 	}
 
+	if !params.IsChannelReceiveEnabled(packet.GetDestChannel()) {
+		return sdkerrors.Wrapf(types.ErrChannelReceiveDisabled, "channel ID (%s)", packet.GetDestChannel())
+	}
+
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
 		return err
 	}
 
+	for _, token := range data.Tokens {
+		if denom := getReceivedDenom(packet, token.Denom); !params.IsDenomReceiveEnabled(denom) {
+			return sdkerrors.Wrapf(types.ErrDenomReceiveDisabled, "denom (%s)", denom)
+		}
+
+		if err := k.receiveToken(ctx, packet, token, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiveToken unescrows the token to the receiver if the receiving chain is the source
+// of the token, otherwise vouchers are minted and sent to the receiver.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, token types.Token, receiver sdk.AccAddress) error {
 	// parse the transfer amount
-	transferAmount, ok := sdk.NewIntFromString(data.Amount)
+	transferAmount, ok := sdk.NewIntFromString(token.Amount)
 	if !ok {
-		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
 	}
 
 	labels := []metrics.Label{
@@ -226,12 +313,12 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// chain would have prefixed with DestPort and DestChannel when originally
 	// receiving this coin as seen in the "sender chain is the source" condition.
 
-	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
 		// sender chain is not the source, unescrow tokens
 
 		// remove prefix added by sender chain
 		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		unprefixedDenom := token.Denom[len(voucherPrefix):]
 
 		// coin denomination used in sending from the escrow address
 		denom := unprefixedDenom
@@ -242,7 +329,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		if denomTrace.Path != "" {
 			denom = denomTrace.IBCDenom()
 		}
-		token := sdk.NewCoin(denom, transferAmount)
+		coin := sdk.NewCoin(denom, transferAmount)
 
 		if k.bankKeeper.BlockedAddr(receiver) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
@@ -250,7 +337,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 		// unescrow tokens
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(coin)); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
@@ -258,6 +345,15 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, coin.GetDenom())
+		if !currentTotalEscrow.IsGTE(coin) {
+			// NOTE: this error is only expected to occur given an unexpected bug or a malicious counterparty module
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "total escrow %s is less than the unescrowed amount %s", currentTotalEscrow, coin)
+		}
+		newTotalEscrow := currentTotalEscrow.Sub(coin)
+		k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -284,7 +380,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
 	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
 	// NOTE: sourcePrefix contains the trailing "/"
-	prefixedDenom := sourcePrefix + data.Denom
+	prefixedDenom := sourcePrefix + token.Denom
 
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
@@ -295,6 +391,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	}
 
 	voucherDenom := denomTrace.IBCDenom()
+	if k.GetDenomMetadataEnabled(ctx) && !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
+		k.setDenomMetadata(ctx, denomTrace)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDenomTrace,
@@ -323,7 +423,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			telemetry.SetGaugeWithLabels(
 				[]string{"ibc", types.ModuleName, "packet", "receive"},
 				float32(transferAmount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, data.Denom)},
+				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, token.Denom)},
 			)
 		}
 
@@ -343,7 +443,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
-func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		return k.refundPacketToken(ctx, packet, data)
@@ -356,56 +456,67 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	return k.refundPacketToken(ctx, packet, data)
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// the sending address. Every token contained in the packet is refunded.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
-
-	// parse the transfer amount
-	transferAmount, ok := sdk.NewIntFromString(data.Amount)
-	if !ok {
-		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
-	}
-	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
-
 	// decode the sender address
 	sender, err := sdk.AccAddressFromBech32(data.Sender)
 	if err != nil {
 		return err
 	}
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
-		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
-			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
-			// counterparty module. The bug may occur in bank or any part of the code that allows
-			// the escrow address to be drained. A malicious counterparty module could drain the
-			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+	for _, token := range data.Tokens {
+		// parse the denomination from the full denom path
+		trace := types.ParseDenomTrace(token.Denom)
+
+		// parse the transfer amount
+		transferAmount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
 		}
+		coin := sdk.NewCoin(trace.IBCDenom(), transferAmount)
+
+		if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+			// unescrow tokens back to sender
+			escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+			if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(coin)); err != nil {
+				// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+				// counterparty module. The bug may occur in bank or any part of the code that allows
+				// the escrow address to be drained. A malicious counterparty module could drain the
+				// escrow address by allowing more tokens to be sent back then were escrowed.
+				return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+			}
 
-		return nil
-	}
+			// track the total amount in escrow keyed by denomination to allow for efficient iteration
+			currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, coin.GetDenom())
+			if !currentTotalEscrow.IsGTE(coin) {
+				// NOTE: this error is only expected to occur given an unexpected bug or a malicious counterparty module
+				return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "total escrow %s is less than the refunded amount %s", currentTotalEscrow, coin)
+			}
+			newTotalEscrow := currentTotalEscrow.Sub(coin)
+			k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 
-	// mint vouchers back to sender
-	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(token),
-	); err != nil {
-		return err
-	}
+			continue
+		}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(token)); err != nil {
-		panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+		// mint vouchers back to sender
+		if err := k.bankKeeper.MintCoins(
+			ctx, types.ModuleName, sdk.NewCoins(coin),
+		); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin)); err != nil {
+			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+		}
 	}
 
 	return nil
@@ -430,3 +541,16 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	fullDenomPath := denomTrace.GetFullDenomPath()
 	return fullDenomPath, nil
 }
+
+// getReceivedDenom returns the denomination, as represented on this chain, of a token received
+// in the packet: the unprefixed denomination if this chain is the source of the token, or the
+// ibc denomination of the voucher otherwise.
+func getReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return types.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return types.ParseDenomTrace(sourcePrefix + denom).IBCDenom()
+}
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
@@ -94,6 +95,40 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, " randomdenom", sdk.NewInt(100))
 			}, false, false,
 		},
+		{
+			"transfer failed - channel send disabled",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ChannelEnabled = []types.ChannelEnabled{{ChannelId: path.EndpointA.ChannelID, SendEnabled: false, ReceiveEnabled: true}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, true, false,
+		},
+		{
+			"transfer failed - denom send disabled",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.DenomEnabled = []types.DenomEnabled{{Denom: sdk.DefaultBondDenom, SendEnabled: false, ReceiveEnabled: true}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, true, false,
+		},
+		{
+			"successful transfer - send disabled for another channel and denom",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ChannelEnabled = []types.ChannelEnabled{{ChannelId: "channel-100", SendEnabled: false, ReceiveEnabled: false}}
+				params.DenomEnabled = []types.DenomEnabled{{Denom: "uatom", SendEnabled: false, ReceiveEnabled: false}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, true, true,
+		},
 		{
 			"channel capability not found",
 			func() {
@@ -121,12 +156,12 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			if !tc.sendFromSource {
 				// send coin from chainB to chainA
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "")
 				_, err = suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
 				// receive coin on chainA from chainB
-				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
 				packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(1, 110), 0)
 
 				// get proof of packet commitment from chainB
@@ -141,12 +176,20 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			}
 
 			err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
-				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
-				sender, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0,
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coins{amount},
+				sender, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
 			)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// only tokens sent from the source chain are tracked as escrowed
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), amount.Denom)
+				if tc.sendFromSource {
+					suite.Require().Equal(amount, totalEscrow)
+				} else {
+					suite.Require().True(totalEscrow.IsZero())
+				}
 			} else {
 				suite.Require().Error(err)
 			}
@@ -191,6 +234,9 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"tries to unescrow more tokens than allowed", func() {
 			amount = sdk.NewInt(1000000)
 		}, true, false},
+		{"failure: total escrow is less than the unescrowed amount", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, amount.SubRaw(1)))
+		}, true, false},
 
 		// - coin being sent to module address on chainA
 		{"failure: receive on module account", func() {
@@ -201,6 +247,26 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+
+		// - receive disabled by channel or denom parameters on chainB
+		{"failure: channel receive disabled", func() {
+			params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+			params.ChannelEnabled = []types.ChannelEnabled{{ChannelId: "channel-0", SendEnabled: true, ReceiveEnabled: false}}
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+		}, false, false},
+		{"failure: denom receive disabled", func() {
+			// the denomination is checked as it is represented on the receiving chain
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(types.PortID, "channel-0", sdk.DefaultBondDenom)).IBCDenom()
+
+			params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+			params.DenomEnabled = []types.DenomEnabled{{Denom: voucherDenom, SendEnabled: true, ReceiveEnabled: false}}
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+		}, false, false},
+		{"failure: denom receive disabled on source chain", func() {
+			params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+			params.DenomEnabled = []types.DenomEnabled{{Denom: sdk.DefaultBondDenom, SendEnabled: true, ReceiveEnabled: false}}
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+		}, true, false},
 	}
 
 	for _, tc := range testCases {
@@ -219,7 +285,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			if tc.recvIsSource {
 				// send coin from chainB to chainA, receive them, acknowledge them, and send back to chainB
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "")
 				res, err := suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
@@ -238,19 +304,23 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}
 
 			// send coin from chainA to chainB
-			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(1, 110), 0)
+			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(1, 110), 0, "")
 			_, err := suite.chainA.SendMsgs(transferMsg)
 			suite.Require().NoError(err) // message committed
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver)
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// tokens unescrowed on the source chain are no longer tracked as escrowed
+				totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+				suite.Require().True(totalEscrow.IsZero())
 			} else {
 				suite.Require().Error(err)
 			}
@@ -287,6 +357,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{
 			"unsuccessful refund from source", failedAck,
@@ -317,12 +388,12 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data), tc.ack)
 			if tc.expPass {
 				suite.Require().NoError(err)
 				postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -332,6 +403,9 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 					suite.Require().Equal(int64(0), deltaAmount.Int64(), "successful ack changed balance")
 				} else {
 					suite.Require().Equal(amount, deltaAmount, "failed ack did not trigger refund")
+
+					totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+					suite.Require().True(totalEscrow.IsZero(), "failed ack did not update total escrow")
 				}
 
 			} else {
@@ -366,6 +440,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 			}, true,
 		},
 		{
@@ -378,6 +453,17 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
 			}, true,
 		},
+		{
+			"total escrow is less than the refunded amount",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(trace.IBCDenom(), amount.SubRaw(1)))
+			}, false,
+		},
 		{
 			"no balance for coin denom",
 			func() {
@@ -413,12 +499,12 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
@@ -432,3 +518,241 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+// TestOnRecvPacketMultiDenom tests that the receipt of a multi-denom packet is atomic: when
+// any of the tokens cannot be received an error acknowledgement is written and none of the
+// tokens are credited to the receiver.
+func (suite *KeeperTestSuite) TestOnRecvPacketMultiDenom() {
+	var data types.FungibleTokenPacketDataV2
+
+	testCases := []struct {
+		msg      string
+		malleate func(path *ibctesting.Path)
+		expPass  bool
+	}{
+		{
+			"success: all tokens are minted as vouchers",
+			func(path *ibctesting.Path) {},
+			true,
+		},
+		{
+			"failure: second token cannot be unescrowed",
+			func(path *ibctesting.Path) {
+				// the prefixed denomination is treated as native to chainB, but nothing was escrowed
+				data.Tokens[1].Denom = types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.V2
+			path.EndpointB.ChannelConfig.Version = types.V2
+			suite.coordinator.Setup(path)
+
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			data = types.NewFungibleTokenPacketDataV2(
+				[]types.Token{types.NewToken(sdk.DefaultBondDenom, "100"), types.NewToken("atom", "100")},
+				suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "",
+			)
+
+			tc.malleate(path)
+
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			suite.Require().Equal(tc.expPass, ack.Success())
+
+			voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucher.Denom)
+
+			if tc.expPass {
+				suite.Require().Equal(voucher, balance)
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
+
+// TestRefundPacketTokenMultiDenom tests that every token of a multi-denom packet is refunded
+// to the sender on timeout and error acknowledgement.
+func (suite *KeeperTestSuite) TestRefundPacketTokenMultiDenom() {
+	testCases := []struct {
+		msg    string
+		refund func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+	}{
+		{
+			"timeout",
+			func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+			},
+		},
+		{
+			"error acknowledgement",
+			func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf("error acknowledgement"))
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.V2
+			path.EndpointB.ChannelConfig.Version = types.V2
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			amount := sdk.NewInt(100)
+
+			// mint vouchers on chainA as if they had been received over the V2 channel
+			voucher := types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, amount)
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)))
+			suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(voucher)))
+			suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, sender, sdk.NewCoins(voucher)))
+
+			native := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+			tokens := sdk.NewCoins(native, voucher)
+
+			preBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tokens,
+				sender, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			suite.Require().NoError(err)
+
+			// native token is escrowed and the voucher is burned
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().Equal(native, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+			suite.Require().Equal(preBalances.Sub(tokens...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender))
+
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{
+					types.NewToken(sdk.DefaultBondDenom, amount.String()),
+					types.NewToken(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom), amount.String()),
+				},
+				sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+			)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err = tc.refund(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(preBalances, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender))
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketSetsDenomMetadata() {
+	var (
+		path       *ibctesting.Path
+		denomTrace types.DenomTrace
+	)
+
+	customMetadata := func() banktypes.Metadata {
+		return banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denomTrace.IBCDenom(), Exponent: 0}},
+			Base:       denomTrace.IBCDenom(),
+			Display:    denomTrace.IBCDenom(),
+			Name:       "custom",
+			Symbol:     "CUSTOM",
+		}
+	}
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expMetadata func() (banktypes.Metadata, bool)
+	}{
+		{
+			"success: metadata is set for a new denomination trace",
+			func() {},
+			func() (banktypes.Metadata, bool) {
+				return banktypes.Metadata{
+					Description: fmt.Sprintf("IBC token from %s", denomTrace.GetFullDenomPath()),
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: denomTrace.IBCDenom(), Exponent: 0},
+					},
+					Base:    denomTrace.IBCDenom(),
+					Display: denomTrace.IBCDenom(),
+					Name:    fmt.Sprintf("%s IBC token", denomTrace.GetFullDenomPath()),
+					Symbol:  "STAKE",
+				}, true
+			},
+		},
+		{
+			"success: existing metadata is not overwritten",
+			func() {
+				suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), customMetadata())
+			},
+			func() (banktypes.Metadata, bool) {
+				return customMetadata(), true
+			},
+		},
+		{
+			"success: metadata is not set when disabled",
+			func() {
+				params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+				params.DenomMetadataEnabled = false
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			func() (banktypes.Metadata, bool) {
+				return banktypes.Metadata{}, false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			denomTrace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+
+			tc.malleate()
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
+			suite.Require().NoError(err)
+
+			expMetadata, expFound := tc.expMetadata()
+			metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomTrace.IBCDenom())
+			suite.Require().Equal(expFound, found)
+			suite.Require().Equal(expMetadata, metadata)
+
+			if found {
+				// the metadata must pass bank validation for the bank genesis to remain valid
+				suite.Require().NoError(metadata.Validate())
+			}
+		})
+	}
+}
@@ -40,10 +40,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { receiveEnabled = RadomEnabled(r) },
 	)
 
+	var denomMetadataEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeyDenomMetadataEnabled), &denomMetadataEnabled, simState.Rand,
+		func(r *rand.Rand) { denomMetadataEnabled = RadomEnabled(r) },
+	)
+
 	transferGenesis := types.GenesisState{
 		PortId:      portID,
 		DenomTraces: types.Traces{},
-		Params:      types.NewParams(sendEnabled, receiveEnabled),
+		Params:      types.NewParams(sendEnabled, receiveEnabled, denomMetadataEnabled),
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
@@ -27,5 +27,11 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%s", types.ModuleCdc.MustMarshalJSON(&gogotypes.BoolValue{Value: receiveEnabled})) //nolint:gosimple
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDenomMetadataEnabled),
+			func(r *rand.Rand) string {
+				denomMetadataEnabled := RadomEnabled(r)
+				return fmt.Sprintf("%s", types.ModuleCdc.MustMarshalJSON(&gogotypes.BoolValue{Value: denomMetadataEnabled})) //nolint:gosimple
+			},
+		),
 	}
 }
@@ -21,11 +21,12 @@ func TestParamChanges(t *testing.T) {
 	}{
 		{"transfer/SendEnabled", "SendEnabled", "false", "transfer"},
 		{"transfer/ReceiveEnabled", "ReceiveEnabled", "true", "transfer"},
+		{"transfer/DenomMetadataEnabled", "DenomMetadataEnabled", "true", "transfer"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 2)
+	require.Len(t, paramChanges, 3)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
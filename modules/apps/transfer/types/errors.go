@@ -6,12 +6,20 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 5, "invalid token amount")
-	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
-	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidPacketTimeout     = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = sdkerrors.Register(ModuleName, 5, "invalid token amount")
+	ErrTraceNotFound            = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
+	ErrSendDisabled             = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidTokens            = sdkerrors.Register(ModuleName, 10, "invalid tokens for cross-chain transfer")
+	ErrInvalidAuthorization     = sdkerrors.Register(ModuleName, 11, "invalid transfer authorization")
+	ErrChannelSendDisabled      = sdkerrors.Register(ModuleName, 12, "fungible token transfers from this chain over the channel are disabled")
+	ErrChannelReceiveDisabled   = sdkerrors.Register(ModuleName, 13, "fungible token transfers to this chain over the channel are disabled")
+	ErrDenomSendDisabled        = sdkerrors.Register(ModuleName, 14, "fungible token transfers of the denomination from this chain are disabled")
+	ErrDenomReceiveDisabled     = sdkerrors.Register(ModuleName, 15, "fungible token transfers of the denomination to this chain are disabled")
+	ErrInvalidEscrowRecovery    = sdkerrors.Register(ModuleName, 16, "invalid escrow recovery proposal")
+	ErrEscrowRecoveryNotAllowed = sdkerrors.Register(ModuleName, 17, "escrow recovery is not allowed for the channel")
 )
@@ -2,11 +2,12 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout        = "timeout"
+	EventTypePacket         = "fungible_token_packet"
+	EventTypeTransfer       = "ibc_transfer"
+	EventTypeChannelClose   = "channel_closed"
+	EventTypeDenomTrace     = "denomination_trace"
+	EventTypeEscrowRecovery = "escrow_recovery"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -18,4 +19,11 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyMemo           = "memo"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyChannelState   = "channel_state"
+	AttributeKeyClientID       = "client_id"
+	AttributeKeyClientStatus   = "client_status"
+	AttributeKeyEscrowAddress  = "escrow_address"
 )
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
@@ -26,6 +27,30 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with total escrow",
+			&types.GenesisState{
+				PortId:        types.PortID,
+				TotalEscrowed: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+			},
+			true,
+		},
+		{
+			"invalid total escrow: duplicate denom",
+			&types.GenesisState{
+				PortId:        types.PortID,
+				TotalEscrowed: sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin("uatom", sdk.NewInt(100))},
+			},
+			false,
+		},
+		{
+			"invalid total escrow: zero amount",
+			&types.GenesisState{
+				PortId:        types.PortID,
+				TotalEscrowed: sdk.Coins{sdk.NewCoin("uatom", sdk.ZeroInt())},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -11,9 +11,17 @@ const (
 	// ModuleName defines the IBC transfer name
 	ModuleName = "transfer"
 
+	// V1 defines the first version of the IBC transfer module, supporting a
+	// single token per packet
+	V1 = "ics20-1"
+
+	// V2 defines the version of the IBC transfer module which supports the
+	// transfer of multiple tokens in a single packet
+	V2 = "ics20-2"
+
 	// Version defines the current version the IBC tranfer
 	// module supports
-	Version = "ics20-1"
+	Version = V1
 
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
@@ -32,12 +40,54 @@ const (
 )
 
 var (
+	// SupportedVersions defines all versions the IBC transfer module supports. The first version
+	// is used when no version is proposed in OnChanOpenInit, ics20-2 must be explicitly opted into.
+	SupportedVersions = []string{V1, V2}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TotalEscrowForDenomKey defines the key prefix to store the total amount of tokens
+	// in escrow for a denomination across all channels
+	TotalEscrowForDenomKey = []byte{0x03}
+	// DenomTraceByBaseDenomKey defines the key prefix of the index of denomination traces by base denomination
+	DenomTraceByBaseDenomKey = []byte{0x04}
+	// DenomTraceByChannelKey defines the key prefix of the index of denomination traces by the port
+	// and channel identifiers leading their path
+	DenomTraceByChannelKey = []byte{0x05}
+	// DenomTraceByHopsKey defines the key prefix of the index of denomination traces by number of hops
+	DenomTraceByHopsKey = []byte{0x06}
 )
 
+// DenomTraceByBaseDenomPrefix returns the index key prefix of the denomination traces with the given base denomination.
+func DenomTraceByBaseDenomPrefix(baseDenom string) []byte {
+	return append(copyKey(DenomTraceByBaseDenomKey), lengthPrefix(baseDenom)...)
+}
+
+// DenomTraceByChannelPrefix returns the index key prefix of the denomination traces whose path
+// starts with the given port and channel identifiers.
+func DenomTraceByChannelPrefix(portID, channelID string) []byte {
+	key := append(copyKey(DenomTraceByChannelKey), lengthPrefix(portID)...)
+	return append(key, lengthPrefix(channelID)...)
+}
+
+// DenomTraceByHopsPrefix returns the index key prefix of the denomination traces with the given number of hops.
+func DenomTraceByHopsPrefix(hops uint64) []byte {
+	return append(copyKey(DenomTraceByHopsKey), sdk.Uint64ToBigEndian(hops)...)
+}
+
+// copyKey returns a copy of the key so that appending to it does not modify the original key.
+func copyKey(key []byte) []byte {
+	return append([]byte{}, key...)
+}
+
+// lengthPrefix returns the bytes of the string prefixed by its big endian encoded length, so that
+// the prefix of one string cannot match the prefix of a longer string.
+func lengthPrefix(s string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(len(s))), s...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -47,9 +97,22 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	contents := fmt.Sprintf("%s/%s", portID, channelID)
 
 	// ADR 028 AddressHash construction
-	preImage := []byte(Version)
+	// NOTE: the first version is always used in the preimage so that escrow addresses
+	// remain the same regardless of the version negotiated on the channel
+	preImage := []byte(V1)
 	preImage = append(preImage, 0)
 	preImage = append(preImage, contents...)
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// IsSupportedVersion returns true if the provided version is supported by the IBC transfer module.
+func IsSupportedVersion(version string) bool {
+	for _, supportedVersion := range SupportedVersions {
+		if version == supportedVersion {
+			return true
+		}
+	}
+
+	return false
+}
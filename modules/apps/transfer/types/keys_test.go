@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +23,11 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+// Test that the index key prefix of a denomination or channel is not a prefix of the index key
+// prefix of another denomination or channel starting with the same characters
+func TestDenomTraceIndexPrefixes(t *testing.T) {
+	require.False(t, bytes.HasPrefix(types.DenomTraceByBaseDenomPrefix("uatom2"), types.DenomTraceByBaseDenomPrefix("uatom")))
+	require.False(t, bytes.HasPrefix(types.DenomTraceByChannelPrefix("transfer", "channel-12"), types.DenomTraceByChannelPrefix("transfer", "channel-1")))
+	require.False(t, bytes.HasPrefix(types.DenomTraceByChannelPrefix("transferchannel-1", "channel-1"), types.DenomTraceByChannelPrefix("transfer", "channel-1")))
+}
@@ -22,6 +22,7 @@ func NewMsgTransfer(
 	sourcePort, sourceChannel string,
 	token sdk.Coin, sender, receiver string,
 	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
 ) *MsgTransfer {
 	return &MsgTransfer{
 		SourcePort:       sourcePort,
@@ -31,6 +32,7 @@ func NewMsgTransfer(
 		Receiver:         receiver,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -55,11 +57,19 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Token.String())
+	if len(msg.Tokens) != 0 && !msg.Token.IsNil() && !msg.Token.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidTokens, "cannot fill both token and tokens fields")
 	}
-	if !msg.Token.IsPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Token.String())
+
+	coins := msg.GetCoins()
+	if len(coins) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokens, "no tokens provided for transfer")
+	}
+	if !coins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+	}
+	if !coins.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, coins.String())
 	}
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -69,7 +79,28 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+
+	for _, coin := range coins {
+		if err := ValidateIBCDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCoins returns the tokens to be transferred. The tokens field takes precedence
+// over the single token field when populated.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if len(msg.Tokens) != 0 {
+		return msg.Tokens
+	}
+
+	if msg.Token.IsNil() {
+		return sdk.Coins{}
+	}
+
+	return sdk.Coins{msg.Token}
 }
 
 // GetSignBytes implements sdk.Msg.
@@ -25,12 +25,14 @@ var (
 func NewFungibleTokenPacketData(
 	denom string, amount string,
 	sender, receiver string,
+	memo string,
 ) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -54,7 +56,110 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// GetBytes is a helper for serialising. Unpopulated fields (such as an empty memo)
+// are omitted so that the encoding remains compatible with counterparties that are
+// unaware of newer packet data fields.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// NewFungibleTokenPacketDataV2 constructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer. At least one token must be
+// transferred and each denomination may only be included once.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if len(ftpd.Tokens) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokens, "tokens cannot be empty")
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, token := range ftpd.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[token.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidTokens, "duplicate denomination %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising. Unpopulated fields are omitted.
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// PacketDataV1ToV2 converts a FungibleTokenPacketData into a FungibleTokenPacketDataV2
+// carrying a single token, such that packets of both versions can be processed uniformly.
+func PacketDataV1ToV2(packetData FungibleTokenPacketData) FungibleTokenPacketDataV2 {
+	return NewFungibleTokenPacketDataV2(
+		[]Token{NewToken(packetData.Denom, packetData.Amount)},
+		packetData.Sender, packetData.Receiver, packetData.Memo,
+	)
+}
+
+// UnmarshalPacketData attempts to decode the packet data bytes into a FungibleTokenPacketDataV2
+// according to the provided ICS20 version. Packet data sent over ics20-1 channels is
+// converted into its FungibleTokenPacketDataV2 representation.
+func UnmarshalPacketData(bz []byte, version string) (FungibleTokenPacketDataV2, error) {
+	switch version {
+	case V1:
+		var data FungibleTokenPacketData
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return FungibleTokenPacketDataV2{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return PacketDataV1ToV2(data), nil
+	case V2:
+		var data FungibleTokenPacketDataV2
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return FungibleTokenPacketDataV2{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return data, nil
+	default:
+		return FungibleTokenPacketDataV2{}, sdkerrors.Wrapf(ErrInvalidVersion, "unsupported ICS20 version: %s", version)
+	}
+}
+
+// MarshalPacketData encodes the packet data according to the provided ICS20 version. Packet
+// data sent over ics20-1 channels must carry exactly one token.
+func MarshalPacketData(data FungibleTokenPacketDataV2, version string) ([]byte, error) {
+	switch version {
+	case V1:
+		if len(data.Tokens) != 1 {
+			return nil, sdkerrors.Wrapf(ErrInvalidTokens, "cannot encode %d tokens in packet data of version %s", len(data.Tokens), version)
+		}
+
+		packetData := NewFungibleTokenPacketData(data.Tokens[0].Denom, data.Tokens[0].Amount, data.Sender, data.Receiver, data.Memo)
+		return packetData.GetBytes(), nil
+	case V2:
+		return data.GetBytes(), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidVersion, "unsupported ICS20 version: %s", version)
+	}
 }
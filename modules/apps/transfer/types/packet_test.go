@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,15 +21,16 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2), true},
-		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2), false},
-		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2), false},
-		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2), false},
-		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2), false},
-		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2, ""), true},
+		{"valid packet with memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo"), true},
+		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2, ""), false},
+		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr, ""), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +42,121 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that the memo is only included in the
+// packet bytes when it is populated and that both encodings can be decoded.
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		expBytes string
+	}{
+		{
+			"empty memo is omitted",
+			"",
+			fmt.Sprintf(`{"amount":"%s","denom":"%s","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1),
+		},
+		{
+			"memo is included",
+			"memo",
+			fmt.Sprintf(`{"amount":"%s","denom":"%s","memo":"memo","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1),
+		},
+	}
+
+	for _, tc := range testCases {
+		packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, tc.memo)
+
+		bz := packetData.GetBytes()
+		require.Equal(t, tc.expBytes, string(bz), tc.name)
+
+		var decoded FungibleTokenPacketData
+		require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded), tc.name)
+		require.Equal(t, packetData, decoded, tc.name)
+	}
+}
+
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount)}, addr1, addr2, ""), true},
+		{"valid packet with multiple tokens", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount), NewToken("atom", largeAmount)}, addr1, addr2, "memo"), true},
+		{"no tokens", NewFungibleTokenPacketDataV2(nil, addr1, addr2, ""), false},
+		{"duplicate denominations", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount), NewToken(denom, amount)}, addr1, addr2, ""), false},
+		{"invalid denom", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount), NewToken("", amount)}, addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, "0")}, addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, invalidLargeAmount)}, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount)}, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount)}, addr1, emptyAddr, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestUnmarshalPacketData tests that packet data is decoded according to the channel version
+func TestUnmarshalPacketData(t *testing.T) {
+	packetDataV1 := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	packetDataV2 := NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount), NewToken("atom", amount)}, addr1, addr2, "memo")
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		version string
+		expData FungibleTokenPacketDataV2
+		expPass bool
+	}{
+		{"ics20-1 packet data", packetDataV1.GetBytes(), V1, PacketDataV1ToV2(packetDataV1), true},
+		{"ics20-2 packet data", packetDataV2.GetBytes(), V2, packetDataV2, true},
+		{"ics20-2 packet data on ics20-1 channel", packetDataV2.GetBytes(), V1, FungibleTokenPacketDataV2{}, false},
+		{"ics20-1 packet data on ics20-2 channel", packetDataV1.GetBytes(), V2, FungibleTokenPacketDataV2{}, false},
+		{"unsupported version", packetDataV1.GetBytes(), "ics20-3", FungibleTokenPacketDataV2{}, false},
+	}
+
+	for _, tc := range testCases {
+		data, err := UnmarshalPacketData(tc.bz, tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expData, data, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMarshalPacketData(t *testing.T) {
+	packetDataV1 := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	packetDataV2 := NewFungibleTokenPacketDataV2([]Token{NewToken(denom, amount), NewToken("atom", amount)}, addr1, addr2, "memo")
+
+	testCases := []struct {
+		name    string
+		data    FungibleTokenPacketDataV2
+		version string
+		expBz   []byte
+		expPass bool
+	}{
+		{"ics20-1 packet data", PacketDataV1ToV2(packetDataV1), V1, packetDataV1.GetBytes(), true},
+		{"ics20-2 packet data", packetDataV2, V2, packetDataV2.GetBytes(), true},
+		{"multiple tokens on ics20-1 channel", packetDataV2, V1, nil, false},
+		{"unsupported version", packetDataV2, "ics20-3", nil, false},
+	}
+
+	for _, tc := range testCases {
+		bz, err := MarshalPacketData(tc.data, tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expBz, bz, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -3,7 +3,10 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 const (
@@ -11,6 +14,8 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultDenomMetadataEnabled enabled
+	DefaultDenomMetadataEnabled = true
 )
 
 var (
@@ -18,6 +23,12 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyDenomMetadataEnabled is store's key for DenomMetadataEnabled Params
+	KeyDenomMetadataEnabled = []byte("DenomMetadataEnabled")
+	// KeyChannelEnabled is store's key for ChannelEnabled Params
+	KeyChannelEnabled = []byte("ChannelEnabled")
+	// KeyDenomEnabled is store's key for DenomEnabled Params
+	KeyDenomEnabled = []byte("DenomEnabled")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +37,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive, enableDenomMetadata bool) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:          enableSend,
+		ReceiveEnabled:       enableReceive,
+		DenomMetadataEnabled: enableDenomMetadata,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, DefaultDenomMetadataEnabled)
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +56,67 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.DenomMetadataEnabled); err != nil {
+		return err
+	}
+
+	if err := validateChannelEnabled(p.ChannelEnabled); err != nil {
+		return err
+	}
+
+	return validateDenomEnabled(p.DenomEnabled)
+}
+
+// IsChannelSendEnabled returns false if cross-chain token transfers from this chain over
+// the channel are disabled by its channel entry. The global SendEnabled flag is not checked.
+func (p Params) IsChannelSendEnabled(channelID string) bool {
+	for _, channelEnabled := range p.ChannelEnabled {
+		if channelEnabled.ChannelId == channelID {
+			return channelEnabled.SendEnabled
+		}
+	}
+
+	return true
+}
+
+// IsChannelReceiveEnabled returns false if cross-chain token transfers to this chain over
+// the channel are disabled by its channel entry. The global ReceiveEnabled flag is not checked.
+func (p Params) IsChannelReceiveEnabled(channelID string) bool {
+	for _, channelEnabled := range p.ChannelEnabled {
+		if channelEnabled.ChannelId == channelID {
+			return channelEnabled.ReceiveEnabled
+		}
+	}
+
+	return true
+}
+
+// IsDenomSendEnabled returns false if cross-chain token transfers of the denomination from this
+// chain are disabled by its denomination entry. The global SendEnabled flag is not checked.
+func (p Params) IsDenomSendEnabled(denom string) bool {
+	for _, denomEnabled := range p.DenomEnabled {
+		if denomEnabled.Denom == denom {
+			return denomEnabled.SendEnabled
+		}
+	}
+
+	return true
+}
+
+// IsDenomReceiveEnabled returns false if cross-chain token transfers of the denomination to this
+// chain are disabled by its denomination entry. The global ReceiveEnabled flag is not checked.
+func (p Params) IsDenomReceiveEnabled(denom string) bool {
+	for _, denomEnabled := range p.DenomEnabled {
+		if denomEnabled.Denom == denom {
+			return denomEnabled.ReceiveEnabled
+		}
+	}
+
+	return true
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +124,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyDenomMetadataEnabled, p.DenomMetadataEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyChannelEnabled, p.ChannelEnabled, validateChannelEnabled),
+		paramtypes.NewParamSetPair(KeyDenomEnabled, p.DenomEnabled, validateDenomEnabled),
 	}
 }
 
@@ -63,3 +138,47 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateChannelEnabled(i interface{}) error {
+	channelEnabled, ok := i.([]ChannelEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, entry := range channelEnabled {
+		if err := host.ChannelIdentifierValidator(entry.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel enabled entry: %w", err)
+		}
+
+		if seenChannels[entry.ChannelId] {
+			return fmt.Errorf("duplicate channel enabled entry for channel ID %s", entry.ChannelId)
+		}
+
+		seenChannels[entry.ChannelId] = true
+	}
+
+	return nil
+}
+
+func validateDenomEnabled(i interface{}) error {
+	denomEnabled, ok := i.([]DenomEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, entry := range denomEnabled {
+		if err := sdk.ValidateDenom(entry.Denom); err != nil {
+			return fmt.Errorf("invalid denom enabled entry: %w", err)
+		}
+
+		if seenDenoms[entry.Denom] {
+			return fmt.Errorf("duplicate denom enabled entry for denom %s", entry.Denom)
+		}
+
+		seenDenoms[entry.Denom] = true
+	}
+
+	return nil
+}
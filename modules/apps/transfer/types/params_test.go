@@ -8,5 +8,53 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, false).Validate())
+	require.NoError(t, NewParams(true, false, true).Validate())
+
+	testCases := []struct {
+		name           string
+		channelEnabled []ChannelEnabled
+		denomEnabled   []DenomEnabled
+		expPass        bool
+	}{
+		{"valid channel and denom entries", []ChannelEnabled{{"channel-0", false, true}, {"channel-1", true, false}}, []DenomEnabled{{"uatom", false, true}, {"ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", true, false}}, true},
+		{"invalid channel ID", []ChannelEnabled{{"(channel-0)", false, true}}, nil, false},
+		{"duplicate channel entry", []ChannelEnabled{{"channel-0", false, true}, {"channel-0", true, true}}, nil, false},
+		{"invalid denom", nil, []DenomEnabled{{"1uatom", false, true}}, false},
+		{"duplicate denom entry", nil, []DenomEnabled{{"uatom", false, true}, {"uatom", true, false}}, false},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.ChannelEnabled = tc.channelEnabled
+		params.DenomEnabled = tc.denomEnabled
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsChannelAndDenomEnabled(t *testing.T) {
+	params := DefaultParams()
+	params.ChannelEnabled = []ChannelEnabled{{"channel-0", false, true}, {"channel-1", true, false}}
+	params.DenomEnabled = []DenomEnabled{{"uatom", false, true}, {"stake", true, false}}
+
+	require.False(t, params.IsChannelSendEnabled("channel-0"))
+	require.True(t, params.IsChannelReceiveEnabled("channel-0"))
+	require.True(t, params.IsChannelSendEnabled("channel-1"))
+	require.False(t, params.IsChannelReceiveEnabled("channel-1"))
+
+	require.False(t, params.IsDenomSendEnabled("uatom"))
+	require.True(t, params.IsDenomReceiveEnabled("uatom"))
+	require.True(t, params.IsDenomSendEnabled("stake"))
+	require.False(t, params.IsDenomReceiveEnabled("stake"))
+
+	// channels and denominations without an entry are enabled
+	require.True(t, params.IsChannelSendEnabled("channel-2"))
+	require.True(t, params.IsChannelReceiveEnabled("channel-2"))
+	require.True(t, params.IsDenomSendEnabled("uosmo"))
+	require.True(t, params.IsDenomReceiveEnabled("uosmo"))
 }
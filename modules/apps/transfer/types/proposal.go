@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// ProposalTypeEscrowRecovery defines the type for an EscrowRecoveryProposal
+const ProposalTypeEscrowRecovery = "EscrowRecovery"
+
+var _ govtypes.Content = &EscrowRecoveryProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeEscrowRecovery)
+}
+
+// NewEscrowRecoveryProposal creates a new escrow recovery proposal.
+func NewEscrowRecoveryProposal(title, description, portID, channelID string, recipients []EscrowRecipient) govtypes.Content {
+	return &EscrowRecoveryProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+		Recipients:  recipients,
+	}
+}
+
+// NewEscrowRecipient creates a new EscrowRecipient instance.
+func NewEscrowRecipient(address string, amount sdk.Coins) EscrowRecipient {
+	return EscrowRecipient{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+// GetTitle returns the title of an escrow recovery proposal.
+func (erp *EscrowRecoveryProposal) GetTitle() string { return erp.Title }
+
+// GetDescription returns the description of an escrow recovery proposal.
+func (erp *EscrowRecoveryProposal) GetDescription() string { return erp.Description }
+
+// ProposalRoute returns the routing key of an escrow recovery proposal.
+func (erp *EscrowRecoveryProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an escrow recovery proposal.
+func (erp *EscrowRecoveryProposal) ProposalType() string { return ProposalTypeEscrowRecovery }
+
+// ValidateBasic runs basic stateless validity checks
+func (erp *EscrowRecoveryProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(erp); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(erp.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(erp.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+
+	if len(erp.Recipients) == 0 {
+		return sdkerrors.Wrap(ErrInvalidEscrowRecovery, "recipients cannot be empty")
+	}
+
+	for _, recipient := range erp.Recipients {
+		if err := recipient.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTotalAmount returns the sum of the tokens released to all recipients of the proposal.
+func (erp EscrowRecoveryProposal) GetTotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, recipient := range erp.Recipients {
+		total = total.Add(recipient.Amount...)
+	}
+
+	return total
+}
+
+// ValidateBasic performs a basic validation of the recipient fields.
+func (er EscrowRecipient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(er.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if er.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount released to recipient (%s) cannot be empty", er.Address)
+	}
+
+	if !er.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s) released to recipient (%s)", er.Amount, er.Address)
+	}
+
+	return nil
+}
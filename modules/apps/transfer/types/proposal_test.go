@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+)
+
+func TestEscrowRecoveryProposalValidateBasic(t *testing.T) {
+	var proposal *types.EscrowRecoveryProposal
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: multiple recipients",
+			func() {
+				proposal.Recipients = append(proposal.Recipients, types.NewEscrowRecipient(sdk.AccAddress("recipient").String(), coins))
+			},
+			true,
+		},
+		{
+			"empty title",
+			func() {
+				proposal.Title = ""
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				proposal.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				proposal.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"empty recipients",
+			func() {
+				proposal.Recipients = nil
+			},
+			false,
+		},
+		{
+			"invalid recipient address",
+			func() {
+				proposal.Recipients[0].Address = "invalid"
+			},
+			false,
+		},
+		{
+			"empty amount",
+			func() {
+				proposal.Recipients[0].Amount = sdk.NewCoins()
+			},
+			false,
+		},
+		{
+			"invalid amount",
+			func() {
+				proposal.Recipients[0].Amount = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		proposal = types.NewEscrowRecoveryProposal(ibctesting.Title, ibctesting.Description, ibctesting.TransferPort, ibctesting.FirstChannelID, []types.EscrowRecipient{
+			types.NewEscrowRecipient(ibctesting.TestAccAddress, coins),
+		}).(*types.EscrowRecoveryProposal)
+
+		tc.malleate()
+
+		err := proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestEscrowRecoveryProposalGetTotalAmount(t *testing.T) {
+	proposal := types.NewEscrowRecoveryProposal(ibctesting.Title, ibctesting.Description, ibctesting.TransferPort, ibctesting.FirstChannelID, []types.EscrowRecipient{
+		types.NewEscrowRecipient(ibctesting.TestAccAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))),
+		types.NewEscrowRecipient(sdk.AccAddress("recipient").String(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)), sdk.NewCoin("uatom", sdk.NewInt(10)))),
+	}).(*types.EscrowRecoveryProposal)
+
+	expTotal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)), sdk.NewCoin("uatom", sdk.NewInt(10)))
+	require.Equal(t, expTotal, proposal.GetTotalAmount())
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewToken creates a new Token instance
+func NewToken(denom, amount string) Token {
+	return Token{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// Validate validates the token denomination and amount. The denomination is expected to
+// be the full denomination path of the token.
+func (t Token) Validate() error {
+	amount, ok := sdk.NewIntFromString(t.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// GetDenomTrace returns the denomination trace parsed from the full denomination path of the token.
+func (t Token) GetDenomTrace() DenomTrace {
+	return ParseDenomTrace(t.Denom)
+}
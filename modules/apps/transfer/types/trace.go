@@ -75,6 +75,27 @@ func (dt DenomTrace) GetFullDenomPath() string {
 	return dt.GetPrefix() + dt.BaseDenom
 }
 
+// Hops returns the number of port and channel identifier pairs in the trace path.
+func (dt DenomTrace) Hops() uint64 {
+	if dt.Path == "" {
+		return 0
+	}
+
+	return uint64(len(strings.Split(dt.Path, "/")) / 2)
+}
+
+// LeadingChannel returns the port and channel identifiers at the start of the trace path, which
+// identify the channel on this chain the tokens were received over. False is returned if the trace
+// has no path.
+func (dt DenomTrace) LeadingChannel() (portID, channelID string, found bool) {
+	identifiers := strings.SplitN(dt.Path, "/", 3)
+	if len(identifiers) < 2 {
+		return "", "", false
+	}
+
+	return identifiers[0], identifiers[1], true
+}
+
 // extractPathAndBaseFromFullDenom returns the trace path and the base denom from
 // the elements that constitute the complete denom.
 func extractPathAndBaseFromFullDenom(fullDenomItems []string) (string, string) {
@@ -58,6 +58,43 @@ func TestDenomTrace_IBCDenom(t *testing.T) {
 	}
 }
 
+func TestDenomTrace_Hops(t *testing.T) {
+	testCases := []struct {
+		name    string
+		trace   DenomTrace
+		expHops uint64
+	}{
+		{"base denom", DenomTrace{BaseDenom: "uatom"}, 0},
+		{"single hop", DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"}, 1},
+		{"multiple hops", DenomTrace{BaseDenom: "gamm/pool/1", Path: "transfer/channel-1/transfer/channel-2/transfer/channel-3"}, 3},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expHops, tc.trace.Hops(), tc.name)
+	}
+}
+
+func TestDenomTrace_LeadingChannel(t *testing.T) {
+	testCases := []struct {
+		name         string
+		trace        DenomTrace
+		expPortID    string
+		expChannelID string
+		expFound     bool
+	}{
+		{"base denom", DenomTrace{BaseDenom: "uatom"}, "", "", false},
+		{"single hop", DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"}, "transfer", "channel-1", true},
+		{"multiple hops", DenomTrace{BaseDenom: "uatom", Path: "customtransfer/channel-12/transfer/channel-2"}, "customtransfer", "channel-12", true},
+	}
+
+	for _, tc := range testCases {
+		portID, channelID, found := tc.trace.LeadingChannel()
+		require.Equal(t, tc.expPortID, portID, tc.name)
+		require.Equal(t, tc.expChannelID, channelID, tc.name)
+		require.Equal(t, tc.expFound, found, tc.name)
+	}
+}
+
 func TestDenomTrace_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -91,6 +91,18 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// denom_metadata_enabled enables or disables setting the bank denomination
+	// metadata of vouchers minted for new denomination traces.
+	DenomMetadataEnabled bool `protobuf:"varint,3,opt,name=denom_metadata_enabled,json=denomMetadataEnabled,proto3" json:"denom_metadata_enabled,omitempty" yaml:"denom_metadata_enabled"`
+	// channel_enabled overrides the send and receive enabled flags for specific
+	// transfer channels. Transfers over channels without an entry are only
+	// subject to the send_enabled and receive_enabled flags.
+	ChannelEnabled []ChannelEnabled `protobuf:"bytes,4,rep,name=channel_enabled,json=channelEnabled,proto3" json:"channel_enabled" yaml:"channel_enabled"`
+	// denom_enabled overrides the send and receive enabled flags for specific
+	// denominations, as represented on this chain. Transfers of denominations
+	// without an entry are only subject to the send_enabled and receive_enabled
+	// flags.
+	DenomEnabled []DenomEnabled `protobuf:"bytes,5,rep,name=denom_enabled,json=denomEnabled,proto3" json:"denom_enabled" yaml:"denom_enabled"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +152,167 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomMetadataEnabled() bool {
+	if m != nil {
+		return m.DenomMetadataEnabled
+	}
+	return false
+}
+
+func (m *Params) GetChannelEnabled() []ChannelEnabled {
+	if m != nil {
+		return m.ChannelEnabled
+	}
+	return nil
+}
+
+func (m *Params) GetDenomEnabled() []DenomEnabled {
+	if m != nil {
+		return m.DenomEnabled
+	}
+	return nil
+}
+
+// ChannelEnabled defines whether cross-chain token transfers over a transfer
+// channel are enabled.
+type ChannelEnabled struct {
+	// the transfer channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// send_enabled enables or disables cross-chain token transfers from this
+	// chain over the channel.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled"`
+	// receive_enabled enables or disables cross-chain token transfers to this
+	// chain over the channel.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+}
+
+func (m *ChannelEnabled) Reset()         { *m = ChannelEnabled{} }
+func (m *ChannelEnabled) String() string { return proto.CompactTextString(m) }
+func (*ChannelEnabled) ProtoMessage()    {}
+func (*ChannelEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *ChannelEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEnabled.Merge(m, src)
+}
+func (m *ChannelEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEnabled proto.InternalMessageInfo
+
+func (m *ChannelEnabled) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelEnabled) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelEnabled) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// DenomEnabled defines whether cross-chain token transfers of a denomination
+// are enabled.
+type DenomEnabled struct {
+	// the denomination as represented on this chain, i.e. the base denomination
+	// of native tokens or the ibc denomination of vouchers.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// send_enabled enables or disables cross-chain token transfers of the
+	// denomination from this chain.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled"`
+	// receive_enabled enables or disables cross-chain token transfers of the
+	// denomination to this chain.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+}
+
+func (m *DenomEnabled) Reset()         { *m = DenomEnabled{} }
+func (m *DenomEnabled) String() string { return proto.CompactTextString(m) }
+func (*DenomEnabled) ProtoMessage()    {}
+func (*DenomEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *DenomEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomEnabled.Merge(m, src)
+}
+func (m *DenomEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomEnabled proto.InternalMessageInfo
+
+func (m *DenomEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomEnabled) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *DenomEnabled) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*ChannelEnabled)(nil), "ibc.applications.transfer.v1.ChannelEnabled")
+	proto.RegisterType((*DenomEnabled)(nil), "ibc.applications.transfer.v1.DenomEnabled")
 }
 
 func init() {
@@ -150,26 +320,37 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0x11, 0x11, 0xba, 0x11, 0xaa, 0xee, 0xb5, 0xb8, 0x72, 0x53, 0x92, 0xcc, 0x71, 0x1a, 0x98,
-	0x4c, 0x86, 0x24, 0x1d, 0xe8, 0x23, 0xb8, 0xf3, 0xb1, 0x5c, 0x76, 0xe9, 0xaa, 0x48, 0xfb, 0x06,
-	0x7d, 0x02, 0x99, 0xb4, 0x94, 0xc1, 0xdd, 0x7f, 0xce, 0xf9, 0xbe, 0xb3, 0xf8, 0xf1, 0x95, 0x12,
-	0x92, 0xf1, 0xa2, 0xc8, 0x94, 0xe4, 0x5e, 0x99, 0xdc, 0x31, 0x6f, 0x79, 0xee, 0xde, 0xc1, 0xb2,
-	0xb2, 0xbf, 0xcb, 0x71, 0x61, 0x8d, 0x37, 0xe4, 0x4c, 0x09, 0x19, 0xd7, 0xe1, 0x78, 0x07, 0x94,
-	0xfd, 0xee, 0x71, 0x6a, 0x52, 0x13, 0x40, 0x56, 0xa5, 0x8d, 0x13, 0xdd, 0x63, 0xfc, 0x08, 0xb9,
-	0xd1, 0xaf, 0x96, 0x4b, 0x20, 0x04, 0xff, 0x2b, 0xb8, 0x9f, 0x74, 0xd0, 0x05, 0xba, 0x6c, 0x8f,
-	0x42, 0x26, 0xe7, 0x18, 0x0b, 0xee, 0x60, 0x9c, 0x54, 0x58, 0xa7, 0x19, 0x2e, 0xed, 0x6a, 0x13,
-	0xbc, 0xe8, 0x03, 0xe1, 0xd6, 0x33, 0xb7, 0x5c, 0x3b, 0x32, 0xc0, 0xfb, 0x0e, 0xf2, 0x64, 0x0c,
-	0x39, 0x17, 0x19, 0x24, 0xe1, 0xcb, 0xff, 0xe1, 0xe9, 0x7a, 0xd1, 0x3b, 0x9a, 0x71, 0x9d, 0x0d,
-	0xa2, 0xfa, 0x35, 0x1a, 0xed, 0x55, 0xe3, 0xd3, 0x66, 0x22, 0x0f, 0xf8, 0xd0, 0x82, 0x04, 0x55,
-	0xc2, 0x4e, 0x6f, 0x06, 0xbd, 0xbb, 0x5e, 0xf4, 0x4e, 0x36, 0xfa, 0x1f, 0x20, 0x1a, 0x1d, 0x6c,
-	0x37, 0xdb, 0x27, 0xc3, 0x97, 0xaf, 0x25, 0x45, 0xf3, 0x25, 0x45, 0x3f, 0x4b, 0x8a, 0x3e, 0x57,
-	0xb4, 0x31, 0x5f, 0xd1, 0xc6, 0xf7, 0x8a, 0x36, 0xde, 0xee, 0x52, 0xe5, 0x27, 0x53, 0x11, 0x4b,
-	0xa3, 0x99, 0x34, 0x4e, 0x1b, 0xc7, 0x94, 0x90, 0xd7, 0xa9, 0x61, 0xe5, 0x2d, 0xd3, 0x26, 0x99,
-	0x66, 0xe0, 0xaa, 0x9e, 0x6b, 0xfd, 0xfa, 0x59, 0x01, 0x4e, 0xb4, 0x42, 0x4d, 0x37, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x6e, 0xa1, 0x51, 0x3a, 0x89, 0x01, 0x00, 0x00,
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x93, 0xb6, 0x22, 0xd3, 0x90, 0x8a, 0x25, 0x94, 0xaa, 0x6a, 0xed, 0xb2, 0xa7, 0x8a,
+	0x1f, 0x5b, 0xe5, 0x47, 0x48, 0xbd, 0x20, 0xa5, 0x70, 0xe0, 0x80, 0x04, 0x16, 0x12, 0x12, 0x97,
+	0x68, 0xbd, 0x5e, 0x92, 0x95, 0x6c, 0xaf, 0xe5, 0xdd, 0x44, 0xea, 0x5b, 0xf0, 0x12, 0x3c, 0x0b,
+	0x39, 0xf6, 0xc8, 0xc9, 0x42, 0xc9, 0x1b, 0xe4, 0x09, 0x90, 0x77, 0x6d, 0xe3, 0x14, 0x14, 0x09,
+	0x71, 0xe8, 0x6d, 0x67, 0xe6, 0xfb, 0x99, 0xf1, 0x8e, 0x17, 0x1e, 0xf1, 0x80, 0x7a, 0x24, 0x4d,
+	0x23, 0x4e, 0x89, 0xe2, 0x22, 0x91, 0x9e, 0xca, 0x48, 0x22, 0xbf, 0xb0, 0xcc, 0x9b, 0x9d, 0xd5,
+	0x67, 0x37, 0xcd, 0x84, 0x12, 0xe8, 0x88, 0x07, 0xd4, 0x6d, 0x82, 0xdd, 0x1a, 0x30, 0x3b, 0x3b,
+	0x1c, 0x8c, 0xc5, 0x58, 0x68, 0xa0, 0x57, 0x9c, 0x0c, 0x07, 0xbf, 0x02, 0x78, 0xcd, 0x12, 0x11,
+	0x7f, 0xcc, 0x08, 0x65, 0x08, 0xc1, 0x56, 0x4a, 0xd4, 0xe4, 0xc0, 0x3a, 0xb1, 0x4e, 0xbb, 0xbe,
+	0x3e, 0xa3, 0x63, 0x80, 0x80, 0x48, 0x36, 0x0a, 0x0b, 0xd8, 0x41, 0x5b, 0x57, 0xba, 0x45, 0x46,
+	0xf3, 0xf0, 0xbc, 0x03, 0x3b, 0xef, 0x49, 0x46, 0x62, 0x89, 0xce, 0xa1, 0x27, 0x59, 0x12, 0x8e,
+	0x58, 0x42, 0x82, 0x88, 0x85, 0x5a, 0xe5, 0xd6, 0xf0, 0xfe, 0x2a, 0x77, 0xee, 0x5e, 0x92, 0x38,
+	0x3a, 0xc7, 0xcd, 0x2a, 0xf6, 0x77, 0x8b, 0xf0, 0x8d, 0x89, 0xd0, 0x05, 0xec, 0x65, 0x8c, 0x32,
+	0x3e, 0x63, 0x35, 0xbd, 0xad, 0xe9, 0x87, 0xab, 0xdc, 0xd9, 0x37, 0xf4, 0x6b, 0x00, 0xec, 0xf7,
+	0xcb, 0x4c, 0x25, 0xf2, 0x09, 0xf6, 0x75, 0x97, 0xa3, 0x98, 0x29, 0x12, 0x12, 0x45, 0x6a, 0xad,
+	0x8e, 0xd6, 0x7a, 0xb0, 0xca, 0x9d, 0x63, 0xa3, 0xf5, 0x77, 0x1c, 0xf6, 0x07, 0xba, 0xf0, 0xae,
+	0xcc, 0x57, 0xc2, 0x53, 0xd8, 0xa3, 0x13, 0x92, 0x24, 0x2c, 0xaa, 0x15, 0xb7, 0x4e, 0x3a, 0xa7,
+	0xbb, 0x4f, 0x1f, 0xbb, 0x9b, 0xbe, 0xb9, 0x7b, 0x61, 0x48, 0xa5, 0xcc, 0xd0, 0x9e, 0xe7, 0x4e,
+	0xeb, 0xf7, 0x3c, 0xd7, 0x24, 0xb1, 0xdf, 0xa7, 0x6b, 0x78, 0x14, 0xc3, 0x6d, 0xd3, 0x67, 0x65,
+	0xba, 0xad, 0x4d, 0x1f, 0x6e, 0x36, 0xd5, 0xf7, 0x52, 0x59, 0x1e, 0x95, 0x96, 0x83, 0xe6, 0xd8,
+	0xb5, 0x61, 0x2f, 0x6c, 0x60, 0xf1, 0x77, 0x0b, 0xfa, 0xeb, 0x1d, 0xa3, 0xe7, 0x00, 0x55, 0x97,
+	0xdc, 0x5c, 0x68, 0x77, 0x78, 0x6f, 0x95, 0x3b, 0x77, 0xd6, 0x27, 0xe0, 0x21, 0xf6, 0xbb, 0x65,
+	0xf0, 0x36, 0xfc, 0x63, 0x11, 0xda, 0xff, 0xb7, 0x08, 0x9d, 0x7f, 0x5d, 0x04, 0xfc, 0xcd, 0x82,
+	0x5e, 0xf3, 0x33, 0xa0, 0x01, 0x6c, 0x9b, 0xfd, 0x35, 0x9b, 0x6d, 0x82, 0x1b, 0xef, 0x73, 0xf8,
+	0x61, 0xbe, 0xb0, 0xad, 0xab, 0x85, 0x6d, 0xfd, 0x5c, 0xd8, 0xd6, 0xd7, 0xa5, 0xdd, 0xba, 0x5a,
+	0xda, 0xad, 0x1f, 0x4b, 0xbb, 0xf5, 0xf9, 0xe5, 0x98, 0xab, 0xc9, 0x34, 0x70, 0xa9, 0x88, 0x3d,
+	0x2a, 0x64, 0x2c, 0xa4, 0xc7, 0x03, 0xfa, 0x64, 0x2c, 0xbc, 0xd9, 0x0b, 0x2f, 0x16, 0xe1, 0x34,
+	0x62, 0xb2, 0x78, 0x18, 0x1a, 0x0f, 0x82, 0xba, 0x4c, 0x99, 0x0c, 0x76, 0xf4, 0x7f, 0xfd, 0xec,
+	0xd7, 0x00, 0x59, 0xb5, 0x76, 0x2d, 0x3a, 0x04, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +410,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomEnabled) > 0 {
+		for iNdEx := len(m.DenomEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChannelEnabled) > 0 {
+		for iNdEx := len(m.ChannelEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DenomMetadataEnabled {
+		i--
+		if m.DenomMetadataEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,6 +471,106 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -292,6 +611,59 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if m.DenomMetadataEnabled {
+		n += 2
+	}
+	if len(m.ChannelEnabled) > 0 {
+		for _, e := range m.ChannelEnabled {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.DenomEnabled) > 0 {
+		for _, e := range m.DenomEnabled {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -484,6 +856,338 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadataEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenomMetadataEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelEnabled = append(m.ChannelEnabled, ChannelEnabled{})
+			if err := m.ChannelEnabled[len(m.ChannelEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomEnabled = append(m.DenomEnabled, DenomEnabled{})
+			if err := m.DenomEnabled[len(m.DenomEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+var _ authz.Authorization = &TransferAuthorization{}
+
+// allowListGasCostPerIteration is the gas consumed for each receiver of an allow list checked on Accept
+const allowListGasCostPerIteration = 10
+
+// NewTransferAuthorization creates a new TransferAuthorization object.
+func NewTransferAuthorization(allocations ...Allocation) *TransferAuthorization {
+	return &TransferAuthorization{
+		Allocations: allocations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransfer{})
+}
+
+// Accept implements Authorization.Accept. The transfer is accepted if an allocation exists for its
+// source port and channel, the receiver is allowed by the allocation and the transferred coins do not
+// exceed the spend limit of the allocation. The spend limit is decremented by the transferred coins and
+// allocations which are fully spent are removed. The authorization is deleted once all allocations are spent.
+func (a TransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgTransfer, ok := msg.(*MsgTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	for index, allocation := range a.Allocations {
+		if allocation.SourcePort != msgTransfer.SourcePort || allocation.SourceChannel != msgTransfer.SourceChannel {
+			continue
+		}
+
+		if !isAllowedAddress(ctx, msgTransfer.Receiver, allocation.AllowList) {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "receiver (%s) is not allowed for transfers on channel (%s)", msgTransfer.Receiver, msgTransfer.SourceChannel)
+		}
+
+		limitLeft, isNegative := allocation.SpendLimit.SafeSub(msgTransfer.GetCoins()...)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit (%s)", allocation.SpendLimit)
+		}
+
+		// copy the allocations to avoid mutating the authorization the method is called on
+		allocations := make([]Allocation, 0, len(a.Allocations))
+		allocations = append(allocations, a.Allocations[:index]...)
+
+		if !limitLeft.IsZero() {
+			allocations = append(allocations, Allocation{
+				SourcePort:    allocation.SourcePort,
+				SourceChannel: allocation.SourceChannel,
+				SpendLimit:    limitLeft,
+				AllowList:     allocation.AllowList,
+			})
+		}
+
+		allocations = append(allocations, a.Allocations[index+1:]...)
+
+		if len(allocations) == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+
+		return authz.AcceptResponse{Accept: true, Delete: false, Updated: NewTransferAuthorization(allocations...)}, nil
+	}
+
+	return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "allocation for port ID (%s) channel ID (%s) does not exist", msgTransfer.SourcePort, msgTransfer.SourceChannel)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferAuthorization) ValidateBasic() error {
+	if len(a.Allocations) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "allocations cannot be empty")
+	}
+
+	foundChannels := make(map[string]bool)
+	for _, allocation := range a.Allocations {
+		if err := allocation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		path := host.ChannelPath(allocation.SourcePort, allocation.SourceChannel)
+		if foundChannels[path] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "duplicate allocation for port ID (%s) channel ID (%s)", allocation.SourcePort, allocation.SourceChannel)
+		}
+
+		foundChannels[path] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the allocation fields.
+func (a Allocation) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(a.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(a.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+
+	if a.SpendLimit.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be empty")
+	}
+
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit: %s", err.Error())
+	}
+
+	foundReceivers := make(map[string]bool)
+	for _, receiver := range a.AllowList {
+		// the receiver is an address on the counterparty chain, which may use a different address format
+		if strings.TrimSpace(receiver) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "allow list receiver cannot be blank")
+		}
+
+		if foundReceivers[receiver] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "duplicate receiver (%s) in allow list", receiver)
+		}
+
+		foundReceivers[receiver] = true
+	}
+
+	return nil
+}
+
+// isAllowedAddress returns a boolean indicating if the receiver address is valid for transfer.
+// An empty allow list permits any receiver.
+func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) bool {
+	if len(allowedAddrs) == 0 {
+		return true
+	}
+
+	for _, addr := range allowedAddrs {
+		ctx.GasMeter().ConsumeGas(allowListGasCostPerIteration, "transfer authorization")
+		if addr == receiver {
+			return true
+		}
+	}
+
+	return false
+}
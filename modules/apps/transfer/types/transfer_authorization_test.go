@@ -0,0 +1,375 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/cosmos/ibc-go/v5/testing/mock"
+)
+
+var (
+	sdkCoins        = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	largerSdkCoins  = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))
+	multiDenomCoins = sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(50)), sdk.NewCoin("stake", sdk.NewInt(100)))
+)
+
+// NewTransferPath creates a path between the transfer ports of the provided chains
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
+	var (
+		msgTransfer   types.MsgTransfer
+		transferAuthz types.TransferAuthorization
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		assertResult func(res authz.AcceptResponse, err error)
+	}{
+		{
+			"success: spend limit is decremented",
+			func() {},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(900))), updatedAuthz.Allocations[0].SpendLimit)
+
+				// the authorization the method was called on is not mutated
+				suite.Require().Equal(largerSdkCoins, transferAuthz.Allocations[0].SpendLimit)
+			},
+		},
+		{
+			"success: multiple denoms",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = largerSdkCoins.Add(sdk.NewCoin("atom", sdk.NewInt(50)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = multiDenomCoins
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(900))), updatedAuthz.Allocations[0].SpendLimit)
+			},
+		},
+		{
+			"success: with spend limit fully spent, the allocation is removed",
+			func() {
+				transferAuthz.Allocations = append(transferAuthz.Allocations, types.Allocation{
+					SourcePort:    ibctesting.TransferPort,
+					SourceChannel: "channel-9",
+					SpendLimit:    sdkCoins,
+				})
+
+				msgTransfer.Token = sdk.NewCoin("stake", sdk.NewInt(1000))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Len(updatedAuthz.Allocations, 1)
+				suite.Require().Equal("channel-9", updatedAuthz.Allocations[0].SourceChannel)
+			},
+		},
+		{
+			"success: with spend limit of the last allocation fully spent, the authorization is deleted",
+			func() {
+				msgTransfer.Token = sdk.NewCoin("stake", sdk.NewInt(1000))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+				suite.Require().Nil(res.Updated)
+			},
+		},
+		{
+			"success: receiver is in the allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{ibctesting.TestAccAddress, suite.chainB.SenderAccount.GetAddress().String()}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Equal(transferAuthz.Allocations[0].AllowList, updatedAuthz.Allocations[0].AllowList)
+			},
+		},
+		{
+			"failure: receiver is not in the allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{ibctesting.TestAccAddress}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: spend limit exceeded",
+			func() {
+				msgTransfer.Token = sdk.NewCoin("stake", sdk.NewInt(1001))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: denom not in spend limit",
+			func() {
+				msgTransfer.Token = sdk.NewCoin("atom", sdk.NewInt(1))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: no allocation for the source channel",
+			func() {
+				msgTransfer.SourceChannel = "channel-9"
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: message is not a MsgTransfer",
+			func() {},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			transferAuthz = types.TransferAuthorization{
+				Allocations: []types.Allocation{
+					{
+						SourcePort:    path.EndpointA.ChannelConfig.PortID,
+						SourceChannel: path.EndpointA.ChannelID,
+						SpendLimit:    largerSdkCoins,
+					},
+				},
+			}
+
+			msgTransfer = types.MsgTransfer{
+				SourcePort:    path.EndpointA.ChannelConfig.PortID,
+				SourceChannel: path.EndpointA.ChannelID,
+				Token:         sdk.NewCoin("stake", sdk.NewInt(100)),
+				Sender:        suite.chainA.SenderAccount.GetAddress().String(),
+				Receiver:      suite.chainB.SenderAccount.GetAddress().String(),
+				TimeoutHeight: suite.chainB.GetTimeoutHeight(),
+			}
+
+			tc.malleate()
+
+			if tc.assertResult == nil {
+				_, err := transferAuthz.Accept(suite.chainA.GetContext(), &clienttypes.MsgUpdateClient{})
+				suite.Require().ErrorIs(err, sdkerrors.ErrInvalidType)
+				return
+			}
+
+			res, err := transferAuthz.Accept(suite.chainA.GetContext(), &msgTransfer)
+			tc.assertResult(res, err)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationMsgTypeURL() {
+	var transferAuthz types.TransferAuthorization
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgTransfer{}), transferAuthz.MsgTypeURL(), "invalid type url for transfer authorization")
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
+	var transferAuthz types.TransferAuthorization
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: multiple allocations",
+			func() {
+				transferAuthz.Allocations = append(transferAuthz.Allocations, types.Allocation{
+					SourcePort:    mock.PortID,
+					SourceChannel: "channel-1",
+					SpendLimit:    multiDenomCoins,
+				})
+			},
+			true,
+		},
+		{
+			"success: with allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{ibctesting.TestAccAddress, "osmo1receiver"}
+			},
+			true,
+		},
+		{
+			"empty allocations",
+			func() {
+				transferAuthz = types.TransferAuthorization{Allocations: []types.Allocation{}}
+			},
+			false,
+		},
+		{
+			"nil spend limit coins",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = nil
+			},
+			false,
+		},
+		{
+			"invalid spend limit coins",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.Coins{sdk.Coin{Denom: ""}}
+			},
+			false,
+		},
+		{
+			"zero spend limit coins",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}
+			},
+			false,
+		},
+		{
+			"duplicate channel ID",
+			func() {
+				transferAuthz.Allocations = append(transferAuthz.Allocations, transferAuthz.Allocations[0])
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				transferAuthz.Allocations[0].SourcePort = ""
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				transferAuthz.Allocations[0].SourceChannel = ""
+			},
+			false,
+		},
+		{
+			"blank allow list receiver",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{" "}
+			},
+			false,
+		},
+		{
+			"duplicate allow list receiver",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{ibctesting.TestAccAddress, ibctesting.TestAccAddress}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			transferAuthz = types.TransferAuthorization{
+				Allocations: []types.Allocation{
+					{
+						SourcePort:    mock.PortID,
+						SourceChannel: ibctesting.FirstChannelID,
+						SpendLimit:    sdkCoins,
+					},
+				},
+			}
+
+			tc.malleate()
+
+			err := transferAuthz.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationExec() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// the grantee is the default sender account of chainA, which signs the MsgExec
+	granter := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	grantee := suite.chainA.SenderAccount.GetAddress()
+
+	transferAuthz := types.NewTransferAuthorization(types.Allocation{
+		SourcePort:    path.EndpointA.ChannelConfig.PortID,
+		SourceChannel: path.EndpointA.ChannelID,
+		SpendLimit:    largerSdkCoins,
+	})
+
+	// the grant is stored directly since a MsgGrant must be signed by the granter
+	err := suite.chainA.GetSimApp().AuthzKeeper.SaveGrant(suite.chainA.GetContext(), grantee, granter, transferAuthz, nil)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	msgTransfer := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin("stake", sdk.NewInt(100)),
+		granter.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	msgExec := authz.NewMsgExec(grantee, []sdk.Msg{msgTransfer})
+
+	_, err = suite.chainA.SendMsgs(&msgExec)
+	suite.Require().NoError(err)
+
+	authorizations, err := suite.chainA.GetSimApp().AuthzKeeper.GetAuthorizations(suite.chainA.GetContext(), grantee, granter)
+	suite.Require().NoError(err)
+	suite.Require().Len(authorizations, 1)
+
+	updatedAuthz, ok := authorizations[0].(*types.TransferAuthorization)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(900))), updatedAuthz.Allocations[0].SpendLimit)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -38,7 +39,7 @@ type MsgTransfer struct {
 	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty" yaml:"source_port"`
 	// the channel by which the packet will be sent
 	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty" yaml:"source_channel"`
-	// the tokens to be transferred
+	// the token to be transferred. Must be left empty when the tokens field is used.
 	Token types.Coin `protobuf:"bytes,3,opt,name=token,proto3" json:"token"`
 	// the sender address
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -50,6 +51,11 @@ type MsgTransfer struct {
 	// Timeout timestamp in absolute nanoseconds since unix epoch.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// optional memo
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// the tokens to be transferred in a single packet. Only supported by channels
+	// using the ics20-2 version. Must be left empty when the token field is used.
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -132,38 +138,42 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x92, 0x86, 0x70, 0x51, 0x2b, 0x30, 0xb4, 0x72, 0xa3, 0x62, 0x47, 0x96, 0x90,
-	0xc2, 0xc0, 0x9d, 0x5c, 0x54, 0x55, 0xea, 0x84, 0xd2, 0x05, 0x86, 0x4a, 0x60, 0x75, 0x62, 0x29,
-	0xf6, 0xf5, 0x70, 0x4e, 0xc4, 0xf7, 0xac, 0xbb, 0x8b, 0x45, 0xbf, 0x01, 0x23, 0x1f, 0xa1, 0x33,
-	0x9f, 0xa4, 0x63, 0x47, 0xa6, 0x08, 0x25, 0x0b, 0x73, 0x3e, 0x01, 0x3a, 0xfb, 0x12, 0x92, 0x05,
-	0x31, 0xd9, 0xef, 0xfd, 0x7f, 0xef, 0xfe, 0x7a, 0xf7, 0xde, 0xa1, 0x17, 0x3c, 0xa3, 0x24, 0x2d,
-	0xcb, 0x09, 0xa7, 0xa9, 0xe6, 0x20, 0x14, 0xd1, 0x32, 0x15, 0xea, 0x33, 0x93, 0xa4, 0x8a, 0x89,
-	0xfe, 0x8a, 0x4b, 0x09, 0x1a, 0xbc, 0x23, 0x9e, 0x51, 0xbc, 0x89, 0xe1, 0x15, 0x86, 0xab, 0xb8,
-	0xff, 0x2c, 0x87, 0x1c, 0x6a, 0x90, 0x98, 0xbf, 0xa6, 0xa6, 0x1f, 0x50, 0x50, 0x05, 0x28, 0x92,
-	0xa5, 0x8a, 0x91, 0x2a, 0xce, 0x98, 0x4e, 0x63, 0x42, 0x81, 0x0b, 0xab, 0x87, 0xc6, 0x9a, 0x82,
-	0x64, 0x84, 0x4e, 0x38, 0x13, 0xda, 0x18, 0x36, 0x7f, 0x0d, 0x10, 0xfd, 0x68, 0xa1, 0xde, 0x85,
-	0xca, 0x2f, 0xad, 0x93, 0x77, 0x8a, 0x7a, 0x0a, 0xa6, 0x92, 0xb2, 0xab, 0x12, 0xa4, 0xf6, 0xdd,
-	0x81, 0x3b, 0x7c, 0x34, 0x3a, 0x58, 0xce, 0x42, 0xef, 0x26, 0x2d, 0x26, 0x67, 0xd1, 0x86, 0x18,
-	0x25, 0xa8, 0x89, 0xde, 0x83, 0xd4, 0xde, 0x1b, 0xb4, 0x67, 0x35, 0x3a, 0x4e, 0x85, 0x60, 0x13,
-	0xff, 0x41, 0x5d, 0x7b, 0xb8, 0x9c, 0x85, 0xfb, 0x5b, 0xb5, 0x56, 0x8f, 0x92, 0xdd, 0x26, 0x71,
-	0xde, 0xc4, 0xde, 0x09, 0xda, 0xd1, 0xf0, 0x85, 0x09, 0xbf, 0x35, 0x70, 0x87, 0xbd, 0xe3, 0x43,
-	0xdc, 0xf4, 0x86, 0x4d, 0x6f, 0xd8, 0xf6, 0x86, 0xcf, 0x81, 0x8b, 0x51, 0xfb, 0x6e, 0x16, 0x3a,
-	0x49, 0x43, 0x7b, 0x07, 0xa8, 0xa3, 0x98, 0xb8, 0x66, 0xd2, 0x6f, 0x1b, 0xc3, 0xc4, 0x46, 0x5e,
-	0x1f, 0x75, 0x25, 0xa3, 0x8c, 0x57, 0x4c, 0xfa, 0x3b, 0xb5, 0xb2, 0x8e, 0xbd, 0x4f, 0x68, 0x4f,
-	0xf3, 0x82, 0xc1, 0x54, 0x5f, 0x8d, 0x19, 0xcf, 0xc7, 0xda, 0xef, 0xd4, 0x9e, 0x7d, 0x6c, 0x66,
-	0x60, 0xee, 0x0b, 0xdb, 0x5b, 0xaa, 0x62, 0xfc, 0xb6, 0x26, 0x46, 0xcf, 0x8d, 0xe9, 0xdf, 0x66,
-	0xb6, 0xeb, 0xa3, 0x64, 0xd7, 0x26, 0x1a, 0xda, 0x7b, 0x87, 0x9e, 0xac, 0x08, 0xf3, 0x55, 0x3a,
-	0x2d, 0x4a, 0xff, 0xe1, 0xc0, 0x1d, 0xb6, 0x47, 0x47, 0xcb, 0x59, 0xe8, 0x6f, 0x1f, 0xb2, 0x46,
-	0xa2, 0xe4, 0xb1, 0xcd, 0x5d, 0xae, 0x52, 0x67, 0xdd, 0x6f, 0xb7, 0xa1, 0xf3, 0xfb, 0x36, 0x74,
-	0xa2, 0x7d, 0xf4, 0x74, 0x63, 0x56, 0x09, 0x53, 0x25, 0x08, 0xc5, 0x8e, 0x01, 0xb5, 0x2e, 0x54,
-	0xee, 0x8d, 0x51, 0x77, 0x3d, 0xc6, 0x97, 0xf8, 0x5f, 0xcb, 0x84, 0x37, 0x4e, 0xe9, 0xc7, 0xff,
-	0x8d, 0xae, 0x0c, 0x47, 0x1f, 0xee, 0xe6, 0x81, 0x7b, 0x3f, 0x0f, 0xdc, 0x5f, 0xf3, 0xc0, 0xfd,
-	0xbe, 0x08, 0x9c, 0xfb, 0x45, 0xe0, 0xfc, 0x5c, 0x04, 0xce, 0xc7, 0xd3, 0x9c, 0xeb, 0xf1, 0x34,
-	0xc3, 0x14, 0x0a, 0x62, 0x57, 0x93, 0x67, 0xf4, 0x55, 0x0e, 0xa4, 0x3a, 0x21, 0x05, 0x5c, 0x4f,
-	0x27, 0x4c, 0x99, 0xa7, 0xb0, 0xf1, 0x04, 0xf4, 0x4d, 0xc9, 0x54, 0xd6, 0xa9, 0xd7, 0xf1, 0xf5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x79, 0xfe, 0x8d, 0x2c, 0x03, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0x49, 0x1a, 0xd2, 0x8d, 0x5a, 0x95, 0x85, 0x56, 0x6e, 0x54, 0xec, 0xc8, 0x12, 0x52,
+	0x90, 0xe8, 0xae, 0x5c, 0x54, 0x55, 0xea, 0x09, 0xa5, 0x1c, 0xe0, 0x50, 0x09, 0xac, 0x9e, 0xb8,
+	0x14, 0x7b, 0xb3, 0x38, 0xab, 0xc6, 0x1e, 0xcb, 0xbb, 0x31, 0xe4, 0x0d, 0x38, 0xf2, 0x08, 0x3d,
+	0xf3, 0x02, 0xbc, 0x42, 0x8f, 0x3d, 0x72, 0x0a, 0x28, 0xb9, 0x20, 0x8e, 0x79, 0x02, 0x64, 0x7b,
+	0x13, 0x12, 0x21, 0x55, 0x9c, 0x76, 0x7e, 0xbe, 0x99, 0xcf, 0xdf, 0x78, 0x06, 0x3d, 0x11, 0x21,
+	0xa3, 0x41, 0x9a, 0x0e, 0x05, 0x0b, 0x94, 0x80, 0x44, 0x52, 0x95, 0x05, 0x89, 0xfc, 0xc0, 0x33,
+	0x9a, 0x7b, 0x54, 0x7d, 0x22, 0x69, 0x06, 0x0a, 0xf0, 0x81, 0x08, 0x19, 0x59, 0x85, 0x91, 0x05,
+	0x8c, 0xe4, 0x5e, 0xfb, 0x51, 0x04, 0x11, 0x94, 0x40, 0x5a, 0x58, 0x55, 0x4d, 0xdb, 0x66, 0x20,
+	0x63, 0x90, 0x34, 0x0c, 0x24, 0xa7, 0xb9, 0x17, 0x72, 0x15, 0x78, 0x94, 0x81, 0x48, 0x74, 0xde,
+	0x29, 0xa8, 0x19, 0x64, 0x9c, 0xb2, 0xa1, 0xe0, 0x89, 0x2a, 0x08, 0x2b, 0xab, 0x02, 0xb8, 0xdf,
+	0xea, 0xa8, 0x75, 0x2e, 0xa3, 0x0b, 0xcd, 0x84, 0x4f, 0x50, 0x4b, 0xc2, 0x28, 0x63, 0xfc, 0x32,
+	0x85, 0x4c, 0x59, 0x66, 0xc7, 0xec, 0x6e, 0xf6, 0xf6, 0xe6, 0x13, 0x07, 0x8f, 0x83, 0x78, 0x78,
+	0xea, 0xae, 0x24, 0x5d, 0x1f, 0x55, 0xde, 0x1b, 0xc8, 0x14, 0x7e, 0x81, 0xb6, 0x75, 0x8e, 0x0d,
+	0x82, 0x24, 0xe1, 0x43, 0xeb, 0x5e, 0x59, 0xbb, 0x3f, 0x9f, 0x38, 0xbb, 0x6b, 0xb5, 0x3a, 0xef,
+	0xfa, 0x5b, 0x55, 0xe0, 0xac, 0xf2, 0xf1, 0x31, 0xda, 0x50, 0x70, 0xc5, 0x13, 0xab, 0xd6, 0x31,
+	0xbb, 0xad, 0xa3, 0x7d, 0x52, 0x69, 0x23, 0x85, 0x36, 0xa2, 0xb5, 0x91, 0x33, 0x10, 0x49, 0xaf,
+	0x7e, 0x33, 0x71, 0x0c, 0xbf, 0x42, 0xe3, 0x3d, 0xd4, 0x90, 0x3c, 0xe9, 0xf3, 0xcc, 0xaa, 0x17,
+	0x84, 0xbe, 0xf6, 0x70, 0x1b, 0x35, 0x33, 0xce, 0xb8, 0xc8, 0x79, 0x66, 0x6d, 0x94, 0x99, 0xa5,
+	0x8f, 0xdf, 0xa3, 0x6d, 0x25, 0x62, 0x0e, 0x23, 0x75, 0x39, 0xe0, 0x22, 0x1a, 0x28, 0xab, 0x51,
+	0x72, 0xb6, 0x49, 0xf1, 0x0f, 0x8a, 0x79, 0x11, 0x3d, 0xa5, 0xdc, 0x23, 0xaf, 0x4a, 0x44, 0xef,
+	0x71, 0x41, 0xfa, 0x57, 0xcc, 0x7a, 0xbd, 0xeb, 0x6f, 0xe9, 0x40, 0x85, 0xc6, 0xaf, 0xd1, 0x83,
+	0x05, 0xa2, 0x78, 0xa5, 0x0a, 0xe2, 0xd4, 0xba, 0xdf, 0x31, 0xbb, 0xf5, 0xde, 0xc1, 0x7c, 0xe2,
+	0x58, 0xeb, 0x4d, 0x96, 0x10, 0xd7, 0xdf, 0xd1, 0xb1, 0x8b, 0x45, 0x08, 0x63, 0x54, 0x8f, 0x79,
+	0x0c, 0x56, 0xb3, 0x14, 0x51, 0xda, 0xf8, 0x23, 0x6a, 0x94, 0xea, 0xa5, 0xb5, 0xd9, 0xa9, 0xdd,
+	0x3d, 0xac, 0x97, 0xc5, 0x77, 0xff, 0x9e, 0x38, 0x3b, 0x55, 0xc1, 0x33, 0x88, 0x85, 0xe2, 0x71,
+	0xaa, 0xc6, 0x5f, 0x7f, 0x38, 0xdd, 0x48, 0xa8, 0xc1, 0x28, 0x24, 0x0c, 0x62, 0xaa, 0x37, 0xa9,
+	0x7a, 0x0e, 0x65, 0xff, 0x8a, 0xaa, 0x71, 0xca, 0x65, 0xd9, 0x44, 0xfa, 0x9a, 0xee, 0xb4, 0xf9,
+	0xf9, 0xda, 0x31, 0x7e, 0x5d, 0x3b, 0x86, 0xbb, 0x8b, 0x1e, 0xae, 0x2c, 0x8e, 0xcf, 0x65, 0x0a,
+	0x89, 0xe4, 0x47, 0x80, 0x6a, 0xe7, 0x32, 0xc2, 0x03, 0xd4, 0x5c, 0xee, 0xd4, 0x53, 0x72, 0xd7,
+	0x66, 0x93, 0x95, 0x2e, 0x6d, 0xef, 0xbf, 0xa1, 0x0b, 0xc2, 0xde, 0xdb, 0x9b, 0xa9, 0x6d, 0xde,
+	0x4e, 0x6d, 0xf3, 0xe7, 0xd4, 0x36, 0xbf, 0xcc, 0x6c, 0xe3, 0x76, 0x66, 0x1b, 0xdf, 0x67, 0xb6,
+	0xf1, 0xee, 0xe4, 0x5f, 0x75, 0x22, 0x64, 0x87, 0x11, 0xd0, 0xfc, 0x98, 0xc6, 0xd0, 0x1f, 0x0d,
+	0xb9, 0x2c, 0xee, 0x72, 0xe5, 0x1e, 0x4b, 0xc9, 0x61, 0xa3, 0xbc, 0x8d, 0xe7, 0x7f, 0x06, 0x00,
+	0x30, 0xf1, 0x88, 0x5c, 0xb9, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +278,27 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TimeoutTimestamp != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
 		i--
@@ -387,6 +418,16 @@ func (m *MsgTransfer) Size() (n int) {
 	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -647,6 +688,72 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])